@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pillowskiy/gopix/internal/api"
+	"github.com/pillowskiy/gopix/internal/config"
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/infrastructure/features"
+	"github.com/pillowskiy/gopix/internal/repository/postgres"
+	"github.com/pillowskiy/gopix/internal/repository/s3"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	"github.com/pillowskiy/gopix/pkg/logger"
+	"github.com/pillowskiy/gopix/pkg/storage"
+)
+
+// checkpoint is saved after every batch, so an interrupted run can be resumed
+type checkpoint struct {
+	LastID    domain.ID `json:"last_id"`
+	Processed int       `json:"processed"`
+	Failed    int       `json:"failed"`
+}
+
+func main() {
+	var (
+		batchSize      = flag.Int("batch", 50, "number of images fetched per batch")
+		concurrency    = flag.Int("concurrency", 4, "number of images processed concurrently")
+		checkpointPath = flag.String("checkpoint", "reindex.checkpoint.json", "checkpoint file, empty to disable")
+		reset          = flag.Bool("reset", false, "ignore the existing checkpoint and start over")
+		onlyMissing    = flag.Bool("only-missing", false, "process only images without computed dimensions")
+		props          = flag.Bool("props", true, "recompute image properties")
+		vectors        = flag.Bool("vectors", true, "resubmit image vectors")
+		dryRun         = flag.Bool("dry-run", false, "download and inspect images without writing anything")
+	)
+
+	// Flags are parsed together with the config path
+	cfg, err := config.FetchAndLoadConfig()
+	if err != nil {
+		log.Fatalf("FetchAndLoadConfig: %v", err)
+	}
+
+	logger := logger.NewZap(&cfg.Logger).Init()
+
+	sh := storage.NewStorageHolder(cfg)
+	if err := sh.Setup(); err != nil {
+		logger.Fatalf("StorageHolderSetup: %v", err)
+	}
+	defer sh.Close()
+
+	vecRepo, err := api.NewVecRepository(&cfg.VecService, logger)
+	if err != nil {
+		logger.Fatalf("NewVecRepository: %v", err)
+	}
+
+	reindexUC := usecase.NewImageReindexUseCase(
+		postgres.NewImageRepository(sh.Postgres),
+		s3.NewImageStorage(sh.S3, sh.S3.PublicBucket),
+		postgres.NewImagePropsRepository(sh.Postgres),
+		vecRepo,
+		features.NewBasicFeatureExtractor(),
+	)
+
+	var state checkpoint
+	if *checkpointPath != "" && !*reset && !*dryRun {
+		if err := loadCheckpoint(*checkpointPath, &state); err != nil {
+			logger.Fatalf("LoadCheckpoint: %v", err)
+		}
+		if state.LastID != 0 {
+			logger.Infof("Resuming after image %d, %d already processed", state.LastID, state.Processed)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *dryRun {
+		logger.Info("Dry run, nothing will be written")
+	}
+
+	opts := usecase.ReindexOptions{
+		AfterID:     state.LastID,
+		BatchSize:   *batchSize,
+		Concurrency: *concurrency,
+		OnlyMissing: *onlyMissing,
+		Properties:  *props,
+		Vectors:     *vectors,
+		DryRun:      *dryRun,
+	}
+
+	start := time.Now()
+	progress, err := reindexUC.Reindex(ctx, opts, func(p *usecase.ReindexProgress) error {
+		for _, failure := range p.Failures {
+			logger.Errorf("Image %d: %v", failure.ImageID, failure.Err)
+		}
+
+		elapsed := time.Since(start)
+		rate := float64(p.Processed) / elapsed.Seconds()
+		logger.Infof(
+			"Processed %d/%d images (%d failed), last id %d, %.1f images/s",
+			p.Processed, p.Total, p.Failed, p.LastID, rate,
+		)
+
+		if *checkpointPath == "" || *dryRun {
+			return nil
+		}
+
+		return saveCheckpoint(*checkpointPath, &checkpoint{
+			LastID:    p.LastID,
+			Processed: state.Processed + p.Processed,
+			Failed:    state.Failed + p.Failed,
+		})
+	})
+
+	if errors.Is(err, context.Canceled) {
+		// Interrupted before the first batch, nothing has moved past the loaded checkpoint
+		lastID := opts.AfterID
+		if progress != nil {
+			lastID = progress.LastID
+		}
+		logger.Warnf("Interrupted, run again to resume after image %d", lastID)
+		return
+	}
+	if err != nil {
+		logger.Fatalf("Reindex: %v", err)
+	}
+
+	logger.Infof(
+		"Reindex finished in %s: %d images processed, %d failed",
+		time.Since(start).Round(time.Second), progress.Processed, progress.Failed,
+	)
+	if progress.Failed > 0 {
+		logger.Warnf("Run again to retry the failed images, resuming after image %d", progress.LastID)
+	}
+}
+
+func loadCheckpoint(path string, state *checkpoint) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, state)
+}
+
+// saveCheckpoint replaces the file atomically, a crash never leaves it half written
+func saveCheckpoint(path string, state *checkpoint) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
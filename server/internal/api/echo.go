@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
@@ -14,6 +15,8 @@ import (
 	"github.com/pillowskiy/gopix/internal/infrastructure/features"
 	"github.com/pillowskiy/gopix/internal/infrastructure/oauth"
 	"github.com/pillowskiy/gopix/internal/policy"
+	"github.com/pillowskiy/gopix/internal/repository/fakerepo"
+	"github.com/pillowskiy/gopix/internal/repository/grpcrepo"
 	"github.com/pillowskiy/gopix/internal/repository/httprepo"
 	"github.com/pillowskiy/gopix/internal/repository/postgres"
 	"github.com/pillowskiy/gopix/internal/repository/redis"
@@ -61,8 +64,15 @@ func (s *EchoServer) Listen() error {
 func (s *EchoServer) MapHandlers() error {
 	s.prepareMiddlewares()
 
+	restrictionRepo := postgres.NewRestrictionRepository(s.sh.Postgres)
+
+	notifRepo := postgres.NewNotificationRepository(s.sh.Postgres)
+	notifUC := usecase.NewNotificationUseCase(
+		notifRepo, restrictionRepo, signal.NewSignal[*domain.Notification](), s.logger,
+	)
+
 	followingRepo := postgres.NewFollowingRepository(s.sh.Postgres)
-	followingUC := usecase.NewFollowingUseCase(followingRepo)
+	followingUC := usecase.NewFollowingUseCase(followingRepo, restrictionRepo, notifUC)
 
 	userCache := redis.NewUserCache(s.sh.Redis)
 	userRepo := postgres.NewUserRepository(s.sh.Postgres)
@@ -72,18 +82,18 @@ func (s *EchoServer) MapHandlers() error {
 		s.cfg.Server.Session.Expire*time.Second,
 	)
 	authUC := usecase.NewAuthUseCase(userRepo, userCache, s.logger, jwtTokenGen)
-	userUC := usecase.NewUserUseCase(userRepo, userCache, followingUC, s.logger)
-
-	notifRepo := postgres.NewNotificationRepository(s.sh.Postgres)
-	notifUC := usecase.NewNotificationUseCase(notifRepo, signal.NewSignal[*domain.Notification](), s.logger)
+	userUC := usecase.NewUserUseCase(userRepo, userCache, followingUC, restrictionRepo, s.logger)
 
 	oauthRepo := postgres.NewOAuthRepository(s.sh.Postgres)
 	oauthClient := oauth.NewOAuthClient(&s.cfg.OAuth)
 	oauthUC := usecase.NewOAuthUseCase(oauthRepo, authUC, oauthClient)
 
-	subscriptionUC := usecase.NewSubscriptionUseCase(followingUC, userUC)
+	restrictionUC := usecase.NewRestrictionUseCase(restrictionRepo, userUC)
 
-	vecRepo := httprepo.NewVectorizationRepository(s.cfg.VecService.URL)
+	vecRepo, err := NewVecRepository(&s.cfg.VecService, s.logger)
+	if err != nil {
+		return err
+	}
 	featExtractor := features.NewBasicFeatureExtractor()
 	imagePropsRepo := postgres.NewImagePropsRepository(s.sh.Postgres)
 	imageFeatUC := usecase.NewImageFeaturesUseCase(vecRepo, imagePropsRepo, featExtractor, s.logger)
@@ -92,21 +102,52 @@ func (s *EchoServer) MapHandlers() error {
 	imageRepo := postgres.NewImageRepository(s.sh.Postgres)
 	imageStorage := s3.NewImageStorage(s.sh.S3, s.sh.S3.PublicBucket)
 	imageACL := policy.NewImageAccessPolicy()
+	commentRepo := postgres.NewCommentRepository(s.sh.Postgres)
+
+	reactionRepo := postgres.NewReactionRepository(s.sh.Postgres)
+	reactionUC := usecase.NewReactionUseCase(
+		reactionRepo, imageRepo, commentRepo, s.cfg.Reactions.Emojis, s.cfg.Reactions.Multiple,
+	)
+
+	followSuggestionUC := usecase.NewFollowSuggestionUseCase(followingRepo, imageFeatUC, s.logger)
+	subscriptionUC := usecase.NewSubscriptionUseCase(followingUC, userUC, followSuggestionUC)
+
+	tagRepo := postgres.NewTagRepository(s.sh.Postgres)
+	tagSuggestionCfg := s.cfg.Tags.Suggestions
+	tagSuggestionUC := usecase.NewTagSuggestionUseCase(
+		tagRepo, imageFeatUC, tagSuggestionCfg.Limit, tagSuggestionCfg.AutoApplyScore, s.logger,
+	)
+
 	imageUC := usecase.NewImageUseCase(
-		imageStorage, imageCache, imageRepo, imageFeatUC, imageACL, notifUC, s.logger,
+		imageStorage, imageCache, imageRepo, imageFeatUC, imageACL, notifUC, reactionUC, tagSuggestionUC, s.logger,
 	)
 
-	commentRepo := postgres.NewCommentRepository(s.sh.Postgres)
 	commentACL := policy.NewCommentAccessPolicy()
-	commentUC := usecase.NewCommentUseCase(commentRepo, commentACL, imageUC, s.logger)
+	commentUC := usecase.NewCommentUseCase(
+		commentRepo, commentACL, imageUC, notifUC, reactionUC, restrictionRepo, s.cfg.Comments.MaxDepth,
+		s.logger,
+	)
 
 	albumRepo := postgres.NewAlbumRepository(s.sh.Postgres)
 	albumACL := policy.NewAlbumAccessPolicy()
-	albumUC := usecase.NewAlbumUseCase(albumRepo, albumACL, imageUC)
+	albumUC := usecase.NewAlbumUseCase(albumRepo, albumACL, imageUC, imageFeatUC, notifUC, s.logger)
 
-	tagRepo := postgres.NewTagRepository(s.sh.Postgres)
 	tagACL := policy.NewTagAccessPolicy()
-	tagUC := usecase.NewTagUseCase(tagRepo, tagACL, imageUC)
+	tagUC := usecase.NewTagUseCase(tagRepo, tagACL, imageUC, tagSuggestionUC)
+
+	// The archives may contain private images, so they are never stored next to the public ones
+	var archiveStorage usecase.AlbumArchiveStorage
+	switch archiveBucket := s.cfg.S3.ArchiveBucket; archiveBucket {
+	case "":
+		s.logger.Warn("s3.archive_bucket is not set, the asynchronous album export is disabled")
+	case s.sh.S3.PublicBucket:
+		return errors.New("s3.archive_bucket must differ from the public bucket")
+	default:
+		archiveStorage = s3.NewArchiveStorage(s.sh.S3, archiveBucket, s.cfg.S3.ArchiveURLTTL)
+	}
+	albumExportUC := usecase.NewAlbumExportUseCase(
+		albumUC, tagRepo, imageStorage, archiveStorage, notifUC, s.logger,
+	)
 
 	v1 := s.echo.Group("/api/v1")
 	guardMiddlewares := middlewares.NewGuardMiddlewares(authUC, s.logger, s.cfg.Server.Cookie)
@@ -125,6 +166,10 @@ func (s *EchoServer) MapHandlers() error {
 	subscriptionGroup := v1.Group("/subscriptions")
 	subscriptionHandlers := handlers.NewSubscriptionHandlers(subscriptionUC, s.logger)
 	routes.MapSubscriptionRoutes(subscriptionGroup, subscriptionHandlers, guardMiddlewares)
+	routes.MapUserSubscriptionRoutes(userGroup, subscriptionHandlers, guardMiddlewares)
+
+	restrictionHandlers := handlers.NewRestrictionHandlers(restrictionUC, s.logger)
+	routes.MapRestrictionRoutes(userGroup, restrictionHandlers, guardMiddlewares)
 
 	imagesGroup := v1.Group("/images")
 	imagesHandlers := handlers.NewImageHandlers(imageUC, s.logger)
@@ -134,6 +179,10 @@ func (s *EchoServer) MapHandlers() error {
 	commentsHandlers := handlers.NewCommentHandlers(commentUC, s.logger)
 	routes.MapCommentRoutes(commentsGroup, commentsHandlers, guardMiddlewares)
 
+	reactionsGroup := imagesGroup.Group("")
+	reactionsHandlers := handlers.NewReactionHandlers(reactionUC, s.logger)
+	routes.MapReactionRoutes(reactionsGroup, reactionsHandlers, guardMiddlewares)
+
 	tagsGroup := imagesGroup.Group("")
 	tagsHandlers := handlers.NewTagHandlers(tagUC, s.logger)
 	routes.MapTagRoutes(tagsGroup, tagsHandlers, guardMiddlewares)
@@ -142,6 +191,13 @@ func (s *EchoServer) MapHandlers() error {
 	albumsHandlers := handlers.NewAlbumHandlers(albumUC, s.logger)
 	routes.MapAlbumRoutes(albumsGroup, albumsHandlers, guardMiddlewares)
 
+	albumExportsGroup := albumsGroup.Group("")
+	albumExportsHandlers := handlers.NewAlbumExportHandlers(albumExportUC, s.logger)
+	routes.MapAlbumExportRoutes(albumExportsGroup, albumExportsHandlers, guardMiddlewares)
+	if archiveStorage != nil {
+		routes.MapAlbumAsyncExportRoutes(albumExportsGroup, albumExportsHandlers, guardMiddlewares)
+	}
+
 	notifGroup := v1.Group("/notifications")
 	notifHandlers := handlers.NewNotificationHandlers(notifUC, s.logger)
 	routes.MapNotificationRoutes(notifGroup, notifHandlers, guardMiddlewares)
@@ -167,3 +223,16 @@ func (s *EchoServer) prepareMiddlewares() {
 		s.echo.GET("/debug/pprof/*", echo.WrapHandler(http.DefaultServeMux))
 	}
 }
+
+// NewVecRepository picks the vectorization service transport configured by the driver
+func NewVecRepository(cfg *config.VecService, logger logger.Logger) (usecase.ImageVecRepository, error) {
+	switch cfg.Driver {
+	case "fake":
+		logger.Warn("Vectorization service is replaced with in-process fake")
+		return fakerepo.NewVectorizationRepository(), nil
+	case "grpc":
+		return grpcrepo.NewVectorizationRepository(cfg)
+	default:
+		return httprepo.NewVectorizationRepository(cfg), nil
+	}
+}
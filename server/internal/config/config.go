@@ -19,6 +19,9 @@ type Config struct {
 	VecService VecService `mapstructure:"vec_service"`
 	Metrics    Metrics    `mapstructure:"metrics"`
 	OAuth      OAuth      `mapstructure:"oauth"`
+	Comments   Comments   `mapstructure:"comments"`
+	Reactions  Reactions  `mapstructure:"reactions"`
+	Tags       Tags       `mapstructure:"tags"`
 }
 
 type Server struct {
@@ -91,6 +94,36 @@ type S3 struct {
 	// The buffer size for file uploads, including multipart uploads, in megabytes.
 	UploadBufferSizeMB   int   `mapstructure:"upload_buffer_size_mb"`
 	MultipartChunkSizeMB int64 `mapstructure:"multipart_chunk_size_mb"`
+	// The bucket for the exported album archives, it must differ from the public bucket.
+	// The asynchronous album export is disabled when it is not set.
+	ArchiveBucket string `mapstructure:"archive_bucket"`
+	// The lifetime of the archive download links, expired archives are deleted.
+	ArchiveURLTTL time.Duration `mapstructure:"archive_url_ttl"`
+}
+
+type Comments struct {
+	// The deepest reply level, top-level comments have zero depth
+	MaxDepth int `mapstructure:"max_depth"`
+}
+
+type Reactions struct {
+	// The allowed reaction emojis, the default set is used when it's empty
+	Emojis []string `mapstructure:"emojis"`
+	// Multiple allows a user to react with several emojis to the same target,
+	// otherwise a new reaction replaces the previous one
+	Multiple bool `mapstructure:"multiple"`
+}
+
+type Tags struct {
+	Suggestions TagSuggestions `mapstructure:"suggestions"`
+}
+
+type TagSuggestions struct {
+	// The maximum number of suggested tags, the default limit is used when it's zero
+	Limit int `mapstructure:"limit"`
+	// The suggestions scored at least this value are applied right after the upload,
+	// zero disables auto applying
+	AutoApplyScore float64 `mapstructure:"auto_apply_score"`
 }
 
 type OAuth struct {
@@ -105,6 +138,23 @@ type OAuthGoogle struct {
 
 type VecService struct {
 	URL string `mapstructure:"url"`
+	// GRPCAddr is the service address used by the "grpc" driver
+	GRPCAddr string `mapstructure:"grpc_addr"`
+	// Driver is one of "http" (default), "grpc" or "fake" for offline development
+	Driver string `mapstructure:"driver"`
+	// Per-call timeouts in seconds
+	FeaturesTimeout time.Duration `mapstructure:"features_timeout"`
+	SearchTimeout   time.Duration `mapstructure:"search_timeout"`
+	DeleteTimeout   time.Duration `mapstructure:"delete_timeout"`
+	// Retries are made only for idempotent calls with exponential backoff,
+	// the base backoff delay is in milliseconds
+	MaxRetries     int           `mapstructure:"max_retries"`
+	RetryBackoffMS time.Duration `mapstructure:"retry_backoff_ms"`
+	MaxConcurrency int           `mapstructure:"max_concurrency"`
+	// The breaker opens after the threshold of consecutive failures
+	// and stays open for the cooldown in seconds
+	BreakerThreshold int           `mapstructure:"breaker_threshold"`
+	BreakerCooldown  time.Duration `mapstructure:"breaker_cooldown"`
 }
 
 type Metrics struct {
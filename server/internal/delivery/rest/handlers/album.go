@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/pillowskiy/gopix/internal/domain"
@@ -14,18 +15,44 @@ import (
 )
 
 type albumUseCase interface {
-	Create(ctx context.Context, album *domain.Album) (*domain.Album, error)
-	GetByAuthorID(ctx context.Context, authorID domain.ID) ([]domain.DetailedAlbum, error)
+	Create(ctx context.Context, album *domain.Album, executor *domain.User) (*domain.Album, error)
+	GetByAuthorID(ctx context.Context, authorID domain.ID, executor *domain.User) ([]domain.DetailedAlbum, error)
 	GetAlbumImages(
-		ctx context.Context, albumID domain.ID, pagInput *domain.PaginationInput,
+		ctx context.Context,
+		albumID domain.ID,
+		pagInput *domain.PaginationInput,
+		sort domain.AlbumImageSortMethod,
+		executor *domain.User,
+		shareToken string,
 	) (*domain.Pagination[domain.ImageWithMeta], error)
 	Delete(ctx context.Context, albumID domain.ID, executor *domain.User) error
 	Update(
 		ctx context.Context, albumID domain.ID, album *domain.Album, executor *domain.User,
 	) (*domain.Album, error)
+	Share(ctx context.Context, albumID domain.ID, executor *domain.User) (string, error)
+	RevokeShare(ctx context.Context, albumID domain.ID, executor *domain.User) error
 
 	PutImage(ctx context.Context, albumID domain.ID, imageID domain.ID, executor *domain.User) error
 	DeleteImage(ctx context.Context, albumID domain.ID, imageID domain.ID, executor *domain.User) error
+	MoveImage(
+		ctx context.Context, albumID domain.ID, imageID domain.ID, position int, executor *domain.User,
+	) error
+	SetImagesOrder(ctx context.Context, albumID domain.ID, imageIDs []domain.ID, executor *domain.User) error
+	SetCover(ctx context.Context, albumID domain.ID, imageID *domain.ID, executor *domain.User) error
+
+	GetMembers(ctx context.Context, albumID domain.ID, executor *domain.User) ([]domain.AlbumMember, error)
+	InviteMember(
+		ctx context.Context, albumID domain.ID, userID domain.ID, role domain.AlbumRole, executor *domain.User,
+	) error
+	UpdateMemberRole(
+		ctx context.Context, albumID domain.ID, userID domain.ID, role domain.AlbumRole, executor *domain.User,
+	) error
+	RemoveMember(ctx context.Context, albumID domain.ID, userID domain.ID, executor *domain.User) error
+	AcceptInvitation(ctx context.Context, albumID domain.ID, executor *domain.User) error
+
+	Follow(ctx context.Context, albumID domain.ID, executor *domain.User) error
+	Unfollow(ctx context.Context, albumID domain.ID, executor *domain.User) error
+	GetFollowed(ctx context.Context, executor *domain.User) ([]domain.DetailedAlbum, error)
 }
 
 type AlbumHandlers struct {
@@ -37,10 +64,37 @@ func NewAlbumHandlers(uc albumUseCase, logger logger.Logger) *AlbumHandlers {
 	return &AlbumHandlers{uc: uc, logger: logger}
 }
 
+type albumRuleDTO struct {
+	Tags      []string   `json:"tags" validate:"omitempty,max=10,dive,gte=1,lte=50"`
+	AuthorID  *domain.ID `json:"authorID"`
+	From      *time.Time `json:"from"`
+	To        *time.Time `json:"to"`
+	MinLikes  int        `json:"minLikes" validate:"gte=0"`
+	SimilarTo *domain.ID `json:"similarTo"`
+}
+
+func (dto *albumRuleDTO) toRule() *domain.AlbumRule {
+	if dto == nil {
+		return nil
+	}
+
+	return &domain.AlbumRule{
+		Tags:      dto.Tags,
+		AuthorID:  dto.AuthorID,
+		From:      dto.From,
+		To:        dto.To,
+		MinLikes:  dto.MinLikes,
+		SimilarTo: dto.SimilarTo,
+	}
+}
+
 func (h *AlbumHandlers) Create() echo.HandlerFunc {
 	type createDTO struct {
-		Name        string `json:"name" validate:"required,gte=1,lte=128"`
-		Description string `json:"description" validate:"gte=1,lte=512"`
+		Name        string        `json:"name" validate:"required,gte=1,lte=128"`
+		Description string        `json:"description" validate:"gte=1,lte=512"`
+		AccessLevel string        `json:"accessLevel" validate:"omitempty,oneof=link private public"`
+		Kind        string        `json:"kind" validate:"omitempty,oneof=regular smart"`
+		Rule        *albumRuleDTO `json:"rule" validate:"required_if=Kind smart"`
 	}
 
 	return func(c echo.Context) error {
@@ -65,10 +119,13 @@ func (h *AlbumHandlers) Create() echo.HandlerFunc {
 		album := &domain.Album{
 			Name:        cr.Name,
 			Description: cr.Description,
+			AccessLevel: domain.AlbumAccessLevel(cr.AccessLevel),
+			Kind:        domain.AlbumKind(cr.Kind),
+			Rule:        cr.Rule.toRule(),
 			AuthorID:    user.ID,
 		}
 
-		createdAlbum, err := h.uc.Create(ctx, album)
+		createdAlbum, err := h.uc.Create(ctx, album, user)
 		if err != nil {
 			return h.responseWithUseCaseErr(c, err, "Create")
 		}
@@ -86,7 +143,9 @@ func (h *AlbumHandlers) GetByAuthorID() echo.HandlerFunc {
 			return c.JSON(rest.NewBadRequestError("Invalid user ID").Response())
 		}
 
-		albums, err := h.uc.GetByAuthorID(ctx, userID)
+		user, _ := c.Get("user").(*domain.User)
+
+		albums, err := h.uc.GetByAuthorID(ctx, userID, user)
 		if err != nil {
 			return h.responseWithUseCaseErr(c, err, "GetByAuthorID")
 		}
@@ -97,8 +156,10 @@ func (h *AlbumHandlers) GetByAuthorID() echo.HandlerFunc {
 
 func (h *AlbumHandlers) GetAlbumImages() echo.HandlerFunc {
 	type imageCommentsQuery struct {
-		Limit int `query:"limit" validate:"required,gte=1,lte=100"`
-		Page  int `query:"page" validate:"required,gte=1"`
+		Limit int    `query:"limit" validate:"required,gte=1,lte=100"`
+		Page  int    `query:"page" validate:"required,gte=1"`
+		Token string `query:"token" validate:"omitempty,lte=64"`
+		Sort  string `query:"sort" validate:"omitempty,oneof=manual added newest popular"`
 	}
 
 	return func(c echo.Context) error {
@@ -123,7 +184,14 @@ func (h *AlbumHandlers) GetAlbumImages() echo.HandlerFunc {
 			PerPage: pag.Limit,
 			Page:    pag.Page,
 		}
-		images, err := h.uc.GetAlbumImages(ctx, albumID, pagInput)
+		user, _ := c.Get("user").(*domain.User)
+
+		sort := domain.AlbumImageSortMethod(pag.Sort)
+		if sort == "" {
+			sort = domain.AlbumImageManualSort
+		}
+
+		images, err := h.uc.GetAlbumImages(ctx, albumID, pagInput, sort, user, pag.Token)
 		if err != nil {
 			return h.responseWithUseCaseErr(c, err, "GetAlbumImages")
 		}
@@ -157,8 +225,10 @@ func (h *AlbumHandlers) Delete() echo.HandlerFunc {
 
 func (h *AlbumHandlers) Update() echo.HandlerFunc {
 	type updateDTO struct {
-		Name        string `json:"name" validate:"lte=128"`
-		Description string `json:"description" validate:"lte=512"`
+		Name        string        `json:"name" validate:"lte=128"`
+		Description string        `json:"description" validate:"lte=512"`
+		AccessLevel string        `json:"accessLevel" validate:"omitempty,oneof=link private public"`
+		Rule        *albumRuleDTO `json:"rule"`
 	}
 
 	return func(c echo.Context) error {
@@ -188,6 +258,8 @@ func (h *AlbumHandlers) Update() echo.HandlerFunc {
 		album := &domain.Album{
 			Name:        up.Name,
 			Description: up.Description,
+			AccessLevel: domain.AlbumAccessLevel(up.AccessLevel),
+			Rule:        up.Rule.toRule(),
 		}
 
 		updatedAlbum, err := h.uc.Update(ctx, albumID, album, user)
@@ -199,6 +271,57 @@ func (h *AlbumHandlers) Update() echo.HandlerFunc {
 	}
 }
 
+func (h *AlbumHandlers) Share() echo.HandlerFunc {
+	type shareResponse struct {
+		Token string `json:"token"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid album ID").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("AlbumHandlers.Share.GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		token, err := h.uc.Share(ctx, albumID, user)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "Share")
+		}
+
+		return c.JSON(http.StatusOK, &shareResponse{Token: token})
+	}
+}
+
+func (h *AlbumHandlers) RevokeShare() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid album ID").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("AlbumHandlers.RevokeShare.GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		if err := h.uc.RevokeShare(ctx, albumID, user); err != nil {
+			return h.responseWithUseCaseErr(c, err, "RevokeShare")
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
 func (h *AlbumHandlers) PutImage() echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := rest.GetEchoRequestCtx(c)
@@ -220,6 +343,9 @@ func (h *AlbumHandlers) PutImage() echo.HandlerFunc {
 		}
 
 		if err := h.uc.PutImage(ctx, albumID, imageID, user); err != nil {
+			if errors.Is(err, usecase.ErrAlreadyExists) {
+				return c.JSON(rest.NewConflictError("Image is already in the album").Response())
+			}
 			return h.responseWithUseCaseErr(c, err, "PutImage")
 		}
 
@@ -255,13 +381,368 @@ func (h *AlbumHandlers) DeleteImage() echo.HandlerFunc {
 	}
 }
 
+func (h *AlbumHandlers) MoveImage() echo.HandlerFunc {
+	type moveImageDTO struct {
+		Position *int `json:"position" validate:"required,gte=0"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		imageID, err := rest.PipeDomainIdentifier(c, "image_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Image ID").Response())
+		}
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Album ID").Response())
+		}
+
+		dto := new(moveImageDTO)
+		if err := rest.DecodeEchoBody(c, dto); err != nil {
+			h.logger.Errorf("AlbumHandlers.MoveImage.DecodeBody: %v", err)
+			return c.JSON(rest.NewBadRequestError("Move body has incorrect type").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, dto); err != nil {
+			return c.JSON(rest.NewBadRequestError("Move body has incorrect type").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("AlbumHandlers.MoveImage.GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		if err := h.uc.MoveImage(ctx, albumID, imageID, *dto.Position, user); err != nil {
+			return h.responseWithUseCaseErr(c, err, "MoveImage")
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+func (h *AlbumHandlers) SetImagesOrder() echo.HandlerFunc {
+	type imagesOrderDTO struct {
+		ImageIDs []domain.ID `json:"imageIDs" validate:"required,min=1,max=1000"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Album ID").Response())
+		}
+
+		dto := new(imagesOrderDTO)
+		if err := rest.DecodeEchoBody(c, dto); err != nil {
+			h.logger.Errorf("AlbumHandlers.SetImagesOrder.DecodeBody: %v", err)
+			return c.JSON(rest.NewBadRequestError("Order body has incorrect type").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, dto); err != nil {
+			return c.JSON(rest.NewBadRequestError("Order body has incorrect type").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("AlbumHandlers.SetImagesOrder.GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		if err := h.uc.SetImagesOrder(ctx, albumID, dto.ImageIDs, user); err != nil {
+			if errors.Is(err, usecase.ErrUnprocessable) {
+				return c.JSON(rest.NewBadRequestError("Order must contain every album image exactly once").Response())
+			}
+			return h.responseWithUseCaseErr(c, err, "SetImagesOrder")
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+func (h *AlbumHandlers) SetCover() echo.HandlerFunc {
+	type coverDTO struct {
+		// ImageID resets the cover when it's null
+		ImageID *domain.ID `json:"imageID"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Album ID").Response())
+		}
+
+		dto := new(coverDTO)
+		if err := rest.DecodeEchoBody(c, dto); err != nil {
+			h.logger.Errorf("AlbumHandlers.SetCover.DecodeBody: %v", err)
+			return c.JSON(rest.NewBadRequestError("Cover body has incorrect type").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("AlbumHandlers.SetCover.GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		if err := h.uc.SetCover(ctx, albumID, dto.ImageID, user); err != nil {
+			return h.responseWithUseCaseErr(c, err, "SetCover")
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+func (h *AlbumHandlers) GetMembers() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid album ID").Response())
+		}
+
+		user, _ := c.Get("user").(*domain.User)
+
+		members, err := h.uc.GetMembers(ctx, albumID, user)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "GetMembers")
+		}
+
+		return c.JSON(http.StatusOK, members)
+	}
+}
+
+func (h *AlbumHandlers) InviteMember() echo.HandlerFunc {
+	type inviteDTO struct {
+		UserID domain.ID `json:"userID" validate:"required"`
+		Role   string    `json:"role" validate:"required,oneof=viewer contributor editor"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid album ID").Response())
+		}
+
+		dto := new(inviteDTO)
+		if err := rest.DecodeEchoBody(c, dto); err != nil {
+			h.logger.Errorf("AlbumHandlers.InviteMember.DecodeBody: %v", err)
+			return c.JSON(rest.NewBadRequestError("Invite body has incorrect type").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, dto); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invite body has incorrect type").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("AlbumHandlers.InviteMember.GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		err = h.uc.InviteMember(ctx, albumID, dto.UserID, domain.AlbumRole(dto.Role), user)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "InviteMember")
+		}
+
+		return c.JSON(http.StatusCreated, true)
+	}
+}
+
+func (h *AlbumHandlers) UpdateMemberRole() echo.HandlerFunc {
+	type updateRoleDTO struct {
+		Role string `json:"role" validate:"required,oneof=viewer contributor editor"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid album ID").Response())
+		}
+
+		userID, err := rest.PipeDomainIdentifier(c, "user_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid user ID").Response())
+		}
+
+		dto := new(updateRoleDTO)
+		if err := rest.DecodeEchoBody(c, dto); err != nil {
+			h.logger.Errorf("AlbumHandlers.UpdateMemberRole.DecodeBody: %v", err)
+			return c.JSON(rest.NewBadRequestError("Update role body has incorrect type").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, dto); err != nil {
+			return c.JSON(rest.NewBadRequestError("Update role body has incorrect type").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("AlbumHandlers.UpdateMemberRole.GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		err = h.uc.UpdateMemberRole(ctx, albumID, userID, domain.AlbumRole(dto.Role), user)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "UpdateMemberRole")
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+func (h *AlbumHandlers) RemoveMember() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid album ID").Response())
+		}
+
+		userID, err := rest.PipeDomainIdentifier(c, "user_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid user ID").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("AlbumHandlers.RemoveMember.GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		if err := h.uc.RemoveMember(ctx, albumID, userID, user); err != nil {
+			return h.responseWithUseCaseErr(c, err, "RemoveMember")
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+func (h *AlbumHandlers) AcceptInvitation() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid album ID").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("AlbumHandlers.AcceptInvitation.GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		if err := h.uc.AcceptInvitation(ctx, albumID, user); err != nil {
+			switch {
+			case errors.Is(err, usecase.ErrAlreadyExists):
+				return c.JSON(http.StatusOK, true)
+			default:
+				return h.responseWithUseCaseErr(c, err, "AcceptInvitation")
+			}
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+func (h *AlbumHandlers) Follow() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid album ID").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("AlbumHandlers.Follow.GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		if err := h.uc.Follow(ctx, albumID, user); err != nil {
+			switch {
+			case errors.Is(err, usecase.ErrAlreadyExists):
+				return c.NoContent(http.StatusOK)
+			default:
+				return h.responseWithUseCaseErr(c, err, "Follow")
+			}
+		}
+
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+func (h *AlbumHandlers) Unfollow() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid album ID").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("AlbumHandlers.Unfollow.GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		if err := h.uc.Unfollow(ctx, albumID, user); err != nil {
+			switch {
+			case errors.Is(err, usecase.ErrNotFound):
+				return c.NoContent(http.StatusOK)
+			default:
+				return h.responseWithUseCaseErr(c, err, "Unfollow")
+			}
+		}
+
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+func (h *AlbumHandlers) GetFollowed() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("AlbumHandlers.GetFollowed.GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		albums, err := h.uc.GetFollowed(ctx, user)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "GetFollowed")
+		}
+
+		return c.JSON(http.StatusOK, albums)
+	}
+}
+
 func (h *AlbumHandlers) responseWithUseCaseErr(c echo.Context, err error, trace string) error {
 	var restErr *rest.Error
 	switch {
 	case errors.Is(err, usecase.ErrIncorrectImageRef):
 		restErr = rest.NewBadRequestError("Incorrect image reference provided")
+	case errors.Is(err, usecase.ErrIncorrectUserRef):
+		restErr = rest.NewBadRequestError("Incorrect user reference provided")
+	case errors.Is(err, usecase.ErrUnprocessable):
+		restErr = rest.NewBadRequestError("Incorrect data provided")
 	case errors.Is(err, usecase.ErrForbidden):
 		restErr = rest.NewForbiddenError("You don't have permissions to perform this action")
+	case errors.Is(err, usecase.ErrAlreadyExists):
+		restErr = rest.NewConflictError("The user is already a member of the album")
 	case errors.Is(err, usecase.ErrNotFound):
 		restErr = rest.NewNotFoundError("Album not found")
 	default:
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	"github.com/pillowskiy/gopix/pkg/logger"
+	"github.com/pillowskiy/gopix/pkg/rest"
+	"github.com/pillowskiy/gopix/pkg/validator"
+)
+
+// albumExportWriteTimeout replaces the server write timeout for the streamed archives,
+// which take much longer to be written than a regular response
+const albumExportWriteTimeout = 10 * time.Minute
+
+type albumExportUseCase interface {
+	Export(
+		ctx context.Context, albumID domain.ID, executor *domain.User, shareToken string,
+	) (*domain.AlbumExport, error)
+	WriteArchive(ctx context.Context, export *domain.AlbumExport, w io.Writer) error
+	ExportAsync(ctx context.Context, albumID domain.ID, executor *domain.User, shareToken string) error
+}
+
+type AlbumExportHandlers struct {
+	uc     albumExportUseCase
+	logger logger.Logger
+}
+
+func NewAlbumExportHandlers(uc albumExportUseCase, logger logger.Logger) *AlbumExportHandlers {
+	return &AlbumExportHandlers{uc: uc, logger: logger}
+}
+
+// The share token is read from the query of the download and from the body of the async export
+type albumExportQuery struct {
+	Token string `query:"token" json:"token" validate:"omitempty,lte=64"`
+}
+
+func (h *AlbumExportHandlers) Export() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid album ID").Response())
+		}
+
+		query := new(albumExportQuery)
+		if err := rest.DecodeEchoBody(c, query); err != nil {
+			h.logger.Errorf("AlbumExportHandlers.Export.DecodeBody: %v", err)
+			return c.JSON(rest.NewBadRequestError("Export query has incorrect type").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Export query has incorrect type").Response())
+		}
+
+		user, _ := c.Get("user").(*domain.User)
+
+		export, err := h.uc.Export(ctx, albumID, user, query.Token)
+		if err != nil {
+			if errors.Is(err, usecase.ErrUnprocessable) {
+				return c.JSON(rest.NewBadRequestError(
+					"The album is too large to be downloaded, request the asynchronous export instead",
+				).Response())
+			}
+			return h.responseWithUseCaseErr(c, err, "Export")
+		}
+
+		res := c.Response()
+		deadline := time.Now().Add(albumExportWriteTimeout)
+		if err := http.NewResponseController(res).SetWriteDeadline(deadline); err != nil &&
+			!errors.Is(err, http.ErrNotSupported) {
+			h.logger.Errorf("AlbumExportHandlers.Export.SetWriteDeadline: %v", err)
+			return c.JSON(rest.NewInternalServerError().Response())
+		}
+
+		res.Header().Set(echo.HeaderContentType, "application/zip")
+		res.Header().Set(
+			echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="album_%s.zip"`, export.AlbumID),
+		)
+		res.WriteHeader(http.StatusOK)
+
+		// NOTE: The status is already sent, so the failed archive can only be reported to the logs
+		if err := h.uc.WriteArchive(ctx, export, res); err != nil {
+			h.logger.Errorf("AlbumExportUseCase.WriteArchive: %v", err)
+		}
+
+		return nil
+	}
+}
+
+func (h *AlbumExportHandlers) ExportAsync() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		albumID, err := rest.PipeDomainIdentifier(c, "album_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid album ID").Response())
+		}
+
+		query := new(albumExportQuery)
+		if err := rest.DecodeEchoBody(c, query); err != nil {
+			h.logger.Errorf("AlbumExportHandlers.ExportAsync.DecodeBody: %v", err)
+			return c.JSON(rest.NewBadRequestError("Export query has incorrect type").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Export query has incorrect type").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		if err := h.uc.ExportAsync(ctx, albumID, user, query.Token); err != nil {
+			return h.responseWithUseCaseErr(c, err, "ExportAsync")
+		}
+
+		return c.NoContent(http.StatusAccepted)
+	}
+}
+
+func (h *AlbumExportHandlers) responseWithUseCaseErr(c echo.Context, err error, trace string) error {
+	var restErr *rest.Error
+	switch {
+	case errors.Is(err, usecase.ErrUnprocessable):
+		restErr = rest.NewBadRequestError("The album is too large to be exported")
+	case errors.Is(err, usecase.ErrUnavailable):
+		restErr = rest.NewError(http.StatusServiceUnavailable, "Too many exports are in progress, try again later")
+	case errors.Is(err, usecase.ErrForbidden):
+		restErr = rest.NewForbiddenError("You don't have permissions to perform this action")
+	case errors.Is(err, usecase.ErrNotFound):
+		restErr = rest.NewNotFoundError("Album not found")
+	default:
+		h.logger.Errorf("AlbumExportUseCase.%s: %v", trace, err)
+		restErr = rest.NewInternalServerError()
+	}
+
+	return c.JSON(restErr.Response())
+}
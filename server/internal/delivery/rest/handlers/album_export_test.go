@@ -0,0 +1,209 @@
+package handlers_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pillowskiy/gopix/internal/delivery/rest/handlers"
+	handlersMock "github.com/pillowskiy/gopix/internal/delivery/rest/handlers/mock"
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	loggerMock "github.com/pillowskiy/gopix/pkg/logger/mock"
+	"github.com/pillowskiy/gopix/pkg/rest"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAlbumExportHandlers_Export(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockExportUC := handlersMock.NewMockalbumExportUseCase(ctrl)
+	ctxUser, mockCtxUser := handlersMock.NewMockCtxUser()
+
+	h := handlers.NewAlbumExportHandlers(mockExportUC, mockLog)
+
+	e := echo.New()
+
+	albumID := handlersMock.DomainID()
+	itoaAlbumID := albumID.String()
+
+	prepareExportQuery := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/albums/:album_id/export", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("album_id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("SuccessExport", func(t *testing.T) {
+		c, rec := prepareExportQuery(itoaAlbumID)
+		mockCtxUser(c)
+
+		export := &domain.AlbumExport{AlbumID: albumID, Name: "test"}
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockExportUC.EXPECT().Export(ctx, albumID, ctxUser, "").Return(export, nil)
+		mockExportUC.EXPECT().WriteArchive(ctx, export, gomock.Any()).
+			DoAndReturn(func(_ any, _ *domain.AlbumExport, w io.Writer) error {
+				_, err := w.Write([]byte("zip"))
+				return err
+			})
+
+		assert.NoError(t, h.Export()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/zip", rec.Header().Get(echo.HeaderContentType))
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "attachment")
+		assert.Equal(t, "zip", rec.Body.String())
+	})
+
+	t.Run("OutlivesWriteTimeout", func(t *testing.T) {
+		export := &domain.AlbumExport{AlbumID: albumID, Name: "test"}
+		mockExportUC.EXPECT().Export(gomock.Any(), albumID, nil, "").Return(export, nil)
+		mockExportUC.EXPECT().WriteArchive(gomock.Any(), export, gomock.Any()).
+			DoAndReturn(func(_ any, _ *domain.AlbumExport, w io.Writer) error {
+				time.Sleep(100 * time.Millisecond)
+				_, err := w.Write([]byte("zip"))
+				return err
+			})
+
+		srvEcho := echo.New()
+		srvEcho.GET("/albums/:album_id/export", h.Export())
+		srv := httptest.NewUnstartedServer(srvEcho)
+		srv.Config.WriteTimeout = 20 * time.Millisecond
+		srv.Start()
+		defer srv.Close()
+
+		res, err := http.Get(srv.URL + "/albums/" + itoaAlbumID + "/export")
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer res.Body.Close()
+
+		body, err := io.ReadAll(res.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "zip", string(body))
+	})
+
+	t.Run("InvalidAlbumID", func(t *testing.T) {
+		c, rec := prepareExportQuery("invalid")
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockExportUC.EXPECT().Export(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Export()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		c, rec := prepareExportQuery(itoaAlbumID)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockExportUC.EXPECT().Export(ctx, albumID, nil, "").Return(nil, usecase.ErrUnprocessable)
+		mockExportUC.EXPECT().WriteArchive(ctx, gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Export()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		c, rec := prepareExportQuery(itoaAlbumID)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockExportUC.EXPECT().Export(ctx, albumID, nil, "").Return(nil, usecase.ErrForbidden)
+
+		assert.NoError(t, h.Export()(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("InternalServerError", func(t *testing.T) {
+		c, rec := prepareExportQuery(itoaAlbumID)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockExportUC.EXPECT().Export(ctx, albumID, nil, "").Return(nil, errors.New("internal error"))
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.Export()(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestAlbumExportHandlers_ExportAsync(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockExportUC := handlersMock.NewMockalbumExportUseCase(ctrl)
+	ctxUser, mockCtxUser := handlersMock.NewMockCtxUser()
+
+	h := handlers.NewAlbumExportHandlers(mockExportUC, mockLog)
+
+	e := echo.New()
+
+	albumID := handlersMock.DomainID()
+	itoaAlbumID := albumID.String()
+
+	prepareExportAsyncQuery := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/albums/:album_id/export", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("album_id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("SuccessExportAsync", func(t *testing.T) {
+		c, rec := prepareExportAsyncQuery(itoaAlbumID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockExportUC.EXPECT().ExportAsync(ctx, albumID, ctxUser, "").Return(nil)
+
+		assert.NoError(t, h.ExportAsync()(c))
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	})
+
+	t.Run("IncorrectUserContext", func(t *testing.T) {
+		c, rec := prepareExportAsyncQuery(itoaAlbumID)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockExportUC.EXPECT().ExportAsync(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.ExportAsync()(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("QueueFull", func(t *testing.T) {
+		c, rec := prepareExportAsyncQuery(itoaAlbumID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockExportUC.EXPECT().ExportAsync(ctx, albumID, ctxUser, "").Return(usecase.ErrUnavailable)
+
+		assert.NoError(t, h.ExportAsync()(c))
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+
+	t.Run("AlbumNotFound", func(t *testing.T) {
+		c, rec := prepareExportAsyncQuery(itoaAlbumID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockExportUC.EXPECT().ExportAsync(ctx, albumID, ctxUser, "").Return(usecase.ErrNotFound)
+
+		assert.NoError(t, h.ExportAsync()(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
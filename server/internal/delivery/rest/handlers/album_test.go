@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/labstack/echo/v4"
@@ -66,18 +68,71 @@ func TestAlbumHandlers_Create(t *testing.T) {
 		}
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockAlbumUC.EXPECT().Create(ctx, withCorrectAuthor).Return(withCorrectAuthor, nil)
+		mockAlbumUC.EXPECT().Create(ctx, withCorrectAuthor, ctxUser).Return(withCorrectAuthor, nil)
 
 		assert.NoError(t, h.Create()(c))
 		assert.Equal(t, http.StatusCreated, rec.Code)
 	})
 
+	t.Run("SuccessCreateSmart", func(t *testing.T) {
+		body := `{"name":"smart","description":"smart","kind":"smart","rule":{"tags":["cats"],"minLikes":10}}`
+		c, rec := prepareCreateQuery(strings.NewReader(body))
+		mockCtxUser(c)
+
+		smartAlbum := &domain.Album{
+			Name:        "smart",
+			Description: "smart",
+			Kind:        domain.AlbumKindSmart,
+			Rule:        &domain.AlbumRule{Tags: []string{"cats"}, MinLikes: 10},
+			AuthorID:    ctxUser.ID,
+		}
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().Create(ctx, smartAlbum, ctxUser).Return(smartAlbum, nil)
+
+		assert.NoError(t, h.Create()(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	t.Run("SmartWithoutRule", func(t *testing.T) {
+		c, rec := prepareCreateQuery(strings.NewReader(`{"name":"smart","description":"smart","kind":"smart"}`))
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Create()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("SmartWithNegativeLikes", func(t *testing.T) {
+		c, rec := prepareCreateQuery(strings.NewReader(`{"name":"smart","description":"smart","kind":"smart","rule":{"minLikes":-1}}`))
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Create()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("UnprocessableRule", func(t *testing.T) {
+		c, rec := prepareCreateQuery(strings.NewReader(`{"name":"smart","description":"smart","kind":"smart","rule":{}}`))
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(nil, usecase.ErrUnprocessable)
+
+		assert.NoError(t, h.Create()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
 	t.Run("IncorrectUserContext", func(t *testing.T) {
 		body, _ := json.Marshal(validCreateInput)
 		c, rec := prepareCreateQuery(bytes.NewBuffer(body))
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockAlbumUC.EXPECT().Create(ctx, gomock.Any()).Times(0)
+		mockAlbumUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Times(0)
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
 
 		assert.NoError(t, h.Create()(c))
@@ -88,7 +143,7 @@ func TestAlbumHandlers_Create(t *testing.T) {
 		c, rec := prepareCreateQuery(nil)
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockAlbumUC.EXPECT().Create(ctx, gomock.Any()).Times(0)
+		mockAlbumUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Times(0)
 
 		assert.NoError(t, h.Create()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -101,7 +156,7 @@ func TestAlbumHandlers_Create(t *testing.T) {
 		mockCtxUser(c)
 		ctx := rest.GetEchoRequestCtx(c)
 
-		mockAlbumUC.EXPECT().Create(ctx, gomock.Any()).Return(nil, errors.New("internal error"))
+		mockAlbumUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(nil, errors.New("internal error"))
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
 
 		assert.NoError(t, h.Create()(c))
@@ -115,7 +170,7 @@ func TestAlbumHandlers_Create(t *testing.T) {
 		mockCtxUser(c)
 		ctx := rest.GetEchoRequestCtx(c)
 
-		mockAlbumUC.EXPECT().Create(ctx, gomock.Any()).Return(nil, usecase.ErrIncorrectImageRef)
+		mockAlbumUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(nil, usecase.ErrIncorrectImageRef)
 
 		assert.NoError(t, h.Create()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -151,7 +206,7 @@ func TestAlbumHandlers_GetByAuthorID(t *testing.T) {
 	t.Run("SuccessGetByAuthorID", func(t *testing.T) {
 		c, rec := prepareGetByAuthorIDQuery(itoaAuthorID)
 
-		mockAlbumUC.EXPECT().GetByAuthorID(gomock.Any(), authorID).Return([]domain.DetailedAlbum{}, nil)
+		mockAlbumUC.EXPECT().GetByAuthorID(gomock.Any(), authorID, nil).Return([]domain.DetailedAlbum{}, nil)
 
 		assert.NoError(t, h.GetByAuthorID()(c))
 		assert.Equal(t, http.StatusOK, rec.Code)
@@ -160,7 +215,7 @@ func TestAlbumHandlers_GetByAuthorID(t *testing.T) {
 	t.Run("IncorrectAuthorID", func(t *testing.T) {
 		c, rec := prepareGetByAuthorIDQuery("abs")
 
-		mockAlbumUC.EXPECT().GetByAuthorID(gomock.Any(), authorID).Times(0)
+		mockAlbumUC.EXPECT().GetByAuthorID(gomock.Any(), authorID, nil).Times(0)
 
 		assert.NoError(t, h.GetByAuthorID()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -170,7 +225,7 @@ func TestAlbumHandlers_GetByAuthorID(t *testing.T) {
 		c, rec := prepareGetByAuthorIDQuery(itoaAuthorID)
 
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
-		mockAlbumUC.EXPECT().GetByAuthorID(gomock.Any(), authorID).Return(nil, errors.New("internal error"))
+		mockAlbumUC.EXPECT().GetByAuthorID(gomock.Any(), authorID, nil).Return(nil, errors.New("internal error"))
 
 		assert.NoError(t, h.GetByAuthorID()(c))
 		assert.Equal(t, http.StatusInternalServerError, rec.Code)
@@ -185,6 +240,7 @@ func TestAlbumHandlers_GetAlbumImages(t *testing.T) {
 
 	mockLog := loggerMock.NewMockLogger(ctrl)
 	mockAlbumUC := handlersMock.NewMockalbumUseCase(ctrl)
+	ctxUser, mockCtxUser := handlersMock.NewMockCtxUser()
 
 	h := handlers.NewAlbumHandlers(mockAlbumUC, mockLog)
 
@@ -229,8 +285,8 @@ func TestAlbumHandlers_GetAlbumImages(t *testing.T) {
 			Page:    validAlbumImagesQuery.Page,
 		}
 
-		pag := &domain.Pagination[domain.ImageWithAuthor]{
-			Items: []domain.ImageWithAuthor{
+		pag := &domain.Pagination[domain.ImageWithMeta]{
+			Items: []domain.ImageWithMeta{
 				{
 					Image: domain.Image{
 						ID: 1,
@@ -242,16 +298,47 @@ func TestAlbumHandlers_GetAlbumImages(t *testing.T) {
 		}
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockAlbumUC.EXPECT().GetAlbumImages(ctx, albumID, pagInput).Return(pag, nil)
+		mockAlbumUC.EXPECT().
+			GetAlbumImages(ctx, albumID, pagInput, domain.AlbumImageManualSort, nil, "").
+			Return(pag, nil)
 
 		assert.NoError(t, h.GetAlbumImages()(c))
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		actual := new(domain.Pagination[domain.ImageWithAuthor])
+		actual := new(domain.Pagination[domain.ImageWithMeta])
 		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), actual))
 		assert.Equal(t, pag, actual)
 	})
 
+	t.Run("SuccessWithSort", func(t *testing.T) {
+		c, rec := prepareGetAlbumImagesQuery(itoaAlbumID, validAlbumImagesQuery)
+		q := c.Request().URL.Query()
+		q.Add("sort", "popular")
+		c.Request().URL.RawQuery = q.Encode()
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().
+			GetAlbumImages(ctx, albumID, gomock.Any(), domain.AlbumImagePopularSort, nil, "").
+			Return(&domain.Pagination[domain.ImageWithMeta]{}, nil)
+
+		assert.NoError(t, h.GetAlbumImages()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("IncorrectSort", func(t *testing.T) {
+		c, rec := prepareGetAlbumImagesQuery(itoaAlbumID, validAlbumImagesQuery)
+		q := c.Request().URL.Query()
+		q.Add("sort", "random")
+		c.Request().URL.RawQuery = q.Encode()
+
+		mockAlbumUC.EXPECT().
+			GetAlbumImages(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(0)
+
+		assert.NoError(t, h.GetAlbumImages()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
 	t.Run("IncorrectQuery", func(t *testing.T) {
 		c, rec := prepareGetAlbumImagesQuery(itoaAlbumID, nil)
 
@@ -263,7 +350,9 @@ func TestAlbumHandlers_GetAlbumImages(t *testing.T) {
 		c, rec := prepareGetAlbumImagesQuery("abs", validAlbumImagesQuery)
 		ctx := rest.GetEchoRequestCtx(c)
 
-		mockAlbumUC.EXPECT().GetAlbumImages(ctx, gomock.Any(), gomock.Any()).Times(0)
+		mockAlbumUC.EXPECT().
+			GetAlbumImages(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(0)
 
 		assert.NoError(t, h.GetAlbumImages()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -273,18 +362,50 @@ func TestAlbumHandlers_GetAlbumImages(t *testing.T) {
 		c, rec := prepareGetAlbumImagesQuery(itoaAlbumID, validAlbumImagesQuery)
 		ctx := rest.GetEchoRequestCtx(c)
 
-		mockAlbumUC.EXPECT().GetAlbumImages(ctx, albumID, gomock.Any()).Return(nil, usecase.ErrIncorrectImageRef)
+		mockAlbumUC.EXPECT().
+			GetAlbumImages(ctx, albumID, gomock.Any(), domain.AlbumImageManualSort, nil, "").
+			Return(nil, usecase.ErrIncorrectImageRef)
 
 		assert.NoError(t, h.GetAlbumImages()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 
+	t.Run("SuccessWithShareToken", func(t *testing.T) {
+		c, rec := prepareGetAlbumImagesQuery(itoaAlbumID, validAlbumImagesQuery)
+		q := c.Request().URL.Query()
+		q.Add("token", "share-token")
+		c.Request().URL.RawQuery = q.Encode()
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().
+			GetAlbumImages(ctx, albumID, gomock.Any(), domain.AlbumImageManualSort, ctxUser, "share-token").
+			Return(&domain.Pagination[domain.ImageWithMeta]{}, nil)
+
+		assert.NoError(t, h.GetAlbumImages()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		c, rec := prepareGetAlbumImagesQuery(itoaAlbumID, validAlbumImagesQuery)
+		ctx := rest.GetEchoRequestCtx(c)
+
+		mockAlbumUC.EXPECT().
+			GetAlbumImages(ctx, albumID, gomock.Any(), domain.AlbumImageManualSort, nil, "").
+			Return(nil, usecase.ErrForbidden)
+
+		assert.NoError(t, h.GetAlbumImages()(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
 	t.Run("InternalServerError", func(t *testing.T) {
 		c, rec := prepareGetAlbumImagesQuery(itoaAlbumID, validAlbumImagesQuery)
 		ctx := rest.GetEchoRequestCtx(c)
 
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
-		mockAlbumUC.EXPECT().GetAlbumImages(ctx, albumID, gomock.Any()).Return(nil, errors.New("internal error"))
+		mockAlbumUC.EXPECT().
+			GetAlbumImages(ctx, albumID, gomock.Any(), domain.AlbumImageManualSort, nil, "").
+			Return(nil, errors.New("internal error"))
 
 		assert.NoError(t, h.GetAlbumImages()(c))
 		assert.Equal(t, http.StatusInternalServerError, rec.Code)
@@ -496,6 +617,101 @@ func TestAlbumHandlers_Update(t *testing.T) {
 	})
 }
 
+func TestAlbumHandlers_Share(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockAlbumUC := handlersMock.NewMockalbumUseCase(ctrl)
+	ctxUser, mockCtxUser := handlersMock.NewMockCtxUser()
+
+	h := handlers.NewAlbumHandlers(mockAlbumUC, mockLog)
+
+	e := echo.New()
+
+	albumID := handlersMock.DomainID()
+	itoaAlbumID := albumID.String()
+
+	prepareShareQuery := func(method string, id string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/api/v1/albums/:album_id/share", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("album_id")
+		c.SetParamValues(id)
+
+		return c, rec
+	}
+
+	t.Run("SuccessShare", func(t *testing.T) {
+		c, rec := prepareShareQuery(http.MethodPost, itoaAlbumID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().Share(ctx, albumID, ctxUser).Return("token", nil)
+
+		assert.NoError(t, h.Share()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"token":"token"}`, rec.Body.String())
+	})
+
+	t.Run("IncorrectAlbumID", func(t *testing.T) {
+		c, rec := prepareShareQuery(http.MethodPost, "abs")
+		mockCtxUser(c)
+
+		mockAlbumUC.EXPECT().Share(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Share()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("IncorrectUserContext", func(t *testing.T) {
+		c, rec := prepareShareQuery(http.MethodPost, itoaAlbumID)
+
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+		mockAlbumUC.EXPECT().Share(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Share()(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		c, rec := prepareShareQuery(http.MethodPost, itoaAlbumID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().Share(ctx, albumID, ctxUser).Return("", usecase.ErrForbidden)
+
+		assert.NoError(t, h.Share()(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("SuccessRevokeShare", func(t *testing.T) {
+		c, rec := prepareShareQuery(http.MethodDelete, itoaAlbumID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().RevokeShare(ctx, albumID, ctxUser).Return(nil)
+
+		assert.NoError(t, h.RevokeShare()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("RevokeShareNotFound", func(t *testing.T) {
+		c, rec := prepareShareQuery(http.MethodDelete, itoaAlbumID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().RevokeShare(ctx, albumID, ctxUser).Return(usecase.ErrNotFound)
+
+		assert.NoError(t, h.RevokeShare()(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
 func TestAlbumHandlers_PutImage(t *testing.T) {
 	t.Parallel()
 
@@ -538,6 +754,17 @@ func TestAlbumHandlers_PutImage(t *testing.T) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 	})
 
+	t.Run("AlreadyInAlbum", func(t *testing.T) {
+		c, rec := preparePutImageQuery(itoaAlbumID, itoaImageID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().PutImage(ctx, albumID, imageID, ctxUser).Return(usecase.ErrAlreadyExists)
+
+		assert.NoError(t, h.PutImage()(c))
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
 	t.Run("IncorrectUserContext", func(t *testing.T) {
 		c, rec := preparePutImageQuery(itoaAlbumID, itoaImageID)
 
@@ -715,3 +942,491 @@ func TestAlbumHandlers_DeleteImage(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, rec.Code)
 	})
 }
+
+func TestAlbumHandlers_Arrange(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockAlbumUC := handlersMock.NewMockalbumUseCase(ctrl)
+	ctxUser, mockCtxUser := handlersMock.NewMockCtxUser()
+
+	h := handlers.NewAlbumHandlers(mockAlbumUC, mockLog)
+
+	e := echo.New()
+
+	albumID := handlersMock.DomainID()
+	itoaAlbumID := albumID.String()
+	imageID := handlersMock.DomainID()
+	itoaImageID := imageID.String()
+
+	prepareArrangeQuery := func(body string, params ...string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/albums/:album_id/images", bytes.NewBufferString(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("album_id", "image_id")
+		c.SetParamValues(params...)
+
+		return c, rec
+	}
+
+	t.Run("SuccessMoveImage", func(t *testing.T) {
+		c, rec := prepareArrangeQuery(`{"position":0}`, itoaAlbumID, itoaImageID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().MoveImage(ctx, albumID, imageID, 0, ctxUser).Return(nil)
+
+		assert.NoError(t, h.MoveImage()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("MoveImageWithoutPosition", func(t *testing.T) {
+		c, rec := prepareArrangeQuery(`{}`, itoaAlbumID, itoaImageID)
+		mockCtxUser(c)
+
+		mockAlbumUC.EXPECT().MoveImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.MoveImage()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("MoveImageForbidden", func(t *testing.T) {
+		c, rec := prepareArrangeQuery(`{"position":3}`, itoaAlbumID, itoaImageID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().MoveImage(ctx, albumID, imageID, 3, ctxUser).Return(usecase.ErrForbidden)
+
+		assert.NoError(t, h.MoveImage()(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("SuccessSetImagesOrder", func(t *testing.T) {
+		body := fmt.Sprintf(`{"imageIDs":["%s"]}`, itoaImageID)
+		c, rec := prepareArrangeQuery(body, itoaAlbumID, "")
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().SetImagesOrder(ctx, albumID, []domain.ID{imageID}, ctxUser).Return(nil)
+
+		assert.NoError(t, h.SetImagesOrder()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("IncompleteImagesOrder", func(t *testing.T) {
+		body := fmt.Sprintf(`{"imageIDs":["%s"]}`, itoaImageID)
+		c, rec := prepareArrangeQuery(body, itoaAlbumID, "")
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().SetImagesOrder(ctx, albumID, []domain.ID{imageID}, ctxUser).
+			Return(usecase.ErrUnprocessable)
+
+		assert.NoError(t, h.SetImagesOrder()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("EmptyImagesOrder", func(t *testing.T) {
+		c, rec := prepareArrangeQuery(`{"imageIDs":[]}`, itoaAlbumID, "")
+		mockCtxUser(c)
+
+		mockAlbumUC.EXPECT().SetImagesOrder(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.SetImagesOrder()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("SuccessSetCover", func(t *testing.T) {
+		body := fmt.Sprintf(`{"imageID":"%s"}`, itoaImageID)
+		c, rec := prepareArrangeQuery(body, itoaAlbumID, "")
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().SetCover(ctx, albumID, &imageID, ctxUser).Return(nil)
+
+		assert.NoError(t, h.SetCover()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("SuccessResetCover", func(t *testing.T) {
+		c, rec := prepareArrangeQuery(`{"imageID":null}`, itoaAlbumID, "")
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().SetCover(ctx, albumID, nil, ctxUser).Return(nil)
+
+		assert.NoError(t, h.SetCover()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("SetCoverIncorrectImageRef", func(t *testing.T) {
+		body := fmt.Sprintf(`{"imageID":"%s"}`, itoaImageID)
+		c, rec := prepareArrangeQuery(body, itoaAlbumID, "")
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().SetCover(ctx, albumID, &imageID, ctxUser).Return(usecase.ErrIncorrectImageRef)
+
+		assert.NoError(t, h.SetCover()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestAlbumHandlers_InviteMember(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockAlbumUC := handlersMock.NewMockalbumUseCase(ctrl)
+	ctxUser, mockCtxUser := handlersMock.NewMockCtxUser()
+
+	h := handlers.NewAlbumHandlers(mockAlbumUC, mockLog)
+
+	e := echo.New()
+
+	albumID := handlersMock.DomainID()
+	itoaAlbumID := albumID.String()
+	userID := handlersMock.DomainID()
+
+	type inviteBody struct {
+		UserID domain.ID `json:"userID"`
+		Role   string    `json:"role"`
+	}
+
+	prepareInviteQuery := func(id string, body any) (echo.Context, *httptest.ResponseRecorder) {
+		reqBody, _ := json.Marshal(body)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/albums/:album_id/members", bytes.NewBuffer(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("album_id")
+		c.SetParamValues(id)
+
+		return c, rec
+	}
+
+	validBody := inviteBody{UserID: userID, Role: string(domain.AlbumRoleContributor)}
+
+	t.Run("SuccessInviteMember", func(t *testing.T) {
+		c, rec := prepareInviteQuery(itoaAlbumID, validBody)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().InviteMember(ctx, albumID, userID, domain.AlbumRoleContributor, ctxUser).Return(nil)
+
+		assert.NoError(t, h.InviteMember()(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	t.Run("InvalidRole", func(t *testing.T) {
+		c, rec := prepareInviteQuery(itoaAlbumID, inviteBody{UserID: userID, Role: "owner"})
+		mockCtxUser(c)
+
+		mockAlbumUC.EXPECT().InviteMember(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Times(0)
+
+		assert.NoError(t, h.InviteMember()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("IncorrectUserContext", func(t *testing.T) {
+		c, rec := prepareInviteQuery(itoaAlbumID, validBody)
+
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+		mockAlbumUC.EXPECT().InviteMember(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Times(0)
+
+		assert.NoError(t, h.InviteMember()(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("AlreadyMember", func(t *testing.T) {
+		c, rec := prepareInviteQuery(itoaAlbumID, validBody)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().InviteMember(ctx, albumID, userID, domain.AlbumRoleContributor, ctxUser).
+			Return(usecase.ErrAlreadyExists)
+
+		assert.NoError(t, h.InviteMember()(c))
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("IncorrectUserRef", func(t *testing.T) {
+		c, rec := prepareInviteQuery(itoaAlbumID, validBody)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().InviteMember(ctx, albumID, userID, domain.AlbumRoleContributor, ctxUser).
+			Return(usecase.ErrIncorrectUserRef)
+
+		assert.NoError(t, h.InviteMember()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestAlbumHandlers_Members(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockAlbumUC := handlersMock.NewMockalbumUseCase(ctrl)
+	ctxUser, mockCtxUser := handlersMock.NewMockCtxUser()
+
+	h := handlers.NewAlbumHandlers(mockAlbumUC, mockLog)
+
+	e := echo.New()
+
+	albumID := handlersMock.DomainID()
+	itoaAlbumID := albumID.String()
+	userID := handlersMock.DomainID()
+	itoaUserID := userID.String()
+
+	prepareMemberQuery := func(
+		method string, body io.Reader, params ...string,
+	) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/api/v1/albums/:album_id/members/:user_id", body)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("album_id", "user_id")
+		c.SetParamValues(params...)
+
+		return c, rec
+	}
+
+	t.Run("SuccessGetMembers", func(t *testing.T) {
+		c, rec := prepareMemberQuery(http.MethodGet, nil, itoaAlbumID, "")
+
+		ctx := rest.GetEchoRequestCtx(c)
+		members := []domain.AlbumMember{{AlbumID: albumID, UserID: userID, Role: domain.AlbumRoleViewer}}
+		mockAlbumUC.EXPECT().GetMembers(ctx, albumID, nil).Return(members, nil)
+
+		assert.NoError(t, h.GetMembers()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("GetMembersForbidden", func(t *testing.T) {
+		c, rec := prepareMemberQuery(http.MethodGet, nil, itoaAlbumID, "")
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().GetMembers(ctx, albumID, ctxUser).Return(nil, usecase.ErrForbidden)
+
+		assert.NoError(t, h.GetMembers()(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("SuccessUpdateMemberRole", func(t *testing.T) {
+		body := bytes.NewBufferString(`{"role":"editor"}`)
+		c, rec := prepareMemberQuery(http.MethodPut, body, itoaAlbumID, itoaUserID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().UpdateMemberRole(ctx, albumID, userID, domain.AlbumRoleEditor, ctxUser).Return(nil)
+
+		assert.NoError(t, h.UpdateMemberRole()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("UpdateMemberRoleIncorrectUserID", func(t *testing.T) {
+		body := bytes.NewBufferString(`{"role":"editor"}`)
+		c, rec := prepareMemberQuery(http.MethodPut, body, itoaAlbumID, "abs")
+		mockCtxUser(c)
+
+		mockAlbumUC.EXPECT().UpdateMemberRole(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Times(0)
+
+		assert.NoError(t, h.UpdateMemberRole()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("SuccessRemoveMember", func(t *testing.T) {
+		c, rec := prepareMemberQuery(http.MethodDelete, nil, itoaAlbumID, itoaUserID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().RemoveMember(ctx, albumID, userID, ctxUser).Return(nil)
+
+		assert.NoError(t, h.RemoveMember()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("RemoveMemberNotFound", func(t *testing.T) {
+		c, rec := prepareMemberQuery(http.MethodDelete, nil, itoaAlbumID, itoaUserID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().RemoveMember(ctx, albumID, userID, ctxUser).Return(usecase.ErrNotFound)
+
+		assert.NoError(t, h.RemoveMember()(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("SuccessAcceptInvitation", func(t *testing.T) {
+		c, rec := prepareMemberQuery(http.MethodPost, nil, itoaAlbumID, "")
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().AcceptInvitation(ctx, albumID, ctxUser).Return(usecase.ErrAlreadyExists)
+
+		assert.NoError(t, h.AcceptInvitation()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("AcceptInvitationIncorrectUserContext", func(t *testing.T) {
+		c, rec := prepareMemberQuery(http.MethodPost, nil, itoaAlbumID, "")
+
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+		mockAlbumUC.EXPECT().AcceptInvitation(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.AcceptInvitation()(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestAlbumHandlers_Follow(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockAlbumUC := handlersMock.NewMockalbumUseCase(ctrl)
+	ctxUser, mockCtxUser := handlersMock.NewMockCtxUser()
+
+	h := handlers.NewAlbumHandlers(mockAlbumUC, mockLog)
+
+	e := echo.New()
+
+	albumID := handlersMock.DomainID()
+	itoaAlbumID := albumID.String()
+
+	prepareFollowQuery := func(method string, id string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/api/v1/albums/:album_id/follow", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("album_id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("SuccessFollow", func(t *testing.T) {
+		c, rec := prepareFollowQuery(http.MethodPost, itoaAlbumID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().Follow(ctx, albumID, ctxUser).Return(nil)
+
+		assert.NoError(t, h.Follow()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("AlreadyFollowing", func(t *testing.T) {
+		c, rec := prepareFollowQuery(http.MethodPost, itoaAlbumID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().Follow(ctx, albumID, ctxUser).Return(usecase.ErrAlreadyExists)
+
+		assert.NoError(t, h.Follow()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("FollowForbidden", func(t *testing.T) {
+		c, rec := prepareFollowQuery(http.MethodPost, itoaAlbumID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().Follow(ctx, albumID, ctxUser).Return(usecase.ErrForbidden)
+
+		assert.NoError(t, h.Follow()(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("FollowInvalidAlbumID", func(t *testing.T) {
+		c, rec := prepareFollowQuery(http.MethodPost, "invalid")
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().Follow(ctx, gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Follow()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("FollowUnauthorized", func(t *testing.T) {
+		c, rec := prepareFollowQuery(http.MethodPost, itoaAlbumID)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().Follow(ctx, gomock.Any(), gomock.Any()).Times(0)
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.Follow()(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("SuccessUnfollow", func(t *testing.T) {
+		c, rec := prepareFollowQuery(http.MethodDelete, itoaAlbumID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().Unfollow(ctx, albumID, ctxUser).Return(nil)
+
+		assert.NoError(t, h.Unfollow()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("UnfollowNotFollowing", func(t *testing.T) {
+		c, rec := prepareFollowQuery(http.MethodDelete, itoaAlbumID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().Unfollow(ctx, albumID, ctxUser).Return(usecase.ErrNotFound)
+
+		assert.NoError(t, h.Unfollow()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("SuccessGetFollowed", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/albums/followed", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		albums := []domain.DetailedAlbum{{Album: domain.Album{ID: albumID}}}
+		mockAlbumUC.EXPECT().GetFollowed(ctx, ctxUser).Return(albums, nil)
+
+		assert.NoError(t, h.GetFollowed()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("GetFollowedInternalError", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/albums/followed", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockAlbumUC.EXPECT().GetFollowed(ctx, ctxUser).Return(nil, errors.New("internal error"))
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.GetFollowed()(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
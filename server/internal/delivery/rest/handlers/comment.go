@@ -14,16 +14,24 @@ import (
 )
 
 type CommentUseCase interface {
-	Create(ctx context.Context, comment *domain.Comment) (*domain.Comment, error)
+	Create(ctx context.Context, comment *domain.Comment, executor *domain.User) (*domain.Comment, error)
 	GetByImageID(
 		ctx context.Context,
 		imageID domain.ID,
 		pagInput *domain.PaginationInput,
 		sort domain.CommentSortMethod,
+		mode domain.CommentViewMode,
+		executor *domain.User,
+	) (*domain.Pagination[domain.DetailedComment], error)
+	GetReplies(
+		ctx context.Context, commentID domain.ID, pagInput *domain.PaginationInput, executor *domain.User,
 	) (*domain.Pagination[domain.DetailedComment], error)
-	GetReplies(ctx context.Context, commentID domain.ID, executorID *domain.ID) ([]domain.DetailedComment, error)
 	Update(ctx context.Context, commentID domain.ID, comment *domain.Comment, executor *domain.User) (*domain.Comment, error)
 	Delete(ctx context.Context, commentID domain.ID, executor *domain.User) error
+	GetRevisions(ctx context.Context, commentID domain.ID, executor *domain.User) ([]domain.CommentRevision, error)
+	SetPinned(ctx context.Context, commentID domain.ID, pinned bool, executor *domain.User) error
+	SetHidden(ctx context.Context, commentID domain.ID, hidden bool, executor *domain.User) error
+	SetLocked(ctx context.Context, imageID domain.ID, locked bool, executor *domain.User) error
 
 	LikeComment(ctx context.Context, commentID domain.ID, executor *domain.User) error
 	UnlikeComment(ctx context.Context, commentID domain.ID, executor *domain.User) error
@@ -40,7 +48,8 @@ func NewCommentHandlers(uc CommentUseCase, logger logger.Logger) *CommentHandler
 
 func (h *CommentHandlers) Create() echo.HandlerFunc {
 	type createDTO struct {
-		Text string `json:"text" validate:"required,gte=1,lte=512"`
+		Text     string     `json:"text" validate:"required,gte=1,lte=512"`
+		ParentID *domain.ID `json:"parentID"`
 	}
 
 	return func(c echo.Context) error {
@@ -70,9 +79,10 @@ func (h *CommentHandlers) Create() echo.HandlerFunc {
 			ImageID:  imageID,
 			Text:     cmt.Text,
 			AuthorID: user.ID,
+			ParentID: cmt.ParentID,
 		}
 
-		createdCmt, err := h.uc.Create(ctx, comment)
+		createdCmt, err := h.uc.Create(ctx, comment, user)
 		if err != nil {
 			return h.responseWithUseCaseErr(c, err, "Create")
 		}
@@ -86,6 +96,7 @@ func (h *CommentHandlers) GetByImageID() echo.HandlerFunc {
 		Limit int    `query:"limit" validate:"required,gte=1,lte=100"`
 		Page  int    `query:"page" validate:"required,gte=1"`
 		Sort  string `query:"sort" validate:"oneof=popular newest oldest mostViewed"`
+		View  string `query:"view" validate:"omitempty,oneof=tree flat"`
 	}
 
 	return func(c echo.Context) error {
@@ -106,7 +117,16 @@ func (h *CommentHandlers) GetByImageID() echo.HandlerFunc {
 		}
 
 		pagInput := &domain.PaginationInput{Page: q.Page, PerPage: q.Limit}
-		comments, err := h.uc.GetByImageID(ctx, imageID, pagInput, domain.CommentSortMethod(q.Sort))
+		view := domain.CommentTreeView
+		if q.View != "" {
+			view = domain.CommentViewMode(q.View)
+		}
+
+		// Anonymous users see no hidden comments
+		user, _ := GetContextUser(c)
+		comments, err := h.uc.GetByImageID(
+			ctx, imageID, pagInput, domain.CommentSortMethod(q.Sort), view, user,
+		)
 		if err != nil {
 			return h.responseWithUseCaseErr(c, err, "GetByImageID")
 		}
@@ -181,7 +201,7 @@ func (h *CommentHandlers) Delete() echo.HandlerFunc {
 	}
 }
 
-func (h *CommentHandlers) GetReplies() echo.HandlerFunc {
+func (h *CommentHandlers) GetRevisions() echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := rest.GetEchoRequestCtx(c)
 
@@ -190,15 +210,49 @@ func (h *CommentHandlers) GetReplies() echo.HandlerFunc {
 			return c.JSON(rest.NewBadRequestError("Comment ID has incorrect type").Response())
 		}
 
-		var executorID *domain.ID
 		user, err := GetContextUser(c)
-		if user != nil && err == nil {
-			executorID = &user.ID
+		if err != nil {
+			h.logger.Errorf("GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
 		}
 
-		comments, err := h.uc.GetReplies(ctx, commentID, executorID)
+		revisions, err := h.uc.GetRevisions(ctx, commentID, user)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "GetRevisions")
+		}
+
+		return c.JSON(http.StatusOK, revisions)
+	}
+}
+
+func (h *CommentHandlers) GetReplies() echo.HandlerFunc {
+	type repliesQuery struct {
+		Limit int `query:"limit" validate:"required,gte=1,lte=100"`
+		Page  int `query:"page" validate:"required,gte=1"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		commentID, err := rest.PipeDomainIdentifier(c, "comment_id")
 		if err != nil {
-			h.responseWithUseCaseErr(c, err, "GetReplies")
+			return c.JSON(rest.NewBadRequestError("Comment ID has incorrect type").Response())
+		}
+
+		q := new(repliesQuery)
+		if err := rest.DecodeEchoBody(c, q); err != nil {
+			return c.JSON(rest.NewBadRequestError("Query has incorrect type").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, q); err != nil {
+			return c.JSON(rest.NewBadRequestError("Query has incorrect type").Response())
+		}
+
+		user, _ := GetContextUser(c)
+		pagInput := &domain.PaginationInput{Page: q.Page, PerPage: q.Limit}
+		comments, err := h.uc.GetReplies(ctx, commentID, pagInput, user)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "GetReplies")
 		}
 
 		return c.JSON(http.StatusOK, comments)
@@ -261,6 +315,89 @@ func (h *CommentHandlers) UnlikeComment() echo.HandlerFunc {
 	}
 }
 
+func (h *CommentHandlers) PinComment() echo.HandlerFunc {
+	return h.moderateComment("SetPinned", func(ctx context.Context, id domain.ID, user *domain.User) error {
+		return h.uc.SetPinned(ctx, id, true, user)
+	})
+}
+
+func (h *CommentHandlers) UnpinComment() echo.HandlerFunc {
+	return h.moderateComment("SetPinned", func(ctx context.Context, id domain.ID, user *domain.User) error {
+		return h.uc.SetPinned(ctx, id, false, user)
+	})
+}
+
+func (h *CommentHandlers) HideComment() echo.HandlerFunc {
+	return h.moderateComment("SetHidden", func(ctx context.Context, id domain.ID, user *domain.User) error {
+		return h.uc.SetHidden(ctx, id, true, user)
+	})
+}
+
+func (h *CommentHandlers) UnhideComment() echo.HandlerFunc {
+	return h.moderateComment("SetHidden", func(ctx context.Context, id domain.ID, user *domain.User) error {
+		return h.uc.SetHidden(ctx, id, false, user)
+	})
+}
+
+func (h *CommentHandlers) LockComments() echo.HandlerFunc {
+	return h.setLocked(true)
+}
+
+func (h *CommentHandlers) UnlockComments() echo.HandlerFunc {
+	return h.setLocked(false)
+}
+
+func (h *CommentHandlers) moderateComment(
+	trace string, action func(ctx context.Context, commentID domain.ID, user *domain.User) error,
+) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		commentID, err := rest.PipeDomainIdentifier(c, "comment_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Comment ID has incorrect type").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		if err := action(ctx, commentID, user); err != nil {
+			return h.responseWithUseCaseErr(c, err, trace)
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+func (h *CommentHandlers) setLocked(locked bool) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		imageID, err := rest.PipeDomainIdentifier(c, "image_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Image ID has incorrect type").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		if err := h.uc.SetLocked(ctx, imageID, locked, user); err != nil {
+			if errors.Is(err, usecase.ErrNotFound) {
+				return c.JSON(rest.NewNotFoundError("Image not found").Response())
+			}
+			return h.responseWithUseCaseErr(c, err, "SetLocked")
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
 func (h *CommentHandlers) responseWithUseCaseErr(c echo.Context, err error, trace string) error {
 	var restErr *rest.Error
 	switch {
@@ -271,7 +408,7 @@ func (h *CommentHandlers) responseWithUseCaseErr(c echo.Context, err error, trac
 	case errors.Is(err, usecase.ErrUnprocessable):
 		restErr = rest.NewBadRequestError("Incorrect data provided")
 	case errors.Is(err, usecase.ErrAlreadyExists):
-		restErr = rest.NewConflictError("You've already commented this image")
+		restErr = rest.NewConflictError("Comment already exists")
 	case errors.Is(err, usecase.ErrNotFound):
 		restErr = rest.NewNotFoundError("Comment not found")
 	default:
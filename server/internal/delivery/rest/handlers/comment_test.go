@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -51,7 +52,8 @@ func TestCommentHandlers_Create(t *testing.T) {
 	}
 
 	type CreateInput struct {
-		Text string `json:"text"`
+		Text     string     `json:"text"`
+		ParentID *domain.ID `json:"parentID,omitempty"`
 	}
 
 	validCreateInput := CreateInput{
@@ -68,9 +70,7 @@ func TestCommentHandlers_Create(t *testing.T) {
 		}
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockCommentUC.EXPECT().Create(
-			ctx, gomock.Any(),
-		).Return(createdComment, nil)
+		mockCommentUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(createdComment, nil)
 
 		assert.NoError(t, h.Create()(c))
 		assert.Equal(t, http.StatusCreated, rec.Code)
@@ -80,11 +80,31 @@ func TestCommentHandlers_Create(t *testing.T) {
 		assert.Equal(t, createdComment, actual)
 	})
 
+	t.Run("SuccessCreateReply", func(t *testing.T) {
+		parentID := handlersMock.DomainID()
+		body, _ := json.Marshal(CreateInput{Text: "reply", ParentID: &parentID})
+		c, rec := prepareCreateQuery(itoaImageID, bytes.NewBuffer(body))
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockCommentUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, cmt *domain.Comment, _ *domain.User) (*domain.Comment, error) {
+				assert.Equal(t, imageID, cmt.ImageID)
+				if assert.NotNil(t, cmt.ParentID) {
+					assert.Equal(t, parentID, *cmt.ParentID)
+				}
+				return cmt, nil
+			})
+
+		assert.NoError(t, h.Create()(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
 	t.Run("IncorrectUserContext", func(t *testing.T) {
 		body, _ := json.Marshal(validCreateInput)
 		c, rec := prepareCreateQuery(itoaImageID, bytes.NewBuffer(body))
 
-		mockCommentUC.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+		mockCommentUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
 
 		assert.NoError(t, h.Create()(c))
@@ -95,7 +115,7 @@ func TestCommentHandlers_Create(t *testing.T) {
 		body, _ := json.Marshal(validCreateInput)
 		c, rec := prepareCreateQuery("abc", bytes.NewBuffer(body))
 
-		mockCommentUC.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+		mockCommentUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		assert.NoError(t, h.Create()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -104,7 +124,7 @@ func TestCommentHandlers_Create(t *testing.T) {
 	t.Run("InvalidInput", func(t *testing.T) {
 		c, rec := prepareCreateQuery(itoaImageID, nil)
 
-		mockCommentUC.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+		mockCommentUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		assert.NoError(t, h.Create()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -118,7 +138,7 @@ func TestCommentHandlers_Create(t *testing.T) {
 		body, _ := json.Marshal(invalidInput)
 		c, rec := prepareCreateQuery(itoaImageID, bytes.NewBuffer(body))
 
-		mockCommentUC.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+		mockCommentUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		assert.NoError(t, h.Create()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -131,7 +151,7 @@ func TestCommentHandlers_Create(t *testing.T) {
 		mockCtxUser(c)
 		ctx := rest.GetEchoRequestCtx(c)
 
-		mockCommentUC.EXPECT().Create(ctx, gomock.Any()).Return(nil, errors.New("internal error"))
+		mockCommentUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(nil, errors.New("internal error"))
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
 
 		assert.NoError(t, h.Create()(c))
@@ -145,7 +165,7 @@ func TestCommentHandlers_Create(t *testing.T) {
 		mockCtxUser(c)
 		ctx := rest.GetEchoRequestCtx(c)
 
-		mockCommentUC.EXPECT().Create(ctx, gomock.Any()).Return(nil, usecase.ErrIncorrectImageRef)
+		mockCommentUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(nil, usecase.ErrIncorrectImageRef)
 
 		assert.NoError(t, h.Create()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -158,7 +178,7 @@ func TestCommentHandlers_Create(t *testing.T) {
 		mockCtxUser(c)
 		ctx := rest.GetEchoRequestCtx(c)
 
-		mockCommentUC.EXPECT().Create(ctx, gomock.Any()).Return(nil, usecase.ErrAlreadyExists)
+		mockCommentUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(nil, usecase.ErrAlreadyExists)
 
 		assert.NoError(t, h.Create()(c))
 		assert.Equal(t, http.StatusConflict, rec.Code)
@@ -184,6 +204,7 @@ func TestCommentHandlers_GetByImageID(t *testing.T) {
 		Limit int    `query:"limit"`
 		Page  int    `query:"page"`
 		Sort  string `query:"sort"`
+		View  string `query:"view"`
 	}
 
 	validImageCommentsQuery := &ImageCommentsQuery{
@@ -203,6 +224,9 @@ func TestCommentHandlers_GetByImageID(t *testing.T) {
 			params.Add("limit", strconv.Itoa(query.Limit))
 			params.Add("page", strconv.Itoa(query.Page))
 			params.Add("sort", query.Sort)
+			if query.View != "" {
+				params.Add("view", query.View)
+			}
 			req.URL.RawQuery = params.Encode()
 		}
 
@@ -233,7 +257,7 @@ func TestCommentHandlers_GetByImageID(t *testing.T) {
 
 		ctx := rest.GetEchoRequestCtx(c)
 		mockCommentUC.EXPECT().GetByImageID(
-			ctx, imageID, gomock.Any(), gomock.Any(),
+			ctx, imageID, gomock.Any(), gomock.Any(), domain.CommentTreeView, gomock.Any(),
 		).Return(pag, nil)
 
 		assert.NoError(t, h.GetByImageID()(c))
@@ -244,10 +268,45 @@ func TestCommentHandlers_GetByImageID(t *testing.T) {
 		assert.Equal(t, pag, actual)
 	})
 
+	t.Run("SuccessByImageID_FlatView", func(t *testing.T) {
+		c, rec := prepareGetByImageIDQuery(itoaImageID, &ImageCommentsQuery{
+			Limit: 10,
+			Page:  1,
+			Sort:  "newest",
+			View:  "flat",
+		})
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockCommentUC.EXPECT().GetByImageID(
+			ctx, imageID, gomock.Any(), domain.CommentNewestSort, domain.CommentFlatView, gomock.Any(),
+		).Return(&domain.Pagination[domain.DetailedComment]{}, nil)
+
+		assert.NoError(t, h.GetByImageID()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("InvalidView", func(t *testing.T) {
+		c, rec := prepareGetByImageIDQuery(itoaImageID, &ImageCommentsQuery{
+			Limit: 10,
+			Page:  1,
+			Sort:  "newest",
+			View:  "nested",
+		})
+
+		mockCommentUC.EXPECT().GetByImageID(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Times(0)
+
+		assert.NoError(t, h.GetByImageID()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
 	t.Run("InvalidQuery", func(t *testing.T) {
 		c, rec := prepareGetByImageIDQuery(itoaImageID, nil)
 
-		mockCommentUC.EXPECT().GetByImageID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockCommentUC.EXPECT().GetByImageID(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Times(0)
 
 		assert.NoError(t, h.GetByImageID()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -256,7 +315,9 @@ func TestCommentHandlers_GetByImageID(t *testing.T) {
 	t.Run("IncorrectImageID", func(t *testing.T) {
 		c, rec := prepareGetByImageIDQuery("abs", validImageCommentsQuery)
 
-		mockCommentUC.EXPECT().GetByImageID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockCommentUC.EXPECT().GetByImageID(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Times(0)
 
 		assert.NoError(t, h.GetByImageID()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -269,7 +330,9 @@ func TestCommentHandlers_GetByImageID(t *testing.T) {
 			Sort:  "",
 		})
 
-		mockCommentUC.EXPECT().GetByImageID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockCommentUC.EXPECT().GetByImageID(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Times(0)
 
 		assert.NoError(t, h.GetByImageID()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -280,7 +343,7 @@ func TestCommentHandlers_GetByImageID(t *testing.T) {
 
 		ctx := rest.GetEchoRequestCtx(c)
 		mockCommentUC.EXPECT().GetByImageID(
-			ctx, imageID, gomock.Any(), gomock.Any(),
+			ctx, imageID, gomock.Any(), gomock.Any(), domain.CommentTreeView, gomock.Any(),
 		).Return(nil, errors.New("server error"))
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
 
@@ -293,7 +356,7 @@ func TestCommentHandlers_GetByImageID(t *testing.T) {
 
 		ctx := rest.GetEchoRequestCtx(c)
 		mockCommentUC.EXPECT().GetByImageID(
-			ctx, imageID, gomock.Any(), gomock.Any(),
+			ctx, imageID, gomock.Any(), gomock.Any(), domain.CommentTreeView, gomock.Any(),
 		).Return(nil, usecase.ErrIncorrectImageRef)
 
 		assert.NoError(t, h.GetByImageID()(c))
@@ -305,7 +368,7 @@ func TestCommentHandlers_GetByImageID(t *testing.T) {
 
 		ctx := rest.GetEchoRequestCtx(c)
 		mockCommentUC.EXPECT().GetByImageID(
-			ctx, imageID, gomock.Any(), gomock.Any(),
+			ctx, imageID, gomock.Any(), gomock.Any(), domain.CommentTreeView, gomock.Any(),
 		).Return(nil, usecase.ErrUnprocessable)
 
 		assert.NoError(t, h.GetByImageID()(c))
@@ -586,6 +649,79 @@ func TestCommentHandlers_Delete(t *testing.T) {
 	})
 }
 
+func TestCommentHandlers_GetRevisions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCommentUC := handlersMock.NewMockCommentUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewCommentHandlers(mockCommentUC, mockLog)
+
+	e := echo.New()
+
+	commentID := handlersMock.DomainID()
+	itoaCommentID := commentID.String()
+
+	prepareGetRevisionsQuery := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/images/comments/:comment_id/revisions", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("comment_id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("SuccessGetRevisions", func(t *testing.T) {
+		c, rec := prepareGetRevisionsQuery(itoaCommentID)
+		mockCtxUser(c)
+
+		revisions := []domain.CommentRevision{{ID: 1, CommentID: commentID, Text: "before"}}
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockCommentUC.EXPECT().GetRevisions(ctx, commentID, mockUser).Return(revisions, nil)
+
+		assert.NoError(t, h.GetRevisions()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		actual := new([]domain.CommentRevision)
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), actual))
+		assert.Equal(t, revisions, *actual)
+	})
+
+	t.Run("IncorrectUserContext", func(t *testing.T) {
+		c, rec := prepareGetRevisionsQuery(itoaCommentID)
+
+		mockCommentUC.EXPECT().GetRevisions(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.GetRevisions()(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("IncorrectCommentID", func(t *testing.T) {
+		c, rec := prepareGetRevisionsQuery("abc")
+
+		mockCommentUC.EXPECT().GetRevisions(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.GetRevisions()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		c, rec := prepareGetRevisionsQuery(itoaCommentID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockCommentUC.EXPECT().GetRevisions(ctx, commentID, mockUser).Return(nil, usecase.ErrForbidden)
+
+		assert.NoError(t, h.GetRevisions()(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
+
 func TestCommentHandlers_GetReplies(t *testing.T) {
 	t.Parallel()
 
@@ -602,9 +738,10 @@ func TestCommentHandlers_GetReplies(t *testing.T) {
 	commentID := handlersMock.DomainID()
 	itoaCommentID := commentID.String()
 
-	prepareGetRepliesQuery := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+	prepareGetRepliesQuery := func(id string, query url.Values) (echo.Context, *httptest.ResponseRecorder) {
 		req := httptest.NewRequest(http.MethodGet, "/api/v1/comments/:comment_id", nil)
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.URL.RawQuery = query.Encode()
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 		c.SetParamNames("comment_id")
@@ -612,50 +749,68 @@ func TestCommentHandlers_GetReplies(t *testing.T) {
 		return c, rec
 	}
 
-	mockReplies := []domain.DetailedComment{
-		{
-			Comment: domain.Comment{ID: 1},
+	validQuery := url.Values{"limit": {"10"}, "page": {"1"}}
+	pagInput := &domain.PaginationInput{PerPage: 10, Page: 1}
+
+	mockReplies := &domain.Pagination[domain.DetailedComment]{
+		PaginationInput: *pagInput,
+		Items: []domain.DetailedComment{
+			{
+				Comment: domain.Comment{ID: 1},
+			},
 		},
+		Total: 1,
 	}
 
 	t.Run("SuccessGetReplies", func(t *testing.T) {
-		c, rec := prepareGetRepliesQuery(itoaCommentID)
+		c, rec := prepareGetRepliesQuery(itoaCommentID, validQuery)
 		mockCtxUser(c)
 
 		ctx := rest.GetEchoRequestCtx(c)
 		mockCommentUC.EXPECT().GetReplies(
-			ctx, commentID, &mockUser.ID,
+			ctx, commentID, pagInput, mockUser,
 		).Return(mockReplies, nil)
 
 		assert.NoError(t, h.GetReplies()(c))
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		actual := new([]domain.DetailedComment)
+		actual := new(domain.Pagination[domain.DetailedComment])
 		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), actual))
-		assert.Equal(t, mockReplies, *actual)
+		assert.Equal(t, mockReplies, actual)
 	})
 
 	t.Run("SuccessGetReplies_Guest", func(t *testing.T) {
-		c, rec := prepareGetRepliesQuery(itoaCommentID)
+		c, rec := prepareGetRepliesQuery(itoaCommentID, validQuery)
 
 		ctx := rest.GetEchoRequestCtx(c)
 		mockCommentUC.EXPECT().GetReplies(
-			ctx, commentID, nil,
+			ctx, commentID, pagInput, nil,
 		).Return(mockReplies, nil)
 
 		assert.NoError(t, h.GetReplies()(c))
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		actual := new([]domain.DetailedComment)
+		actual := new(domain.Pagination[domain.DetailedComment])
 		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), actual))
-		assert.Equal(t, mockReplies, *actual)
+		assert.Equal(t, mockReplies, actual)
 	})
 
 	t.Run("IncorrectCommentID", func(t *testing.T) {
-		c, rec := prepareGetRepliesQuery("")
+		c, rec := prepareGetRepliesQuery("", validQuery)
 
 		mockCommentUC.EXPECT().GetReplies(
-			gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Times(0)
+
+		assert.NoError(t, h.GetReplies()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		c, rec := prepareGetRepliesQuery(itoaCommentID, url.Values{"limit": {"1000"}, "page": {"0"}})
+
+		mockCommentUC.EXPECT().GetReplies(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 		).Times(0)
 
 		assert.NoError(t, h.GetReplies()(c))
@@ -663,11 +818,11 @@ func TestCommentHandlers_GetReplies(t *testing.T) {
 	})
 
 	t.Run("NotFound", func(t *testing.T) {
-		c, rec := prepareGetRepliesQuery(itoaCommentID)
+		c, rec := prepareGetRepliesQuery(itoaCommentID, validQuery)
 
 		ctx := rest.GetEchoRequestCtx(c)
 		mockCommentUC.EXPECT().GetReplies(
-			ctx, commentID, nil,
+			ctx, commentID, pagInput, nil,
 		).Return(nil, usecase.ErrNotFound)
 
 		assert.NoError(t, h.GetReplies()(c))
@@ -675,11 +830,11 @@ func TestCommentHandlers_GetReplies(t *testing.T) {
 	})
 
 	t.Run("InternalServerError", func(t *testing.T) {
-		c, rec := prepareGetRepliesQuery(itoaCommentID)
+		c, rec := prepareGetRepliesQuery(itoaCommentID, validQuery)
 
 		ctx := rest.GetEchoRequestCtx(c)
 		mockCommentUC.EXPECT().GetReplies(
-			ctx, commentID, nil,
+			ctx, commentID, pagInput, nil,
 		).Return(nil, errors.New("internal server error"))
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
 
@@ -853,3 +1008,172 @@ func TestCommentHandlers_UnlikeComment(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, rec.Code)
 	})
 }
+
+func TestCommentHandlers_PinComment(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCommentUC := handlersMock.NewMockCommentUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewCommentHandlers(mockCommentUC, mockLog)
+
+	e := echo.New()
+
+	commentID := handlersMock.DomainID()
+	itoaCommentID := commentID.String()
+
+	preparePinQuery := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/images/comments/:comment_id/pin", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("comment_id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("SuccessPin", func(t *testing.T) {
+		c, rec := preparePinQuery(itoaCommentID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockCommentUC.EXPECT().SetPinned(ctx, commentID, true, mockUser).Return(nil)
+
+		assert.NoError(t, h.PinComment()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("SuccessUnpin", func(t *testing.T) {
+		c, rec := preparePinQuery(itoaCommentID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockCommentUC.EXPECT().SetPinned(ctx, commentID, false, mockUser).Return(nil)
+
+		assert.NoError(t, h.UnpinComment()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		c, rec := preparePinQuery(itoaCommentID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockCommentUC.EXPECT().SetPinned(ctx, commentID, true, mockUser).Return(usecase.ErrForbidden)
+
+		assert.NoError(t, h.PinComment()(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("IncorrectUserContext", func(t *testing.T) {
+		c, rec := preparePinQuery(itoaCommentID)
+
+		mockCommentUC.EXPECT().SetPinned(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.PinComment()(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestCommentHandlers_HideComment(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCommentUC := handlersMock.NewMockCommentUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewCommentHandlers(mockCommentUC, mockLog)
+
+	e := echo.New()
+
+	commentID := handlersMock.DomainID()
+
+	t.Run("SuccessHide", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/images/comments/:comment_id/hide", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("comment_id")
+		c.SetParamValues(commentID.String())
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockCommentUC.EXPECT().SetHidden(ctx, commentID, true, mockUser).Return(nil)
+
+		assert.NoError(t, h.HideComment()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestCommentHandlers_LockComments(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCommentUC := handlersMock.NewMockCommentUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewCommentHandlers(mockCommentUC, mockLog)
+
+	e := echo.New()
+
+	imageID := handlersMock.DomainID()
+	itoaImageID := imageID.String()
+
+	prepareLockQuery := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/images/:image_id/comments/lock", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("image_id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("SuccessLock", func(t *testing.T) {
+		c, rec := prepareLockQuery(itoaImageID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockCommentUC.EXPECT().SetLocked(ctx, imageID, true, mockUser).Return(nil)
+
+		assert.NoError(t, h.LockComments()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("SuccessUnlock", func(t *testing.T) {
+		c, rec := prepareLockQuery(itoaImageID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockCommentUC.EXPECT().SetLocked(ctx, imageID, false, mockUser).Return(nil)
+
+		assert.NoError(t, h.UnlockComments()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("ImageNotFound", func(t *testing.T) {
+		c, rec := prepareLockQuery(itoaImageID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockCommentUC.EXPECT().SetLocked(ctx, imageID, true, mockUser).Return(usecase.ErrNotFound)
+
+		assert.NoError(t, h.LockComments()(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("IncorrectImageID", func(t *testing.T) {
+		c, rec := prepareLockQuery("bad")
+		mockCtxUser(c)
+
+		mockCommentUC.EXPECT().SetLocked(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.LockComments()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -17,14 +17,22 @@ type imageUseCase interface {
 	Create(ctx context.Context, image *domain.Image, file *domain.File, ext *domain.User) (*domain.Image, error)
 	Delete(ctx context.Context, id domain.ID, executor *domain.User) error
 	Similar(ctx context.Context, id domain.ID) ([]domain.ImageWithMeta, error)
-	GetDetailed(ctx context.Context, id domain.ID) (*domain.DetailedImage, error)
+	SearchSimilar(ctx context.Context, file *domain.File) ([]domain.ImageWithMeta, error)
+	Search(
+		ctx context.Context,
+		query string,
+		mode domain.ImageSearchMode,
+		pagInput *domain.PaginationInput,
+		executor *domain.User,
+	) (*domain.Pagination[domain.ImageWithMeta], error)
+	GetDetailed(ctx context.Context, id domain.ID, executor *domain.User) (*domain.DetailedImage, error)
 	Update(ctx context.Context, id domain.ID, image *domain.Image, executor *domain.User) (*domain.Image, error)
 	AddView(ctx context.Context, imageID domain.ID, userID *domain.ID) error
 	Discover(
-		ctx context.Context, pagInput *domain.PaginationInput, sort domain.ImageSortMethod,
+		ctx context.Context, pagInput *domain.PaginationInput, sort domain.ImageSortMethod, executor *domain.User,
 	) (*domain.Pagination[domain.ImageWithMeta], error)
 	Favorites(
-		ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput,
+		ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput, executor *domain.User,
 	) (*domain.Pagination[domain.ImageWithMeta], error)
 
 	States(ctx context.Context, imageID domain.ID, userID domain.ID) (*domain.ImageStates, error)
@@ -49,7 +57,7 @@ func (h *ImageHandlers) Upload() echo.HandlerFunc {
 		user, err := GetContextUser(c)
 		if err != nil {
 			h.logger.Errorf("Upload.GetContextUser: %v", err)
-			return err
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
 		}
 
 		fileHeader, err := rest.ReadEchoImage(c, "file")
@@ -125,6 +133,79 @@ func (h *ImageHandlers) Similar() echo.HandlerFunc {
 	}
 }
 
+func (h *ImageHandlers) SearchSimilar() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		fileHeader, err := rest.ReadEchoImage(c, "file")
+		if err != nil {
+			if restErr, ok := err.(*rest.Error); ok {
+				return c.JSON(restErr.Response())
+			}
+
+			h.logger.Errorf("SearchSimilar.ReadEchoImage: %v", err)
+			return c.JSON(rest.NewInternalServerError().Response())
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			h.logger.Errorf("SearchSimilar.Open: %v", err)
+			return c.JSON(rest.NewInternalServerError().Response())
+		}
+		defer file.Close()
+
+		dFile := &domain.File{
+			Reader: file,
+			Size:   fileHeader.Size,
+		}
+
+		images, err := h.uc.SearchSimilar(ctx, dFile)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "SearchSimilar")
+		}
+
+		return c.JSON(http.StatusOK, images)
+	}
+}
+
+func (h *ImageHandlers) Search() echo.HandlerFunc {
+	type searchQuery struct {
+		Query string `query:"q" validate:"required,gte=1,lte=256"`
+		Mode  string `query:"mode" validate:"omitempty,oneof=semantic text"`
+		Limit int    `query:"limit" validate:"required,gte=1,lte=100"`
+		Page  int    `query:"page" validate:"required,gte=1"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		query := new(searchQuery)
+		if err := rest.DecodeEchoBody(c, query); err != nil {
+			h.logger.Errorf("Search.DecodeQuery: %v", err)
+			return c.JSON(rest.NewBadRequestError("Search query has incorrect type").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Search query has incorrect type").Response())
+		}
+
+		mode := domain.ImageSearchMode(query.Mode)
+		if mode == "" {
+			mode = domain.ImageSearchSemantic
+		}
+
+		user, _ := c.Get("user").(*domain.User)
+
+		pagInput := &domain.PaginationInput{Page: query.Page, PerPage: query.Limit}
+		images, err := h.uc.Search(ctx, query.Query, mode, pagInput, user)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "Search")
+		}
+
+		return c.JSON(http.StatusOK, images)
+	}
+}
+
 func (h *ImageHandlers) GetDetailed() echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := rest.GetEchoRequestCtx(c)
@@ -134,13 +215,14 @@ func (h *ImageHandlers) GetDetailed() echo.HandlerFunc {
 			return c.JSON(rest.NewBadRequestError("Invalid image ID").Response())
 		}
 
-		img, err := h.uc.GetDetailed(ctx, imageID)
+		user, _ := GetContextUser(c)
+		img, err := h.uc.GetDetailed(ctx, imageID, user)
 		if err != nil {
 			return h.responseWithUseCaseErr(c, err, "GetDetailed")
 		}
 
 		var userID *domain.ID
-		if user, ok := c.Get("user").(*domain.User); ok {
+		if user != nil {
 			userID = &user.ID
 		}
 
@@ -242,7 +324,8 @@ func (h *ImageHandlers) GetDiscover() echo.HandlerFunc {
 		}
 
 		pagInput := &domain.PaginationInput{Page: query.Page, PerPage: query.Limit}
-		images, err := h.uc.Discover(ctx, pagInput, domain.ImageSortMethod(query.Sort))
+		user, _ := GetContextUser(c)
+		images, err := h.uc.Discover(ctx, pagInput, domain.ImageSortMethod(query.Sort), user)
 		if err != nil {
 			if errors.Is(err, usecase.ErrUnprocessable) {
 				return c.JSON(rest.NewBadRequestError("Discover query has incorrect type").Response())
@@ -280,7 +363,8 @@ func (h *ImageHandlers) Favorites() echo.HandlerFunc {
 		}
 
 		pagInput := &domain.PaginationInput{Page: query.Page, PerPage: query.Limit}
-		images, err := h.uc.Favorites(ctx, userId, pagInput)
+		user, _ := GetContextUser(c)
+		images, err := h.uc.Favorites(ctx, userId, pagInput, user)
 		if err != nil {
 			if errors.Is(err, usecase.ErrUnprocessable) {
 				return c.JSON(rest.NewBadRequestError("Discover query has incorrect type").Response())
@@ -148,7 +148,7 @@ func TestImageHandlers_Upload(t *testing.T) {
 
 			img := &domain.Image{Path: "anypath.png"}
 			ctx := rest.GetEchoRequestCtx(c)
-			mockImageUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(img, nil)
+			mockImageUC.EXPECT().Create(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(img, nil)
 
 			assert.NoError(t, h.Upload()(c))
 			assert.Equal(t, http.StatusCreated, rec.Code)
@@ -162,7 +162,7 @@ func TestImageHandlers_Upload(t *testing.T) {
 	t.Run("IncorrectUserContext", func(t *testing.T) {
 		c, rec := prepareUploadQuery(mockImages["image/jpeg"], "image/jpeg", "file")
 
-		mockImageUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockImageUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
 
 		assert.NoError(t, h.Upload()(c))
@@ -177,7 +177,7 @@ func TestImageHandlers_Upload(t *testing.T) {
 		c, rec := prepareUploadQuery(invalidInput, "text/plain", "file")
 		mockCtxUser(c)
 
-		mockImageUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockImageUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		assert.NoError(t, h.Upload()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -188,7 +188,7 @@ func TestImageHandlers_Upload(t *testing.T) {
 		c, rec := prepareUploadQuery(mockImages[ct], ct, "wrong")
 		mockCtxUser(c)
 
-		mockImageUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockImageUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		assert.NoError(t, h.Upload()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -207,8 +207,7 @@ func TestImageHandlers_Upload(t *testing.T) {
 		c, rec := prepareUploadQuery(invalidInput, ct, "file")
 		mockCtxUser(c)
 
-		mockImageUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+		mockImageUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		assert.NoError(t, h.Upload()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -218,7 +217,7 @@ func TestImageHandlers_Upload(t *testing.T) {
 		c, rec := prepareUploadQuery(mockImages["image/jpeg"], "image/jpeg", "file")
 		mockCtxUser(c)
 
-		mockImageUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("internal error"))
+		mockImageUC.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("internal error"))
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
 
 		assert.NoError(t, h.Upload()(c))
@@ -343,7 +342,7 @@ func TestImageHandlers_Similar(t *testing.T) {
 		return c, rec
 	}
 
-	images := []domain.ImageWithAuthor{
+	images := []domain.ImageWithMeta{
 		{
 			Image: domain.Image{ID: 1, Path: "path.png"},
 		},
@@ -357,7 +356,7 @@ func TestImageHandlers_Similar(t *testing.T) {
 		assert.NoError(t, h.Similar()(c))
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		actual := &[]domain.ImageWithAuthor{}
+		actual := &[]domain.ImageWithMeta{}
 		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), actual))
 		assert.Equal(t, images, *actual)
 	})
@@ -392,6 +391,189 @@ func TestImageHandlers_Similar(t *testing.T) {
 	})
 }
 
+func TestImageHandlers_SearchSimilar(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := handlersMock.NewMockimageUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+
+	h := handlers.NewImageHandlers(mockImageUC, mockLog)
+	e := echo.New()
+
+	prepareSearchQuery := func(data []byte, mime string, field string) (echo.Context, *httptest.ResponseRecorder) {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		defer writer.Close()
+
+		imageHeader := make(textproto.MIMEHeader)
+		imageHeader.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="query"`, field))
+		imageHeader.Set("Content-Type", mime)
+
+		part, err := writer.CreatePart(imageHeader)
+		if err != nil {
+			t.Fatalf("failed to create part of multipart.writer: %v", err)
+		}
+
+		if _, err := part.Write(data); err != nil {
+			t.Fatalf("failed to write part to multipart section; %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/images/search/similar", body)
+		req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		return c, rec
+	}
+
+	imageData := []byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A}
+	images := []domain.ImageWithMeta{
+		{
+			Image: domain.Image{ID: 1, Path: "path.png"},
+		},
+	}
+
+	t.Run("SuccessSearchSimilar", func(t *testing.T) {
+		c, rec := prepareSearchQuery(imageData, "image/png", "file")
+		ctx := rest.GetEchoRequestCtx(c)
+
+		mockImageUC.EXPECT().SearchSimilar(ctx, gomock.Any()).Return(images, nil)
+		assert.NoError(t, h.SearchSimilar()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		actual := &[]domain.ImageWithMeta{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), actual))
+		assert.Equal(t, images, *actual)
+	})
+
+	t.Run("IncorrectFormField", func(t *testing.T) {
+		c, rec := prepareSearchQuery(imageData, "image/png", "wrong")
+
+		mockImageUC.EXPECT().SearchSimilar(gomock.Any(), gomock.Any()).Times(0)
+		assert.NoError(t, h.SearchSimilar()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("IncorrectContentType", func(t *testing.T) {
+		c, rec := prepareSearchQuery([]byte("plain text"), "text/plain", "file")
+
+		mockImageUC.EXPECT().SearchSimilar(gomock.Any(), gomock.Any()).Times(0)
+		assert.NoError(t, h.SearchSimilar()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Unprocessable", func(t *testing.T) {
+		c, rec := prepareSearchQuery(imageData, "image/png", "file")
+
+		mockImageUC.EXPECT().SearchSimilar(gomock.Any(), gomock.Any()).Return(nil, usecase.ErrUnprocessable)
+		assert.NoError(t, h.SearchSimilar()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("InternalServerError", func(t *testing.T) {
+		c, rec := prepareSearchQuery(imageData, "image/png", "file")
+
+		mockImageUC.EXPECT().SearchSimilar(gomock.Any(), gomock.Any()).Return(nil, errors.New("internal error"))
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.SearchSimilar()(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestImageHandlers_Search(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := handlersMock.NewMockimageUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	h := handlers.NewImageHandlers(mockImageUC, mockLog)
+
+	e := echo.New()
+
+	prepareSearchQuery := func(query map[string]string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/images/search", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		q := req.URL.Query()
+		for key, value := range query {
+			q.Add(key, value)
+		}
+		req.URL.RawQuery = q.Encode()
+
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		return c, rec
+	}
+
+	pagInput := &domain.PaginationInput{Page: 1, PerPage: 10}
+	pag := &domain.Pagination[domain.ImageWithMeta]{
+		PaginationInput: *pagInput,
+		Items: []domain.ImageWithMeta{
+			{Image: domain.Image{ID: 1, Path: "path.png"}},
+		},
+		Total: 1,
+	}
+
+	t.Run("SuccessSearch_DefaultMode", func(t *testing.T) {
+		c, rec := prepareSearchQuery(map[string]string{"q": "red car", "page": "1", "limit": "10"})
+		ctx := rest.GetEchoRequestCtx(c)
+
+		mockImageUC.EXPECT().Search(ctx, "red car", domain.ImageSearchSemantic, pagInput, nil).Return(pag, nil)
+
+		assert.NoError(t, h.Search()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		actual := new(domain.Pagination[domain.ImageWithMeta])
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), actual))
+		assert.Equal(t, pag, actual)
+	})
+
+	t.Run("SuccessSearch_TextMode", func(t *testing.T) {
+		c, rec := prepareSearchQuery(map[string]string{"q": "car", "mode": "text", "page": "1", "limit": "10"})
+		mockCtxUser, _ := handlersMock.NewMockCtxUser()
+		c.Set("user", mockCtxUser)
+		ctx := rest.GetEchoRequestCtx(c)
+
+		mockImageUC.EXPECT().Search(ctx, "car", domain.ImageSearchText, pagInput, mockCtxUser).Return(pag, nil)
+
+		assert.NoError(t, h.Search()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		invalidQueries := []map[string]string{
+			{"page": "1", "limit": "10"},
+			{"q": "car", "mode": "fuzzy", "page": "1", "limit": "10"},
+			{"q": "car", "page": "0", "limit": "10"},
+			{"q": "car", "page": "1", "limit": "1000"},
+		}
+
+		for _, query := range invalidQueries {
+			c, rec := prepareSearchQuery(query)
+			mockImageUC.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			assert.NoError(t, h.Search()(c))
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("InternalServerError", func(t *testing.T) {
+		c, rec := prepareSearchQuery(map[string]string{"q": "car", "page": "1", "limit": "10"})
+
+		mockImageUC.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("internal error"))
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.Search()(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
 func TestImageHandlers_GetDetailed(t *testing.T) {
 	t.Parallel()
 
@@ -419,7 +601,7 @@ func TestImageHandlers_GetDetailed(t *testing.T) {
 	}
 
 	img := &domain.DetailedImage{
-		ImageWithAuthor: domain.ImageWithAuthor{
+		ImageWithMeta: domain.ImageWithMeta{
 			Image: domain.Image{ID: 1, Path: "path.png"},
 		},
 	}
@@ -428,7 +610,7 @@ func TestImageHandlers_GetDetailed(t *testing.T) {
 		c, rec := prepareGetDetailedQuery(itoaImageID)
 		ctx := rest.GetEchoRequestCtx(c)
 
-		mockImageUC.EXPECT().GetDetailed(ctx, imageID).Return(img, nil)
+		mockImageUC.EXPECT().GetDetailed(ctx, imageID, gomock.Any()).Return(img, nil)
 		mockImageUC.EXPECT().AddView(ctx, gomock.Any(), gomock.Any())
 
 		assert.NoError(t, h.GetDetailed()(c))
@@ -444,7 +626,7 @@ func TestImageHandlers_GetDetailed(t *testing.T) {
 		mockCtxUser(c)
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockImageUC.EXPECT().GetDetailed(ctx, imageID).Return(img, nil)
+		mockImageUC.EXPECT().GetDetailed(ctx, imageID, gomock.Any()).Return(img, nil)
 
 		mockImageUC.EXPECT().AddView(ctx, imageID, &ctxUser.ID)
 
@@ -456,7 +638,7 @@ func TestImageHandlers_GetDetailed(t *testing.T) {
 		c, rec := prepareGetDetailedQuery(itoaImageID)
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockImageUC.EXPECT().GetDetailed(ctx, imageID).Return(img, nil)
+		mockImageUC.EXPECT().GetDetailed(ctx, imageID, gomock.Any()).Return(img, nil)
 
 		mockImageUC.EXPECT().AddView(ctx, imageID, nil)
 
@@ -468,7 +650,7 @@ func TestImageHandlers_GetDetailed(t *testing.T) {
 		c, rec := prepareGetDetailedQuery(itoaImageID)
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockImageUC.EXPECT().GetDetailed(ctx, imageID).Return(img, nil)
+		mockImageUC.EXPECT().GetDetailed(ctx, imageID, gomock.Any()).Return(img, nil)
 
 		mockImageUC.EXPECT().AddView(ctx, gomock.Any(), gomock.Any()).Return(errors.New("any error"))
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
@@ -488,7 +670,7 @@ func TestImageHandlers_GetDetailed(t *testing.T) {
 		c, rec := prepareGetDetailedQuery(itoaImageID)
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockImageUC.EXPECT().GetDetailed(ctx, imageID).Return(nil, usecase.ErrNotFound)
+		mockImageUC.EXPECT().GetDetailed(ctx, imageID, gomock.Any()).Return(nil, usecase.ErrNotFound)
 
 		assert.NoError(t, h.GetDetailed()(c))
 		assert.Equal(t, http.StatusNotFound, rec.Code)
@@ -748,12 +930,12 @@ func TestImageHandlers_GetDiscover(t *testing.T) {
 		Sort:    domain.ImagePopularSort,
 	}
 
-	pag := &domain.Pagination[domain.ImageWithAuthor]{
+	pag := &domain.Pagination[domain.ImageWithMeta]{
 		PaginationInput: domain.PaginationInput{
 			Page:    1,
 			PerPage: 10,
 		},
-		Items: []domain.ImageWithAuthor{
+		Items: []domain.ImageWithMeta{
 			{
 				Image: domain.Image{
 					ID:   1,
@@ -768,21 +950,33 @@ func TestImageHandlers_GetDiscover(t *testing.T) {
 		c, rec := prepareGetStatesQuery(validDiscoverInput)
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockImageUC.EXPECT().Discover(ctx, pagInput, validDiscoverInput.Sort).Return(pag, nil)
+		mockImageUC.EXPECT().Discover(ctx, pagInput, validDiscoverInput.Sort, nil).Return(pag, nil)
 
 		assert.NoError(t, h.GetDiscover()(c))
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		actual := new(domain.Pagination[domain.ImageWithAuthor])
+		actual := new(domain.Pagination[domain.ImageWithMeta])
 		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), actual))
 		assert.Equal(t, pag, actual)
 	})
 
+	t.Run("SuccessGetDiscover_Viewer", func(t *testing.T) {
+		c, rec := prepareGetStatesQuery(validDiscoverInput)
+		mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockImageUC.EXPECT().Discover(ctx, pagInput, validDiscoverInput.Sort, mockUser).Return(pag, nil)
+
+		assert.NoError(t, h.GetDiscover()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
 	t.Run("IncorrectInput", func(t *testing.T) {
 		c, rec := prepareGetStatesQuery(nil)
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockImageUC.EXPECT().Discover(ctx, gomock.Any(), gomock.Any()).Times(0)
+		mockImageUC.EXPECT().Discover(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		assert.NoError(t, h.GetDiscover()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -792,7 +986,7 @@ func TestImageHandlers_GetDiscover(t *testing.T) {
 		c, rec := prepareGetStatesQuery(validDiscoverInput)
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockImageUC.EXPECT().Discover(ctx, gomock.Any(), gomock.Any()).Return(nil, usecase.ErrUnprocessable)
+		mockImageUC.EXPECT().Discover(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, usecase.ErrUnprocessable)
 
 		assert.NoError(t, h.GetDiscover()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -802,7 +996,7 @@ func TestImageHandlers_GetDiscover(t *testing.T) {
 		c, rec := prepareGetStatesQuery(validDiscoverInput)
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockImageUC.EXPECT().Discover(ctx, gomock.Any(), gomock.Any()).Return(nil, errors.New("internal error"))
+		mockImageUC.EXPECT().Discover(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("internal error"))
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
 
 		assert.NoError(t, h.GetDiscover()(c))
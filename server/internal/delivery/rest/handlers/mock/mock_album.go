@@ -40,19 +40,33 @@ func (m *MockalbumUseCase) EXPECT() *MockalbumUseCaseMockRecorder {
 	return m.recorder
 }
 
+// AcceptInvitation mocks base method.
+func (m *MockalbumUseCase) AcceptInvitation(ctx context.Context, albumID domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", ctx, albumID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockalbumUseCaseMockRecorder) AcceptInvitation(ctx, albumID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockalbumUseCase)(nil).AcceptInvitation), ctx, albumID, executor)
+}
+
 // Create mocks base method.
-func (m *MockalbumUseCase) Create(ctx context.Context, album *domain.Album) (*domain.Album, error) {
+func (m *MockalbumUseCase) Create(ctx context.Context, album *domain.Album, executor *domain.User) (*domain.Album, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, album)
+	ret := m.ctrl.Call(m, "Create", ctx, album, executor)
 	ret0, _ := ret[0].(*domain.Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockalbumUseCaseMockRecorder) Create(ctx, album any) *gomock.Call {
+func (mr *MockalbumUseCaseMockRecorder) Create(ctx, album, executor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockalbumUseCase)(nil).Create), ctx, album)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockalbumUseCase)(nil).Create), ctx, album, executor)
 }
 
 // Delete mocks base method.
@@ -83,34 +97,106 @@ func (mr *MockalbumUseCaseMockRecorder) DeleteImage(ctx, albumID, imageID, execu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockalbumUseCase)(nil).DeleteImage), ctx, albumID, imageID, executor)
 }
 
+// Follow mocks base method.
+func (m *MockalbumUseCase) Follow(ctx context.Context, albumID domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, albumID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockalbumUseCaseMockRecorder) Follow(ctx, albumID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockalbumUseCase)(nil).Follow), ctx, albumID, executor)
+}
+
 // GetAlbumImages mocks base method.
-func (m *MockalbumUseCase) GetAlbumImages(ctx context.Context, albumID domain.ID, pagInput *domain.PaginationInput) (*domain.Pagination[domain.ImageWithAuthor], error) {
+func (m *MockalbumUseCase) GetAlbumImages(ctx context.Context, albumID domain.ID, pagInput *domain.PaginationInput, sort domain.AlbumImageSortMethod, executor *domain.User, shareToken string) (*domain.Pagination[domain.ImageWithMeta], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAlbumImages", ctx, albumID, pagInput)
-	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithAuthor])
+	ret := m.ctrl.Call(m, "GetAlbumImages", ctx, albumID, pagInput, sort, executor, shareToken)
+	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithMeta])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAlbumImages indicates an expected call of GetAlbumImages.
-func (mr *MockalbumUseCaseMockRecorder) GetAlbumImages(ctx, albumID, pagInput any) *gomock.Call {
+func (mr *MockalbumUseCaseMockRecorder) GetAlbumImages(ctx, albumID, pagInput, sort, executor, shareToken any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumImages", reflect.TypeOf((*MockalbumUseCase)(nil).GetAlbumImages), ctx, albumID, pagInput)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumImages", reflect.TypeOf((*MockalbumUseCase)(nil).GetAlbumImages), ctx, albumID, pagInput, sort, executor, shareToken)
 }
 
 // GetByAuthorID mocks base method.
-func (m *MockalbumUseCase) GetByAuthorID(ctx context.Context, authorID domain.ID) ([]domain.DetailedAlbum, error) {
+func (m *MockalbumUseCase) GetByAuthorID(ctx context.Context, authorID domain.ID, executor *domain.User) ([]domain.DetailedAlbum, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByAuthorID", ctx, authorID)
+	ret := m.ctrl.Call(m, "GetByAuthorID", ctx, authorID, executor)
 	ret0, _ := ret[0].([]domain.DetailedAlbum)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByAuthorID indicates an expected call of GetByAuthorID.
-func (mr *MockalbumUseCaseMockRecorder) GetByAuthorID(ctx, authorID any) *gomock.Call {
+func (mr *MockalbumUseCaseMockRecorder) GetByAuthorID(ctx, authorID, executor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthorID", reflect.TypeOf((*MockalbumUseCase)(nil).GetByAuthorID), ctx, authorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthorID", reflect.TypeOf((*MockalbumUseCase)(nil).GetByAuthorID), ctx, authorID, executor)
+}
+
+// GetFollowed mocks base method.
+func (m *MockalbumUseCase) GetFollowed(ctx context.Context, executor *domain.User) ([]domain.DetailedAlbum, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowed", ctx, executor)
+	ret0, _ := ret[0].([]domain.DetailedAlbum)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowed indicates an expected call of GetFollowed.
+func (mr *MockalbumUseCaseMockRecorder) GetFollowed(ctx, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowed", reflect.TypeOf((*MockalbumUseCase)(nil).GetFollowed), ctx, executor)
+}
+
+// GetMembers mocks base method.
+func (m *MockalbumUseCase) GetMembers(ctx context.Context, albumID domain.ID, executor *domain.User) ([]domain.AlbumMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembers", ctx, albumID, executor)
+	ret0, _ := ret[0].([]domain.AlbumMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembers indicates an expected call of GetMembers.
+func (mr *MockalbumUseCaseMockRecorder) GetMembers(ctx, albumID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockalbumUseCase)(nil).GetMembers), ctx, albumID, executor)
+}
+
+// InviteMember mocks base method.
+func (m *MockalbumUseCase) InviteMember(ctx context.Context, albumID, userID domain.ID, role domain.AlbumRole, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteMember", ctx, albumID, userID, role, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InviteMember indicates an expected call of InviteMember.
+func (mr *MockalbumUseCaseMockRecorder) InviteMember(ctx, albumID, userID, role, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteMember", reflect.TypeOf((*MockalbumUseCase)(nil).InviteMember), ctx, albumID, userID, role, executor)
+}
+
+// MoveImage mocks base method.
+func (m *MockalbumUseCase) MoveImage(ctx context.Context, albumID, imageID domain.ID, position int, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveImage", ctx, albumID, imageID, position, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveImage indicates an expected call of MoveImage.
+func (mr *MockalbumUseCaseMockRecorder) MoveImage(ctx, albumID, imageID, position, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveImage", reflect.TypeOf((*MockalbumUseCase)(nil).MoveImage), ctx, albumID, imageID, position, executor)
 }
 
 // PutImage mocks base method.
@@ -127,6 +213,91 @@ func (mr *MockalbumUseCaseMockRecorder) PutImage(ctx, albumID, imageID, executor
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImage", reflect.TypeOf((*MockalbumUseCase)(nil).PutImage), ctx, albumID, imageID, executor)
 }
 
+// RemoveMember mocks base method.
+func (m *MockalbumUseCase) RemoveMember(ctx context.Context, albumID, userID domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, albumID, userID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockalbumUseCaseMockRecorder) RemoveMember(ctx, albumID, userID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockalbumUseCase)(nil).RemoveMember), ctx, albumID, userID, executor)
+}
+
+// RevokeShare mocks base method.
+func (m *MockalbumUseCase) RevokeShare(ctx context.Context, albumID domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShare", ctx, albumID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeShare indicates an expected call of RevokeShare.
+func (mr *MockalbumUseCaseMockRecorder) RevokeShare(ctx, albumID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShare", reflect.TypeOf((*MockalbumUseCase)(nil).RevokeShare), ctx, albumID, executor)
+}
+
+// SetCover mocks base method.
+func (m *MockalbumUseCase) SetCover(ctx context.Context, albumID domain.ID, imageID *domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCover", ctx, albumID, imageID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCover indicates an expected call of SetCover.
+func (mr *MockalbumUseCaseMockRecorder) SetCover(ctx, albumID, imageID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCover", reflect.TypeOf((*MockalbumUseCase)(nil).SetCover), ctx, albumID, imageID, executor)
+}
+
+// SetImagesOrder mocks base method.
+func (m *MockalbumUseCase) SetImagesOrder(ctx context.Context, albumID domain.ID, imageIDs []domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetImagesOrder", ctx, albumID, imageIDs, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetImagesOrder indicates an expected call of SetImagesOrder.
+func (mr *MockalbumUseCaseMockRecorder) SetImagesOrder(ctx, albumID, imageIDs, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetImagesOrder", reflect.TypeOf((*MockalbumUseCase)(nil).SetImagesOrder), ctx, albumID, imageIDs, executor)
+}
+
+// Share mocks base method.
+func (m *MockalbumUseCase) Share(ctx context.Context, albumID domain.ID, executor *domain.User) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Share", ctx, albumID, executor)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Share indicates an expected call of Share.
+func (mr *MockalbumUseCaseMockRecorder) Share(ctx, albumID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Share", reflect.TypeOf((*MockalbumUseCase)(nil).Share), ctx, albumID, executor)
+}
+
+// Unfollow mocks base method.
+func (m *MockalbumUseCase) Unfollow(ctx context.Context, albumID domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, albumID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow.
+func (mr *MockalbumUseCaseMockRecorder) Unfollow(ctx, albumID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockalbumUseCase)(nil).Unfollow), ctx, albumID, executor)
+}
+
 // Update mocks base method.
 func (m *MockalbumUseCase) Update(ctx context.Context, albumID domain.ID, album *domain.Album, executor *domain.User) (*domain.Album, error) {
 	m.ctrl.T.Helper()
@@ -141,3 +312,17 @@ func (mr *MockalbumUseCaseMockRecorder) Update(ctx, albumID, album, executor any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockalbumUseCase)(nil).Update), ctx, albumID, album, executor)
 }
+
+// UpdateMemberRole mocks base method.
+func (m *MockalbumUseCase) UpdateMemberRole(ctx context.Context, albumID, userID domain.ID, role domain.AlbumRole, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberRole", ctx, albumID, userID, role, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMemberRole indicates an expected call of UpdateMemberRole.
+func (mr *MockalbumUseCaseMockRecorder) UpdateMemberRole(ctx, albumID, userID, role, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberRole", reflect.TypeOf((*MockalbumUseCase)(nil).UpdateMemberRole), ctx, albumID, userID, role, executor)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/rest/handlers/album_export.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/rest/handlers/album_export.go -destination=./internal/delivery/rest/handlers/mock/mock_album_export.go
+//
+
+// Package mock_handlers is a generated GoMock package.
+package mock_handlers
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	domain "github.com/pillowskiy/gopix/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockalbumExportUseCase is a mock of albumExportUseCase interface.
+type MockalbumExportUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockalbumExportUseCaseMockRecorder
+}
+
+// MockalbumExportUseCaseMockRecorder is the mock recorder for MockalbumExportUseCase.
+type MockalbumExportUseCaseMockRecorder struct {
+	mock *MockalbumExportUseCase
+}
+
+// NewMockalbumExportUseCase creates a new mock instance.
+func NewMockalbumExportUseCase(ctrl *gomock.Controller) *MockalbumExportUseCase {
+	mock := &MockalbumExportUseCase{ctrl: ctrl}
+	mock.recorder = &MockalbumExportUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockalbumExportUseCase) EXPECT() *MockalbumExportUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockalbumExportUseCase) Export(ctx context.Context, albumID domain.ID, executor *domain.User, shareToken string) (*domain.AlbumExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, albumID, executor, shareToken)
+	ret0, _ := ret[0].(*domain.AlbumExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockalbumExportUseCaseMockRecorder) Export(ctx, albumID, executor, shareToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockalbumExportUseCase)(nil).Export), ctx, albumID, executor, shareToken)
+}
+
+// ExportAsync mocks base method.
+func (m *MockalbumExportUseCase) ExportAsync(ctx context.Context, albumID domain.ID, executor *domain.User, shareToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAsync", ctx, albumID, executor, shareToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportAsync indicates an expected call of ExportAsync.
+func (mr *MockalbumExportUseCaseMockRecorder) ExportAsync(ctx, albumID, executor, shareToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAsync", reflect.TypeOf((*MockalbumExportUseCase)(nil).ExportAsync), ctx, albumID, executor, shareToken)
+}
+
+// WriteArchive mocks base method.
+func (m *MockalbumExportUseCase) WriteArchive(ctx context.Context, export *domain.AlbumExport, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteArchive", ctx, export, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteArchive indicates an expected call of WriteArchive.
+func (mr *MockalbumExportUseCaseMockRecorder) WriteArchive(ctx, export, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteArchive", reflect.TypeOf((*MockalbumExportUseCase)(nil).WriteArchive), ctx, export, w)
+}
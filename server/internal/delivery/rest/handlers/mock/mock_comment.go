@@ -41,18 +41,18 @@ func (m *MockCommentUseCase) EXPECT() *MockCommentUseCaseMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockCommentUseCase) Create(ctx context.Context, comment *domain.Comment) (*domain.Comment, error) {
+func (m *MockCommentUseCase) Create(ctx context.Context, comment *domain.Comment, executor *domain.User) (*domain.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, comment)
+	ret := m.ctrl.Call(m, "Create", ctx, comment, executor)
 	ret0, _ := ret[0].(*domain.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockCommentUseCaseMockRecorder) Create(ctx, comment any) *gomock.Call {
+func (mr *MockCommentUseCaseMockRecorder) Create(ctx, comment, executor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCommentUseCase)(nil).Create), ctx, comment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCommentUseCase)(nil).Create), ctx, comment, executor)
 }
 
 // Delete mocks base method.
@@ -70,33 +70,48 @@ func (mr *MockCommentUseCaseMockRecorder) Delete(ctx, commentID, executor any) *
 }
 
 // GetByImageID mocks base method.
-func (m *MockCommentUseCase) GetByImageID(ctx context.Context, imageID domain.ID, pagInput *domain.PaginationInput, sort domain.CommentSortMethod) (*domain.Pagination[domain.DetailedComment], error) {
+func (m *MockCommentUseCase) GetByImageID(ctx context.Context, imageID domain.ID, pagInput *domain.PaginationInput, sort domain.CommentSortMethod, mode domain.CommentViewMode, executor *domain.User) (*domain.Pagination[domain.DetailedComment], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByImageID", ctx, imageID, pagInput, sort)
+	ret := m.ctrl.Call(m, "GetByImageID", ctx, imageID, pagInput, sort, mode, executor)
 	ret0, _ := ret[0].(*domain.Pagination[domain.DetailedComment])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByImageID indicates an expected call of GetByImageID.
-func (mr *MockCommentUseCaseMockRecorder) GetByImageID(ctx, imageID, pagInput, sort any) *gomock.Call {
+func (mr *MockCommentUseCaseMockRecorder) GetByImageID(ctx, imageID, pagInput, sort, mode, executor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByImageID", reflect.TypeOf((*MockCommentUseCase)(nil).GetByImageID), ctx, imageID, pagInput, sort)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByImageID", reflect.TypeOf((*MockCommentUseCase)(nil).GetByImageID), ctx, imageID, pagInput, sort, mode, executor)
 }
 
 // GetReplies mocks base method.
-func (m *MockCommentUseCase) GetReplies(ctx context.Context, commentID domain.ID, executorID *domain.ID) ([]domain.DetailedComment, error) {
+func (m *MockCommentUseCase) GetReplies(ctx context.Context, commentID domain.ID, pagInput *domain.PaginationInput, executor *domain.User) (*domain.Pagination[domain.DetailedComment], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReplies", ctx, commentID, executorID)
-	ret0, _ := ret[0].([]domain.DetailedComment)
+	ret := m.ctrl.Call(m, "GetReplies", ctx, commentID, pagInput, executor)
+	ret0, _ := ret[0].(*domain.Pagination[domain.DetailedComment])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReplies indicates an expected call of GetReplies.
-func (mr *MockCommentUseCaseMockRecorder) GetReplies(ctx, commentID, executorID any) *gomock.Call {
+func (mr *MockCommentUseCaseMockRecorder) GetReplies(ctx, commentID, pagInput, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplies", reflect.TypeOf((*MockCommentUseCase)(nil).GetReplies), ctx, commentID, pagInput, executor)
+}
+
+// GetRevisions mocks base method.
+func (m *MockCommentUseCase) GetRevisions(ctx context.Context, commentID domain.ID, executor *domain.User) ([]domain.CommentRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, commentID, executor)
+	ret0, _ := ret[0].([]domain.CommentRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockCommentUseCaseMockRecorder) GetRevisions(ctx, commentID, executor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplies", reflect.TypeOf((*MockCommentUseCase)(nil).GetReplies), ctx, commentID, executorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockCommentUseCase)(nil).GetRevisions), ctx, commentID, executor)
 }
 
 // LikeComment mocks base method.
@@ -113,6 +128,48 @@ func (mr *MockCommentUseCaseMockRecorder) LikeComment(ctx, commentID, executor a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeComment", reflect.TypeOf((*MockCommentUseCase)(nil).LikeComment), ctx, commentID, executor)
 }
 
+// SetHidden mocks base method.
+func (m *MockCommentUseCase) SetHidden(ctx context.Context, commentID domain.ID, hidden bool, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHidden", ctx, commentID, hidden, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHidden indicates an expected call of SetHidden.
+func (mr *MockCommentUseCaseMockRecorder) SetHidden(ctx, commentID, hidden, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHidden", reflect.TypeOf((*MockCommentUseCase)(nil).SetHidden), ctx, commentID, hidden, executor)
+}
+
+// SetLocked mocks base method.
+func (m *MockCommentUseCase) SetLocked(ctx context.Context, imageID domain.ID, locked bool, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLocked", ctx, imageID, locked, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLocked indicates an expected call of SetLocked.
+func (mr *MockCommentUseCaseMockRecorder) SetLocked(ctx, imageID, locked, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLocked", reflect.TypeOf((*MockCommentUseCase)(nil).SetLocked), ctx, imageID, locked, executor)
+}
+
+// SetPinned mocks base method.
+func (m *MockCommentUseCase) SetPinned(ctx context.Context, commentID domain.ID, pinned bool, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPinned", ctx, commentID, pinned, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPinned indicates an expected call of SetPinned.
+func (mr *MockCommentUseCaseMockRecorder) SetPinned(ctx, commentID, pinned, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPinned", reflect.TypeOf((*MockCommentUseCase)(nil).SetPinned), ctx, commentID, pinned, executor)
+}
+
 // UnlikeComment mocks base method.
 func (m *MockCommentUseCase) UnlikeComment(ctx context.Context, commentID domain.ID, executor *domain.User) error {
 	m.ctrl.T.Helper()
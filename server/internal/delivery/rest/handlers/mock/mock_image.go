@@ -69,18 +69,18 @@ func (mr *MockimageUseCaseMockRecorder) AddView(ctx, imageID, userID any) *gomoc
 }
 
 // Create mocks base method.
-func (m *MockimageUseCase) Create(ctx context.Context, image *domain.Image, file *domain.FileNode) (*domain.Image, error) {
+func (m *MockimageUseCase) Create(ctx context.Context, image *domain.Image, file *domain.File, ext *domain.User) (*domain.Image, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, image, file)
+	ret := m.ctrl.Call(m, "Create", ctx, image, file, ext)
 	ret0, _ := ret[0].(*domain.Image)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockimageUseCaseMockRecorder) Create(ctx, image, file any) *gomock.Call {
+func (mr *MockimageUseCaseMockRecorder) Create(ctx, image, file, ext any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockimageUseCase)(nil).Create), ctx, image, file)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockimageUseCase)(nil).Create), ctx, image, file, ext)
 }
 
 // Delete mocks base method.
@@ -98,48 +98,48 @@ func (mr *MockimageUseCaseMockRecorder) Delete(ctx, id, executor any) *gomock.Ca
 }
 
 // Discover mocks base method.
-func (m *MockimageUseCase) Discover(ctx context.Context, pagInput *domain.PaginationInput, sort domain.ImageSortMethod) (*domain.Pagination[domain.ImageWithAuthor], error) {
+func (m *MockimageUseCase) Discover(ctx context.Context, pagInput *domain.PaginationInput, sort domain.ImageSortMethod, executor *domain.User) (*domain.Pagination[domain.ImageWithMeta], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Discover", ctx, pagInput, sort)
-	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithAuthor])
+	ret := m.ctrl.Call(m, "Discover", ctx, pagInput, sort, executor)
+	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithMeta])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Discover indicates an expected call of Discover.
-func (mr *MockimageUseCaseMockRecorder) Discover(ctx, pagInput, sort any) *gomock.Call {
+func (mr *MockimageUseCaseMockRecorder) Discover(ctx, pagInput, sort, executor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Discover", reflect.TypeOf((*MockimageUseCase)(nil).Discover), ctx, pagInput, sort)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Discover", reflect.TypeOf((*MockimageUseCase)(nil).Discover), ctx, pagInput, sort, executor)
 }
 
 // Favorites mocks base method.
-func (m *MockimageUseCase) Favorites(ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput) (*domain.Pagination[domain.ImageWithAuthor], error) {
+func (m *MockimageUseCase) Favorites(ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput, executor *domain.User) (*domain.Pagination[domain.ImageWithMeta], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Favorites", ctx, userID, pagInput)
-	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithAuthor])
+	ret := m.ctrl.Call(m, "Favorites", ctx, userID, pagInput, executor)
+	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithMeta])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Favorites indicates an expected call of Favorites.
-func (mr *MockimageUseCaseMockRecorder) Favorites(ctx, userID, pagInput any) *gomock.Call {
+func (mr *MockimageUseCaseMockRecorder) Favorites(ctx, userID, pagInput, executor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Favorites", reflect.TypeOf((*MockimageUseCase)(nil).Favorites), ctx, userID, pagInput)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Favorites", reflect.TypeOf((*MockimageUseCase)(nil).Favorites), ctx, userID, pagInput, executor)
 }
 
 // GetDetailed mocks base method.
-func (m *MockimageUseCase) GetDetailed(ctx context.Context, id domain.ID) (*domain.DetailedImage, error) {
+func (m *MockimageUseCase) GetDetailed(ctx context.Context, id domain.ID, executor *domain.User) (*domain.DetailedImage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDetailed", ctx, id)
+	ret := m.ctrl.Call(m, "GetDetailed", ctx, id, executor)
 	ret0, _ := ret[0].(*domain.DetailedImage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDetailed indicates an expected call of GetDetailed.
-func (mr *MockimageUseCaseMockRecorder) GetDetailed(ctx, id any) *gomock.Call {
+func (mr *MockimageUseCaseMockRecorder) GetDetailed(ctx, id, executor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetailed", reflect.TypeOf((*MockimageUseCase)(nil).GetDetailed), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetailed", reflect.TypeOf((*MockimageUseCase)(nil).GetDetailed), ctx, id, executor)
 }
 
 // RemoveLike mocks base method.
@@ -156,11 +156,41 @@ func (mr *MockimageUseCaseMockRecorder) RemoveLike(ctx, imageID, userID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLike", reflect.TypeOf((*MockimageUseCase)(nil).RemoveLike), ctx, imageID, userID)
 }
 
+// Search mocks base method.
+func (m *MockimageUseCase) Search(ctx context.Context, query string, mode domain.ImageSearchMode, pagInput *domain.PaginationInput, executor *domain.User) (*domain.Pagination[domain.ImageWithMeta], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query, mode, pagInput, executor)
+	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithMeta])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockimageUseCaseMockRecorder) Search(ctx, query, mode, pagInput, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockimageUseCase)(nil).Search), ctx, query, mode, pagInput, executor)
+}
+
+// SearchSimilar mocks base method.
+func (m *MockimageUseCase) SearchSimilar(ctx context.Context, file *domain.File) ([]domain.ImageWithMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSimilar", ctx, file)
+	ret0, _ := ret[0].([]domain.ImageWithMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSimilar indicates an expected call of SearchSimilar.
+func (mr *MockimageUseCaseMockRecorder) SearchSimilar(ctx, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSimilar", reflect.TypeOf((*MockimageUseCase)(nil).SearchSimilar), ctx, file)
+}
+
 // Similar mocks base method.
-func (m *MockimageUseCase) Similar(ctx context.Context, id domain.ID) ([]domain.ImageWithAuthor, error) {
+func (m *MockimageUseCase) Similar(ctx context.Context, id domain.ID) ([]domain.ImageWithMeta, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Similar", ctx, id)
-	ret0, _ := ret[0].([]domain.ImageWithAuthor)
+	ret0, _ := ret[0].([]domain.ImageWithMeta)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
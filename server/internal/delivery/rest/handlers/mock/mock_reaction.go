@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/rest/handlers/reaction.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/rest/handlers/reaction.go -destination=./internal/delivery/rest/handlers/mock/mock_reaction.go
+//
+
+// Package mock_handlers is a generated GoMock package.
+package mock_handlers
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/pillowskiy/gopix/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockreactionUseCase is a mock of reactionUseCase interface.
+type MockreactionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockreactionUseCaseMockRecorder
+}
+
+// MockreactionUseCaseMockRecorder is the mock recorder for MockreactionUseCase.
+type MockreactionUseCaseMockRecorder struct {
+	mock *MockreactionUseCase
+}
+
+// NewMockreactionUseCase creates a new mock instance.
+func NewMockreactionUseCase(ctrl *gomock.Controller) *MockreactionUseCase {
+	mock := &MockreactionUseCase{ctrl: ctrl}
+	mock.recorder = &MockreactionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreactionUseCase) EXPECT() *MockreactionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Emojis mocks base method.
+func (m *MockreactionUseCase) Emojis() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Emojis")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Emojis indicates an expected call of Emojis.
+func (mr *MockreactionUseCaseMockRecorder) Emojis() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Emojis", reflect.TypeOf((*MockreactionUseCase)(nil).Emojis))
+}
+
+// React mocks base method.
+func (m *MockreactionUseCase) React(ctx context.Context, reaction *domain.Reaction, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "React", ctx, reaction, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// React indicates an expected call of React.
+func (mr *MockreactionUseCaseMockRecorder) React(ctx, reaction, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "React", reflect.TypeOf((*MockreactionUseCase)(nil).React), ctx, reaction, executor)
+}
+
+// Unreact mocks base method.
+func (m *MockreactionUseCase) Unreact(ctx context.Context, reaction *domain.Reaction, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unreact", ctx, reaction, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unreact indicates an expected call of Unreact.
+func (mr *MockreactionUseCaseMockRecorder) Unreact(ctx, reaction, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unreact", reflect.TypeOf((*MockreactionUseCase)(nil).Unreact), ctx, reaction, executor)
+}
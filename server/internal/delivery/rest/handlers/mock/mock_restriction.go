@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/rest/handlers/restriction.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/rest/handlers/restriction.go -destination=./internal/delivery/rest/handlers/mock/mock_restriction.go
+//
+
+// Package mock_handlers is a generated GoMock package.
+package mock_handlers
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/pillowskiy/gopix/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockrestrictionUseCase is a mock of restrictionUseCase interface.
+type MockrestrictionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockrestrictionUseCaseMockRecorder
+}
+
+// MockrestrictionUseCaseMockRecorder is the mock recorder for MockrestrictionUseCase.
+type MockrestrictionUseCaseMockRecorder struct {
+	mock *MockrestrictionUseCase
+}
+
+// NewMockrestrictionUseCase creates a new mock instance.
+func NewMockrestrictionUseCase(ctrl *gomock.Controller) *MockrestrictionUseCase {
+	mock := &MockrestrictionUseCase{ctrl: ctrl}
+	mock.recorder = &MockrestrictionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrestrictionUseCase) EXPECT() *MockrestrictionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Block mocks base method.
+func (m *MockrestrictionUseCase) Block(ctx context.Context, userID domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Block", ctx, userID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockrestrictionUseCaseMockRecorder) Block(ctx, userID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockrestrictionUseCase)(nil).Block), ctx, userID, executor)
+}
+
+// Blocked mocks base method.
+func (m *MockrestrictionUseCase) Blocked(ctx context.Context, pagInput *domain.PaginationInput, executor *domain.User) (*domain.Pagination[domain.RestrictedUser], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Blocked", ctx, pagInput, executor)
+	ret0, _ := ret[0].(*domain.Pagination[domain.RestrictedUser])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Blocked indicates an expected call of Blocked.
+func (mr *MockrestrictionUseCaseMockRecorder) Blocked(ctx, pagInput, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Blocked", reflect.TypeOf((*MockrestrictionUseCase)(nil).Blocked), ctx, pagInput, executor)
+}
+
+// Mute mocks base method.
+func (m *MockrestrictionUseCase) Mute(ctx context.Context, userID domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mute", ctx, userID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Mute indicates an expected call of Mute.
+func (mr *MockrestrictionUseCaseMockRecorder) Mute(ctx, userID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mute", reflect.TypeOf((*MockrestrictionUseCase)(nil).Mute), ctx, userID, executor)
+}
+
+// Muted mocks base method.
+func (m *MockrestrictionUseCase) Muted(ctx context.Context, pagInput *domain.PaginationInput, executor *domain.User) (*domain.Pagination[domain.RestrictedUser], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Muted", ctx, pagInput, executor)
+	ret0, _ := ret[0].(*domain.Pagination[domain.RestrictedUser])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Muted indicates an expected call of Muted.
+func (mr *MockrestrictionUseCaseMockRecorder) Muted(ctx, pagInput, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Muted", reflect.TypeOf((*MockrestrictionUseCase)(nil).Muted), ctx, pagInput, executor)
+}
+
+// Unblock mocks base method.
+func (m *MockrestrictionUseCase) Unblock(ctx context.Context, userID domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unblock", ctx, userID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unblock indicates an expected call of Unblock.
+func (mr *MockrestrictionUseCaseMockRecorder) Unblock(ctx, userID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unblock", reflect.TypeOf((*MockrestrictionUseCase)(nil).Unblock), ctx, userID, executor)
+}
+
+// Unmute mocks base method.
+func (m *MockrestrictionUseCase) Unmute(ctx context.Context, userID domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unmute", ctx, userID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unmute indicates an expected call of Unmute.
+func (mr *MockrestrictionUseCaseMockRecorder) Unmute(ctx, userID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unmute", reflect.TypeOf((*MockrestrictionUseCase)(nil).Unmute), ctx, userID, executor)
+}
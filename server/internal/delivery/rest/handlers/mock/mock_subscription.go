@@ -40,6 +40,34 @@ func (m *MockSubscriptionUseCase) EXPECT() *MockSubscriptionUseCaseMockRecorder
 	return m.recorder
 }
 
+// ApproveRequest mocks base method.
+func (m *MockSubscriptionUseCase) ApproveRequest(ctx context.Context, requesterID domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveRequest", ctx, requesterID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveRequest indicates an expected call of ApproveRequest.
+func (mr *MockSubscriptionUseCaseMockRecorder) ApproveRequest(ctx, requesterID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveRequest", reflect.TypeOf((*MockSubscriptionUseCase)(nil).ApproveRequest), ctx, requesterID, executor)
+}
+
+// DenyRequest mocks base method.
+func (m *MockSubscriptionUseCase) DenyRequest(ctx context.Context, requesterID domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenyRequest", ctx, requesterID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DenyRequest indicates an expected call of DenyRequest.
+func (mr *MockSubscriptionUseCaseMockRecorder) DenyRequest(ctx, requesterID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyRequest", reflect.TypeOf((*MockSubscriptionUseCase)(nil).DenyRequest), ctx, requesterID, executor)
+}
+
 // Follow mocks base method.
 func (m *MockSubscriptionUseCase) Follow(ctx context.Context, userID domain.ID, executor *domain.User) error {
 	m.ctrl.T.Helper()
@@ -54,6 +82,66 @@ func (mr *MockSubscriptionUseCaseMockRecorder) Follow(ctx, userID, executor any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockSubscriptionUseCase)(nil).Follow), ctx, userID, executor)
 }
 
+// FollowRequests mocks base method.
+func (m *MockSubscriptionUseCase) FollowRequests(ctx context.Context, pagInput *domain.PaginationInput, executor *domain.User) (*domain.Pagination[domain.FollowRequest], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowRequests", ctx, pagInput, executor)
+	ret0, _ := ret[0].(*domain.Pagination[domain.FollowRequest])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowRequests indicates an expected call of FollowRequests.
+func (mr *MockSubscriptionUseCaseMockRecorder) FollowRequests(ctx, pagInput, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowRequests", reflect.TypeOf((*MockSubscriptionUseCase)(nil).FollowRequests), ctx, pagInput, executor)
+}
+
+// Followers mocks base method.
+func (m *MockSubscriptionUseCase) Followers(ctx context.Context, userID domain.ID, input *domain.FollowListInput, executor *domain.User) (*domain.CursorPagination[domain.Follow], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Followers", ctx, userID, input, executor)
+	ret0, _ := ret[0].(*domain.CursorPagination[domain.Follow])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Followers indicates an expected call of Followers.
+func (mr *MockSubscriptionUseCaseMockRecorder) Followers(ctx, userID, input, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Followers", reflect.TypeOf((*MockSubscriptionUseCase)(nil).Followers), ctx, userID, input, executor)
+}
+
+// Following mocks base method.
+func (m *MockSubscriptionUseCase) Following(ctx context.Context, userID domain.ID, input *domain.FollowListInput, executor *domain.User) (*domain.CursorPagination[domain.Follow], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Following", ctx, userID, input, executor)
+	ret0, _ := ret[0].(*domain.CursorPagination[domain.Follow])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Following indicates an expected call of Following.
+func (mr *MockSubscriptionUseCaseMockRecorder) Following(ctx, userID, input, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Following", reflect.TypeOf((*MockSubscriptionUseCase)(nil).Following), ctx, userID, input, executor)
+}
+
+// Suggestions mocks base method.
+func (m *MockSubscriptionUseCase) Suggestions(ctx context.Context, limit int, executor *domain.User) ([]domain.FollowSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggestions", ctx, limit, executor)
+	ret0, _ := ret[0].([]domain.FollowSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggestions indicates an expected call of Suggestions.
+func (mr *MockSubscriptionUseCaseMockRecorder) Suggestions(ctx, limit, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggestions", reflect.TypeOf((*MockSubscriptionUseCase)(nil).Suggestions), ctx, limit, executor)
+}
+
 // Unfollow mocks base method.
 func (m *MockSubscriptionUseCase) Unfollow(ctx context.Context, userID domain.ID, executor *domain.User) error {
 	m.ctrl.T.Helper()
@@ -40,6 +40,34 @@ func (m *MockTagUseCase) EXPECT() *MockTagUseCaseMockRecorder {
 	return m.recorder
 }
 
+// AddAlias mocks base method.
+func (m *MockTagUseCase) AddAlias(ctx context.Context, tagID domain.ID, alias string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAlias", ctx, tagID, alias)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAlias indicates an expected call of AddAlias.
+func (mr *MockTagUseCaseMockRecorder) AddAlias(ctx, tagID, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAlias", reflect.TypeOf((*MockTagUseCase)(nil).AddAlias), ctx, tagID, alias)
+}
+
+// ApproveProposal mocks base method.
+func (m *MockTagUseCase) ApproveProposal(ctx context.Context, tagID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveProposal", ctx, tagID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveProposal indicates an expected call of ApproveProposal.
+func (mr *MockTagUseCaseMockRecorder) ApproveProposal(ctx, tagID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveProposal", reflect.TypeOf((*MockTagUseCase)(nil).ApproveProposal), ctx, tagID)
+}
+
 // Create mocks base method.
 func (m *MockTagUseCase) Create(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
 	m.ctrl.T.Helper()
@@ -83,19 +111,179 @@ func (mr *MockTagUseCaseMockRecorder) DeleteImageTag(ctx, tagID, imageID, execut
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageTag", reflect.TypeOf((*MockTagUseCase)(nil).DeleteImageTag), ctx, tagID, imageID, executor)
 }
 
+// GetDetailed mocks base method.
+func (m *MockTagUseCase) GetDetailed(ctx context.Context, tagID domain.ID) (*domain.DetailedTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDetailed", ctx, tagID)
+	ret0, _ := ret[0].(*domain.DetailedTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDetailed indicates an expected call of GetDetailed.
+func (mr *MockTagUseCaseMockRecorder) GetDetailed(ctx, tagID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetailed", reflect.TypeOf((*MockTagUseCase)(nil).GetDetailed), ctx, tagID)
+}
+
+// GetImages mocks base method.
+func (m *MockTagUseCase) GetImages(ctx context.Context, tagID domain.ID, pagInput *domain.PaginationInput, executor *domain.User) (*domain.Pagination[domain.ImageWithMeta], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImages", ctx, tagID, pagInput, executor)
+	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithMeta])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImages indicates an expected call of GetImages.
+func (mr *MockTagUseCaseMockRecorder) GetImages(ctx, tagID, pagInput, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImages", reflect.TypeOf((*MockTagUseCase)(nil).GetImages), ctx, tagID, pagInput, executor)
+}
+
+// GetProposals mocks base method.
+func (m *MockTagUseCase) GetProposals(ctx context.Context, pagInput *domain.PaginationInput) (*domain.Pagination[domain.TagProposal], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposals", ctx, pagInput)
+	ret0, _ := ret[0].(*domain.Pagination[domain.TagProposal])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposals indicates an expected call of GetProposals.
+func (mr *MockTagUseCaseMockRecorder) GetProposals(ctx, pagInput any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposals", reflect.TypeOf((*MockTagUseCase)(nil).GetProposals), ctx, pagInput)
+}
+
+// GetUsage mocks base method.
+func (m *MockTagUseCase) GetUsage(ctx context.Context, days int, sort domain.TagUsageSortMethod, pagInput *domain.PaginationInput) (*domain.Pagination[domain.TagUsage], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", ctx, days, sort, pagInput)
+	ret0, _ := ret[0].(*domain.Pagination[domain.TagUsage])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockTagUseCaseMockRecorder) GetUsage(ctx, days, sort, pagInput any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockTagUseCase)(nil).GetUsage), ctx, days, sort, pagInput)
+}
+
+// GetUsageHistory mocks base method.
+func (m *MockTagUseCase) GetUsageHistory(ctx context.Context, tagID domain.ID, days int) ([]domain.TagUsagePoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsageHistory", ctx, tagID, days)
+	ret0, _ := ret[0].([]domain.TagUsagePoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsageHistory indicates an expected call of GetUsageHistory.
+func (mr *MockTagUseCaseMockRecorder) GetUsageHistory(ctx, tagID, days any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsageHistory", reflect.TypeOf((*MockTagUseCase)(nil).GetUsageHistory), ctx, tagID, days)
+}
+
+// Merge mocks base method.
+func (m *MockTagUseCase) Merge(ctx context.Context, tagID, targetID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, tagID, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockTagUseCaseMockRecorder) Merge(ctx, tagID, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockTagUseCase)(nil).Merge), ctx, tagID, targetID)
+}
+
+// RejectProposal mocks base method.
+func (m *MockTagUseCase) RejectProposal(ctx context.Context, tagID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectProposal", ctx, tagID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectProposal indicates an expected call of RejectProposal.
+func (mr *MockTagUseCaseMockRecorder) RejectProposal(ctx, tagID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectProposal", reflect.TypeOf((*MockTagUseCase)(nil).RejectProposal), ctx, tagID)
+}
+
+// RemoveAlias mocks base method.
+func (m *MockTagUseCase) RemoveAlias(ctx context.Context, tagID domain.ID, alias string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAlias", ctx, tagID, alias)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAlias indicates an expected call of RemoveAlias.
+func (mr *MockTagUseCaseMockRecorder) RemoveAlias(ctx, tagID, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAlias", reflect.TypeOf((*MockTagUseCase)(nil).RemoveAlias), ctx, tagID, alias)
+}
+
+// Rename mocks base method.
+func (m *MockTagUseCase) Rename(ctx context.Context, tagID domain.ID, name string, keepAlias bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", ctx, tagID, name, keepAlias)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockTagUseCaseMockRecorder) Rename(ctx, tagID, name, keepAlias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockTagUseCase)(nil).Rename), ctx, tagID, name, keepAlias)
+}
+
 // Search mocks base method.
-func (m *MockTagUseCase) Search(ctx context.Context, query string) ([]domain.Tag, error) {
+func (m *MockTagUseCase) Search(ctx context.Context, query string, executor *domain.User) ([]domain.Tag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", ctx, query)
+	ret := m.ctrl.Call(m, "Search", ctx, query, executor)
 	ret0, _ := ret[0].([]domain.Tag)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Search indicates an expected call of Search.
-func (mr *MockTagUseCaseMockRecorder) Search(ctx, query any) *gomock.Call {
+func (mr *MockTagUseCaseMockRecorder) Search(ctx, query, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTagUseCase)(nil).Search), ctx, query, executor)
+}
+
+// SetParent mocks base method.
+func (m *MockTagUseCase) SetParent(ctx context.Context, tagID domain.ID, parentID *domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetParent", ctx, tagID, parentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetParent indicates an expected call of SetParent.
+func (mr *MockTagUseCaseMockRecorder) SetParent(ctx, tagID, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetParent", reflect.TypeOf((*MockTagUseCase)(nil).SetParent), ctx, tagID, parentID)
+}
+
+// Suggestions mocks base method.
+func (m *MockTagUseCase) Suggestions(ctx context.Context, imageID domain.ID, executor *domain.User) ([]domain.TagSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggestions", ctx, imageID, executor)
+	ret0, _ := ret[0].([]domain.TagSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggestions indicates an expected call of Suggestions.
+func (mr *MockTagUseCaseMockRecorder) Suggestions(ctx, imageID, executor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTagUseCase)(nil).Search), ctx, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggestions", reflect.TypeOf((*MockTagUseCase)(nil).Suggestions), ctx, imageID, executor)
 }
 
 // UpsertImageTag mocks base method.
@@ -69,6 +69,20 @@ func (mr *MockuserUseCaseMockRecorder) OverwritePermissions(ctx, id, deny, allow
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverwritePermissions", reflect.TypeOf((*MockuserUseCase)(nil).OverwritePermissions), ctx, id, deny, allow)
 }
 
+// SetPrivate mocks base method.
+func (m *MockuserUseCase) SetPrivate(ctx context.Context, id domain.ID, private bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrivate", ctx, id, private)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPrivate indicates an expected call of SetPrivate.
+func (mr *MockuserUseCaseMockRecorder) SetPrivate(ctx, id, private any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrivate", reflect.TypeOf((*MockuserUseCase)(nil).SetPrivate), ctx, id, private)
+}
+
 // Update mocks base method.
 func (m *MockuserUseCase) Update(ctx context.Context, id domain.ID, user *domain.User) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	"github.com/pillowskiy/gopix/pkg/logger"
+	"github.com/pillowskiy/gopix/pkg/rest"
+	"github.com/pillowskiy/gopix/pkg/validator"
+)
+
+type reactionUseCase interface {
+	Emojis() []string
+	React(ctx context.Context, reaction *domain.Reaction, executor *domain.User) error
+	Unreact(ctx context.Context, reaction *domain.Reaction, executor *domain.User) error
+}
+
+type ReactionHandlers struct {
+	uc     reactionUseCase
+	logger logger.Logger
+}
+
+func NewReactionHandlers(uc reactionUseCase, logger logger.Logger) *ReactionHandlers {
+	return &ReactionHandlers{uc: uc, logger: logger}
+}
+
+func (h *ReactionHandlers) GetEmojis() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return c.JSON(http.StatusOK, h.uc.Emojis())
+	}
+}
+
+func (h *ReactionHandlers) ReactImage() echo.HandlerFunc {
+	return h.react(domain.ReactionTargetImage, "image_id")
+}
+
+func (h *ReactionHandlers) UnreactImage() echo.HandlerFunc {
+	return h.unreact(domain.ReactionTargetImage, "image_id")
+}
+
+func (h *ReactionHandlers) ReactComment() echo.HandlerFunc {
+	return h.react(domain.ReactionTargetComment, "comment_id")
+}
+
+func (h *ReactionHandlers) UnreactComment() echo.HandlerFunc {
+	return h.unreact(domain.ReactionTargetComment, "comment_id")
+}
+
+func (h *ReactionHandlers) react(target domain.ReactionTarget, param string) echo.HandlerFunc {
+	type reactDTO struct {
+		Emoji string `json:"emoji" validate:"required,lte=32"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		targetID, err := rest.PipeDomainIdentifier(c, param)
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Target ID has incorrect type").Response())
+		}
+
+		dto := new(reactDTO)
+		if err := rest.DecodeEchoBody(c, dto); err != nil {
+			return c.JSON(rest.NewBadRequestError("Reaction body has incorrect type").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, dto); err != nil {
+			return c.JSON(rest.NewBadRequestError("Reaction body has incorrect type").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		reaction := &domain.Reaction{Target: target, TargetID: targetID, Emoji: dto.Emoji}
+		if err := h.uc.React(ctx, reaction, user); err != nil {
+			return h.responseWithUseCaseErr(c, err, "React")
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+func (h *ReactionHandlers) unreact(target domain.ReactionTarget, param string) echo.HandlerFunc {
+	type unreactQuery struct {
+		Emoji string `query:"emoji" validate:"required,lte=32"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		targetID, err := rest.PipeDomainIdentifier(c, param)
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Target ID has incorrect type").Response())
+		}
+
+		q := new(unreactQuery)
+		if err := rest.DecodeEchoBody(c, q); err != nil {
+			return c.JSON(rest.NewBadRequestError("Query has incorrect type").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, q); err != nil {
+			return c.JSON(rest.NewBadRequestError("Query has incorrect type").Response())
+		}
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		reaction := &domain.Reaction{Target: target, TargetID: targetID, Emoji: q.Emoji}
+		if err := h.uc.Unreact(ctx, reaction, user); err != nil {
+			return h.responseWithUseCaseErr(c, err, "Unreact")
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+func (h *ReactionHandlers) responseWithUseCaseErr(c echo.Context, err error, trace string) error {
+	var restErr *rest.Error
+	switch {
+	case errors.Is(err, usecase.ErrUnprocessable):
+		restErr = rest.NewBadRequestError("Unsupported reaction provided")
+	case errors.Is(err, usecase.ErrForbidden):
+		restErr = rest.NewForbiddenError("You don't have permissions to perform this action")
+	case errors.Is(err, usecase.ErrNotFound):
+		restErr = rest.NewNotFoundError("Reaction target not found")
+	default:
+		h.logger.Errorf("ReactionUseCase.%s: %v", trace, err)
+		restErr = rest.NewInternalServerError()
+	}
+
+	return c.JSON(restErr.Response())
+}
@@ -0,0 +1,236 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pillowskiy/gopix/internal/delivery/rest/handlers"
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	"github.com/stretchr/testify/assert"
+
+	handlersMock "github.com/pillowskiy/gopix/internal/delivery/rest/handlers/mock"
+	loggerMock "github.com/pillowskiy/gopix/pkg/logger/mock"
+	"github.com/pillowskiy/gopix/pkg/rest"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestReactionHandlers_GetEmojis(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReactionUC := handlersMock.NewMockreactionUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	h := handlers.NewReactionHandlers(mockReactionUC, mockLog)
+
+	e := echo.New()
+
+	t.Run("SuccessGetEmojis", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/images/reactions", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		emojis := []string{"👍", "🔥"}
+		mockReactionUC.EXPECT().Emojis().Return(emojis)
+
+		assert.NoError(t, h.GetEmojis()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var actual []string
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+		assert.Equal(t, emojis, actual)
+	})
+}
+
+func TestReactionHandlers_ReactImage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReactionUC := handlersMock.NewMockreactionUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewReactionHandlers(mockReactionUC, mockLog)
+
+	e := echo.New()
+
+	imageID := handlersMock.DomainID()
+	itoaImageID := imageID.String()
+
+	prepareReactQuery := func(id string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/images/:image_id/reactions", body)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("image_id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	validBody := func() io.Reader {
+		body, _ := json.Marshal(map[string]string{"emoji": "🔥"})
+		return bytes.NewBuffer(body)
+	}
+
+	t.Run("SuccessReact", func(t *testing.T) {
+		c, rec := prepareReactQuery(itoaImageID, validBody())
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockReactionUC.EXPECT().React(ctx, gomock.Any(), mockUser).
+			DoAndReturn(func(_ context.Context, reaction *domain.Reaction, _ *domain.User) error {
+				assert.Equal(t, domain.ReactionTargetImage, reaction.Target)
+				assert.Equal(t, imageID, reaction.TargetID)
+				assert.Equal(t, "🔥", reaction.Emoji)
+				return nil
+			})
+
+		assert.NoError(t, h.ReactImage()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("UnsupportedEmoji", func(t *testing.T) {
+		c, rec := prepareReactQuery(itoaImageID, validBody())
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockReactionUC.EXPECT().React(ctx, gomock.Any(), mockUser).Return(usecase.ErrUnprocessable)
+
+		assert.NoError(t, h.ReactImage()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("ImageNotFound", func(t *testing.T) {
+		c, rec := prepareReactQuery(itoaImageID, validBody())
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockReactionUC.EXPECT().React(ctx, gomock.Any(), mockUser).Return(usecase.ErrNotFound)
+
+		assert.NoError(t, h.ReactImage()(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("MissingEmoji", func(t *testing.T) {
+		c, rec := prepareReactQuery(itoaImageID, bytes.NewBufferString(`{}`))
+		mockCtxUser(c)
+
+		mockReactionUC.EXPECT().React(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.ReactImage()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("IncorrectImageID", func(t *testing.T) {
+		c, rec := prepareReactQuery("bad", validBody())
+		mockCtxUser(c)
+
+		mockReactionUC.EXPECT().React(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.ReactImage()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("IncorrectUserContext", func(t *testing.T) {
+		c, rec := prepareReactQuery(itoaImageID, validBody())
+
+		mockReactionUC.EXPECT().React(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.ReactImage()(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("UseCaseError", func(t *testing.T) {
+		c, rec := prepareReactQuery(itoaImageID, validBody())
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockReactionUC.EXPECT().React(ctx, gomock.Any(), mockUser).Return(errors.New("usecase error"))
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.ReactImage()(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestReactionHandlers_UnreactComment(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReactionUC := handlersMock.NewMockreactionUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewReactionHandlers(mockReactionUC, mockLog)
+
+	e := echo.New()
+
+	commentID := handlersMock.DomainID()
+	itoaCommentID := commentID.String()
+
+	prepareUnreactQuery := func(id string, emoji string) (echo.Context, *httptest.ResponseRecorder) {
+		q := make(url.Values)
+		if emoji != "" {
+			q.Set("emoji", emoji)
+		}
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/images/comments/:comment_id/reactions?"+q.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("comment_id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("SuccessUnreact", func(t *testing.T) {
+		c, rec := prepareUnreactQuery(itoaCommentID, "🔥")
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockReactionUC.EXPECT().Unreact(ctx, gomock.Any(), mockUser).
+			DoAndReturn(func(_ context.Context, reaction *domain.Reaction, _ *domain.User) error {
+				assert.Equal(t, domain.ReactionTargetComment, reaction.Target)
+				assert.Equal(t, commentID, reaction.TargetID)
+				assert.Equal(t, "🔥", reaction.Emoji)
+				return nil
+			})
+
+		assert.NoError(t, h.UnreactComment()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("ReactionNotFound", func(t *testing.T) {
+		c, rec := prepareUnreactQuery(itoaCommentID, "🔥")
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockReactionUC.EXPECT().Unreact(ctx, gomock.Any(), mockUser).Return(usecase.ErrNotFound)
+
+		assert.NoError(t, h.UnreactComment()(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("MissingEmoji", func(t *testing.T) {
+		c, rec := prepareUnreactQuery(itoaCommentID, "")
+		mockCtxUser(c)
+
+		mockReactionUC.EXPECT().Unreact(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.UnreactComment()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
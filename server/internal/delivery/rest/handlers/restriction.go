@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	"github.com/pillowskiy/gopix/pkg/logger"
+	"github.com/pillowskiy/gopix/pkg/rest"
+	"github.com/pillowskiy/gopix/pkg/validator"
+)
+
+type restrictionUseCase interface {
+	Block(ctx context.Context, userID domain.ID, executor *domain.User) error
+	Unblock(ctx context.Context, userID domain.ID, executor *domain.User) error
+	Mute(ctx context.Context, userID domain.ID, executor *domain.User) error
+	Unmute(ctx context.Context, userID domain.ID, executor *domain.User) error
+	Blocked(
+		ctx context.Context, pagInput *domain.PaginationInput, executor *domain.User,
+	) (*domain.Pagination[domain.RestrictedUser], error)
+	Muted(
+		ctx context.Context, pagInput *domain.PaginationInput, executor *domain.User,
+	) (*domain.Pagination[domain.RestrictedUser], error)
+}
+
+type RestrictionHandlers struct {
+	uc     restrictionUseCase
+	logger logger.Logger
+}
+
+func NewRestrictionHandlers(uc restrictionUseCase, logger logger.Logger) *RestrictionHandlers {
+	return &RestrictionHandlers{uc: uc, logger: logger}
+}
+
+func (h *RestrictionHandlers) Block() echo.HandlerFunc {
+	return h.restrict("Block", h.uc.Block)
+}
+
+func (h *RestrictionHandlers) Unblock() echo.HandlerFunc {
+	return h.restrict("Unblock", h.uc.Unblock)
+}
+
+func (h *RestrictionHandlers) Mute() echo.HandlerFunc {
+	return h.restrict("Mute", h.uc.Mute)
+}
+
+func (h *RestrictionHandlers) Unmute() echo.HandlerFunc {
+	return h.restrict("Unmute", h.uc.Unmute)
+}
+
+func (h *RestrictionHandlers) Blocked() echo.HandlerFunc {
+	return h.restrictedList("Blocked", h.uc.Blocked)
+}
+
+func (h *RestrictionHandlers) Muted() echo.HandlerFunc {
+	return h.restrictedList("Muted", h.uc.Muted)
+}
+
+func (h *RestrictionHandlers) restrict(
+	trace string, restrict func(ctx context.Context, userID domain.ID, executor *domain.User) error,
+) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("%s.GetContextUser: %v", trace, err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		id, err := rest.PipeDomainIdentifier(c, "id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("ID has incorrect type").Response())
+		}
+
+		if err := restrict(ctx, id, user); err != nil {
+			return h.responseWithUseCaseErr(c, err, trace)
+		}
+
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+func (h *RestrictionHandlers) restrictedList(
+	trace string,
+	list func(
+		ctx context.Context, pagInput *domain.PaginationInput, executor *domain.User,
+	) (*domain.Pagination[domain.RestrictedUser], error),
+) echo.HandlerFunc {
+	type listQuery struct {
+		Limit int `query:"limit" validate:"required,gte=1,lte=100"`
+		Page  int `query:"page" validate:"required,gte=1"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("%s.GetContextUser: %v", trace, err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		query := new(listQuery)
+		if err := rest.DecodeEchoBody(c, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		pagInput := &domain.PaginationInput{Page: query.Page, PerPage: query.Limit}
+		users, err := list(ctx, pagInput, user)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, trace)
+		}
+
+		return c.JSON(http.StatusOK, users)
+	}
+}
+
+func (h *RestrictionHandlers) responseWithUseCaseErr(c echo.Context, err error, trace string) error {
+	var restErr *rest.Error
+	switch {
+	case errors.Is(err, usecase.ErrIncorrectUserRef):
+		restErr = rest.NewBadRequestError("Incorrect user reference provided")
+	case errors.Is(err, usecase.ErrUnprocessable):
+		restErr = rest.NewBadRequestError("You can't restrict yourself")
+	default:
+		h.logger.Errorf("RestrictionUseCase.%s: %v", trace, err)
+		restErr = rest.NewInternalServerError()
+	}
+
+	return c.JSON(restErr.Response())
+}
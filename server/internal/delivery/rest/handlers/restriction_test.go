@@ -0,0 +1,194 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pillowskiy/gopix/internal/delivery/rest/handlers"
+	handlersMock "github.com/pillowskiy/gopix/internal/delivery/rest/handlers/mock"
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	loggerMock "github.com/pillowskiy/gopix/pkg/logger/mock"
+	"github.com/pillowskiy/gopix/pkg/rest"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRestrictionHandlers_Block(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRestrictionUC := handlersMock.NewMockrestrictionUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewRestrictionHandlers(mockRestrictionUC, mockLog)
+
+	e := echo.New()
+
+	prepareBlockQuery := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/users/:id/block", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	userID := handlersMock.DomainID()
+	itoaUserID := userID.String()
+
+	t.Run("SuccessBlock", func(t *testing.T) {
+		c, rec := prepareBlockQuery(itoaUserID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockRestrictionUC.EXPECT().Block(ctx, userID, mockUser).Return(nil)
+
+		assert.NoError(t, h.Block()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("IncorrectUserContext", func(t *testing.T) {
+		c, rec := prepareBlockQuery(itoaUserID)
+
+		mockRestrictionUC.EXPECT().Block(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.Block()(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("IncorrectUserID", func(t *testing.T) {
+		c, rec := prepareBlockQuery("")
+		mockCtxUser(c)
+
+		mockRestrictionUC.EXPECT().Block(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Block()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("BlockSelf", func(t *testing.T) {
+		c, rec := prepareBlockQuery(itoaUserID)
+		mockCtxUser(c)
+
+		mockRestrictionUC.EXPECT().Block(gomock.Any(), userID, mockUser).Return(usecase.ErrUnprocessable)
+
+		assert.NoError(t, h.Block()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("IncorrectUserRef", func(t *testing.T) {
+		c, rec := prepareBlockQuery(itoaUserID)
+		mockCtxUser(c)
+
+		mockRestrictionUC.EXPECT().Block(gomock.Any(), userID, mockUser).Return(usecase.ErrIncorrectUserRef)
+
+		assert.NoError(t, h.Block()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("InternalServerError", func(t *testing.T) {
+		c, rec := prepareBlockQuery(itoaUserID)
+		mockCtxUser(c)
+
+		mockRestrictionUC.EXPECT().Block(gomock.Any(), userID, mockUser).Return(errors.New("internal error"))
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.Block()(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestRestrictionHandlers_Unmute(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRestrictionUC := handlersMock.NewMockrestrictionUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewRestrictionHandlers(mockRestrictionUC, mockLog)
+
+	e := echo.New()
+	userID := handlersMock.DomainID()
+
+	t.Run("SuccessUnmute", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/users/:id/mute", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(userID.String())
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockRestrictionUC.EXPECT().Unmute(ctx, userID, mockUser).Return(nil)
+
+		assert.NoError(t, h.Unmute()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestRestrictionHandlers_Blocked(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRestrictionUC := handlersMock.NewMockrestrictionUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewRestrictionHandlers(mockRestrictionUC, mockLog)
+
+	e := echo.New()
+
+	prepareBlockedQuery := func(query url.Values) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/@me/blocks", nil)
+		req.URL.RawQuery = query.Encode()
+		rec := httptest.NewRecorder()
+		return e.NewContext(req, rec), rec
+	}
+
+	pagInput := &domain.PaginationInput{Page: 1, PerPage: 10}
+
+	t.Run("SuccessBlocked", func(t *testing.T) {
+		c, rec := prepareBlockedQuery(url.Values{"page": {"1"}, "limit": {"10"}})
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockRestrictionUC.EXPECT().Blocked(ctx, pagInput, mockUser).Return(
+			&domain.Pagination[domain.RestrictedUser]{PaginationInput: *pagInput}, nil,
+		)
+
+		assert.NoError(t, h.Blocked()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		c, rec := prepareBlockedQuery(url.Values{"page": {"0"}})
+		mockCtxUser(c)
+
+		mockRestrictionUC.EXPECT().Blocked(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Blocked()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("InternalServerError", func(t *testing.T) {
+		c, rec := prepareBlockedQuery(url.Values{"page": {"1"}, "limit": {"10"}})
+		mockCtxUser(c)
+
+		mockRestrictionUC.EXPECT().Blocked(gomock.Any(), pagInput, mockUser).Return(nil, errors.New("internal error"))
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.Blocked()(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
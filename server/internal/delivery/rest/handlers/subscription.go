@@ -10,11 +10,24 @@ import (
 	"github.com/pillowskiy/gopix/internal/usecase"
 	"github.com/pillowskiy/gopix/pkg/logger"
 	"github.com/pillowskiy/gopix/pkg/rest"
+	"github.com/pillowskiy/gopix/pkg/validator"
 )
 
 type SubscriptionUseCase interface {
 	Follow(ctx context.Context, userID domain.ID, executor *domain.User) error
 	Unfollow(ctx context.Context, userID domain.ID, executor *domain.User) error
+	Followers(
+		ctx context.Context, userID domain.ID, input *domain.FollowListInput, executor *domain.User,
+	) (*domain.CursorPagination[domain.Follow], error)
+	Following(
+		ctx context.Context, userID domain.ID, input *domain.FollowListInput, executor *domain.User,
+	) (*domain.CursorPagination[domain.Follow], error)
+	FollowRequests(
+		ctx context.Context, pagInput *domain.PaginationInput, executor *domain.User,
+	) (*domain.Pagination[domain.FollowRequest], error)
+	ApproveRequest(ctx context.Context, requesterID domain.ID, executor *domain.User) error
+	DenyRequest(ctx context.Context, requesterID domain.ID, executor *domain.User) error
+	Suggestions(ctx context.Context, limit int, executor *domain.User) ([]domain.FollowSuggestion, error)
 }
 
 type SubscriptionHandlers struct {
@@ -82,11 +95,173 @@ func (h *SubscriptionHandlers) Unfollow() echo.HandlerFunc {
 	}
 }
 
+func (h *SubscriptionHandlers) Followers() echo.HandlerFunc {
+	return h.followList("Followers", h.uc.Followers)
+}
+
+func (h *SubscriptionHandlers) Following() echo.HandlerFunc {
+	return h.followList("Following", h.uc.Following)
+}
+
+func (h *SubscriptionHandlers) followList(
+	trace string,
+	list func(
+		ctx context.Context, userID domain.ID, input *domain.FollowListInput, executor *domain.User,
+	) (*domain.CursorPagination[domain.Follow], error),
+) echo.HandlerFunc {
+	type listQuery struct {
+		Query  string `query:"q" validate:"omitempty,lte=60"`
+		Cursor string `query:"cursor"`
+		Limit  int    `query:"limit" validate:"required,gte=1,lte=100"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		id, err := rest.PipeDomainIdentifier(c, "id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("ID has incorrect type").Response())
+		}
+
+		query := new(listQuery)
+		if err := rest.DecodeEchoBody(c, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		input := &domain.FollowListInput{Query: query.Query, Limit: query.Limit}
+		if query.Cursor != "" {
+			input.Cursor, err = domain.ParseFollowCursor(query.Cursor)
+			if err != nil {
+				return c.JSON(rest.NewBadRequestError("Invalid cursor").Response())
+			}
+		}
+
+		user, _ := GetContextUser(c)
+		follows, err := list(ctx, id, input, user)
+		if err != nil {
+			if errors.Is(err, usecase.ErrForbidden) {
+				return c.JSON(rest.NewForbiddenError("You can't see the follows of this user").Response())
+			}
+			return h.responseWithUseCaseErr(c, err, trace)
+		}
+
+		return c.JSON(http.StatusOK, follows)
+	}
+}
+
+func (h *SubscriptionHandlers) FollowRequests() echo.HandlerFunc {
+	type requestsQuery struct {
+		Limit int `query:"limit" validate:"required,gte=1,lte=100"`
+		Page  int `query:"page" validate:"required,gte=1"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		query := new(requestsQuery)
+		if err := rest.DecodeEchoBody(c, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		pagInput := &domain.PaginationInput{Page: query.Page, PerPage: query.Limit}
+		requests, err := h.uc.FollowRequests(ctx, pagInput, user)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "FollowRequests")
+		}
+
+		return c.JSON(http.StatusOK, requests)
+	}
+}
+
+func (h *SubscriptionHandlers) ApproveRequest() echo.HandlerFunc {
+	return h.resolveRequest("ApproveRequest", h.uc.ApproveRequest)
+}
+
+func (h *SubscriptionHandlers) DenyRequest() echo.HandlerFunc {
+	return h.resolveRequest("DenyRequest", h.uc.DenyRequest)
+}
+
+func (h *SubscriptionHandlers) resolveRequest(
+	trace string,
+	resolve func(ctx context.Context, requesterID domain.ID, executor *domain.User) error,
+) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		id, err := rest.PipeDomainIdentifier(c, "user_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("ID has incorrect type").Response())
+		}
+
+		if err := resolve(ctx, id, user); err != nil {
+			return h.responseWithUseCaseErr(c, err, trace)
+		}
+
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+func (h *SubscriptionHandlers) Suggestions() echo.HandlerFunc {
+	type suggestionsQuery struct {
+		Limit int `query:"limit" validate:"required,gte=1,lte=50"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("GetContextUser: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		query := new(suggestionsQuery)
+		if err := rest.DecodeEchoBody(c, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		suggestions, err := h.uc.Suggestions(ctx, query.Limit, user)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "Suggestions")
+		}
+
+		return c.JSON(http.StatusOK, suggestions)
+	}
+}
+
 func (h *SubscriptionHandlers) responseWithUseCaseErr(c echo.Context, err error, trace string) error {
 	var restErr *rest.Error
 	switch {
 	case errors.Is(err, usecase.ErrIncorrectUserRef):
 		restErr = rest.NewBadRequestError("Incorrect user reference provided")
+	case errors.Is(err, usecase.ErrForbidden):
+		restErr = rest.NewForbiddenError("You can't follow this user")
+	case errors.Is(err, usecase.ErrNotFound):
+		restErr = rest.NewNotFoundError("Follow request not found")
 	default:
 		h.logger.Errorf("SubscriptionUseCase.%s: %v", trace, err)
 		restErr = rest.NewInternalServerError()
@@ -4,13 +4,16 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 
 	"github.com/labstack/echo/v4"
 	"github.com/pillowskiy/gopix/internal/delivery/rest/handlers"
 	handlersMock "github.com/pillowskiy/gopix/internal/delivery/rest/handlers/mock"
+	"github.com/pillowskiy/gopix/internal/domain"
 	"github.com/pillowskiy/gopix/internal/usecase"
 	loggerMock "github.com/pillowskiy/gopix/pkg/logger/mock"
 	"github.com/pillowskiy/gopix/pkg/rest"
@@ -65,6 +68,17 @@ func TestSubscriptionHandlers_Follow(t *testing.T) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 	})
 
+	t.Run("Forbidden", func(t *testing.T) {
+		c, rec := prepareFollowQuery(itoaFollowingID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockSubsUC.EXPECT().Follow(ctx, followingID, mockUser).Return(usecase.ErrForbidden)
+
+		assert.NoError(t, h.Follow()(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
 	t.Run("IncorrectUserContext", func(t *testing.T) {
 		c, rec := prepareFollowQuery(itoaFollowingID)
 
@@ -204,3 +218,346 @@ func TestSubscriptionHandlers_Unfollow(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, rec.Code)
 	})
 }
+
+func TestSubscriptionHandlers_Followers(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSubsUC := handlersMock.NewMockSubscriptionUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewSubscriptionHandlers(mockSubsUC, mockLog)
+
+	e := echo.New()
+
+	prepareFollowersQuery := func(id string, query url.Values) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/:id/followers", nil)
+		req.URL.RawQuery = query.Encode()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	userID := handlersMock.DomainID()
+	itoaUserID := userID.String()
+	cursor := &domain.FollowCursor{FollowedAt: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC), UserID: userID}
+	mockPage := &domain.CursorPagination[domain.Follow]{}
+
+	t.Run("SuccessFollowers", func(t *testing.T) {
+		c, rec := prepareFollowersQuery(itoaUserID, url.Values{
+			"limit": {"10"}, "q": {"user"}, "cursor": {cursor.String()},
+		})
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockSubsUC.EXPECT().Followers(ctx, userID, &domain.FollowListInput{
+			Query: "user", Limit: 10, Cursor: cursor,
+		}, mockUser).Return(mockPage, nil)
+
+		assert.NoError(t, h.Followers()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("SuccessFollowers_Anonymous", func(t *testing.T) {
+		c, rec := prepareFollowersQuery(itoaUserID, url.Values{"limit": {"10"}})
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockSubsUC.EXPECT().Followers(ctx, userID, &domain.FollowListInput{Limit: 10}, nil).Return(mockPage, nil)
+
+		assert.NoError(t, h.Followers()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("IncorrectUserID", func(t *testing.T) {
+		c, rec := prepareFollowersQuery("", url.Values{"limit": {"10"}})
+
+		mockSubsUC.EXPECT().Followers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Followers()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		c, rec := prepareFollowersQuery(itoaUserID, url.Values{"limit": {"1000"}})
+
+		mockSubsUC.EXPECT().Followers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Followers()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("InvalidCursor", func(t *testing.T) {
+		c, rec := prepareFollowersQuery(itoaUserID, url.Values{"limit": {"10"}, "cursor": {"broken"}})
+
+		mockSubsUC.EXPECT().Followers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Followers()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("IncorrectUserRef", func(t *testing.T) {
+		c, rec := prepareFollowersQuery(itoaUserID, url.Values{"limit": {"10"}})
+
+		mockSubsUC.EXPECT().Followers(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Return(nil, usecase.ErrIncorrectUserRef)
+
+		assert.NoError(t, h.Followers()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("InternalServerError", func(t *testing.T) {
+		c, rec := prepareFollowersQuery(itoaUserID, url.Values{"limit": {"10"}})
+
+		mockSubsUC.EXPECT().Followers(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Return(nil, errors.New("internal error"))
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.Followers()(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestSubscriptionHandlers_Following(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSubsUC := handlersMock.NewMockSubscriptionUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewSubscriptionHandlers(mockSubsUC, mockLog)
+
+	e := echo.New()
+
+	prepareFollowingQuery := func(id string, query url.Values) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/:id/following", nil)
+		req.URL.RawQuery = query.Encode()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	userID := handlersMock.DomainID()
+	itoaUserID := userID.String()
+
+	t.Run("SuccessFollowing", func(t *testing.T) {
+		c, rec := prepareFollowingQuery(itoaUserID, url.Values{"limit": {"20"}})
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockSubsUC.EXPECT().Following(ctx, userID, &domain.FollowListInput{Limit: 20}, mockUser).Return(
+			&domain.CursorPagination[domain.Follow]{}, nil,
+		)
+
+		assert.NoError(t, h.Following()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("MissingLimit", func(t *testing.T) {
+		c, rec := prepareFollowingQuery(itoaUserID, url.Values{})
+
+		mockSubsUC.EXPECT().Following(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Following()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestSubscriptionHandlers_FollowRequests(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSubsUC := handlersMock.NewMockSubscriptionUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewSubscriptionHandlers(mockSubsUC, mockLog)
+
+	e := echo.New()
+
+	prepareRequestsQuery := func(query url.Values) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/subscriptions/requests", nil)
+		req.URL.RawQuery = query.Encode()
+		rec := httptest.NewRecorder()
+		return e.NewContext(req, rec), rec
+	}
+
+	validQuery := url.Values{"page": {"1"}, "limit": {"10"}}
+
+	t.Run("SuccessFollowRequests", func(t *testing.T) {
+		c, rec := prepareRequestsQuery(validQuery)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		pagInput := &domain.PaginationInput{Page: 1, PerPage: 10}
+		mockSubsUC.EXPECT().FollowRequests(ctx, pagInput, mockUser).Return(
+			&domain.Pagination[domain.FollowRequest]{PaginationInput: *pagInput}, nil,
+		)
+
+		assert.NoError(t, h.FollowRequests()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("IncorrectUserContext", func(t *testing.T) {
+		c, rec := prepareRequestsQuery(validQuery)
+
+		mockSubsUC.EXPECT().FollowRequests(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.FollowRequests()(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		c, rec := prepareRequestsQuery(url.Values{"page": {"0"}, "limit": {"1000"}})
+		mockCtxUser(c)
+
+		mockSubsUC.EXPECT().FollowRequests(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.FollowRequests()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestSubscriptionHandlers_ApproveRequest(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSubsUC := handlersMock.NewMockSubscriptionUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewSubscriptionHandlers(mockSubsUC, mockLog)
+
+	e := echo.New()
+
+	prepareApproveQuery := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/subscriptions/requests/:user_id/approve", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("user_id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	requesterID := handlersMock.DomainID()
+	itoaRequesterID := requesterID.String()
+
+	t.Run("SuccessApprove", func(t *testing.T) {
+		c, rec := prepareApproveQuery(itoaRequesterID)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockSubsUC.EXPECT().ApproveRequest(ctx, requesterID, mockUser).Return(nil)
+
+		assert.NoError(t, h.ApproveRequest()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("IncorrectUserID", func(t *testing.T) {
+		c, rec := prepareApproveQuery("abc")
+		mockCtxUser(c)
+
+		mockSubsUC.EXPECT().ApproveRequest(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.ApproveRequest()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		c, rec := prepareApproveQuery(itoaRequesterID)
+		mockCtxUser(c)
+
+		mockSubsUC.EXPECT().ApproveRequest(gomock.Any(), requesterID, mockUser).Return(usecase.ErrNotFound)
+
+		assert.NoError(t, h.ApproveRequest()(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("InternalServerError", func(t *testing.T) {
+		c, rec := prepareApproveQuery(itoaRequesterID)
+		mockCtxUser(c)
+
+		mockSubsUC.EXPECT().ApproveRequest(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("internal error"))
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.ApproveRequest()(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestSubscriptionHandlers_Suggestions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSubsUC := handlersMock.NewMockSubscriptionUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockUser, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewSubscriptionHandlers(mockSubsUC, mockLog)
+
+	e := echo.New()
+
+	prepareSuggestionsQuery := func(query url.Values) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/suggestions", nil)
+		req.URL.RawQuery = query.Encode()
+		rec := httptest.NewRecorder()
+		return e.NewContext(req, rec), rec
+	}
+
+	validQuery := url.Values{"limit": {"10"}}
+
+	t.Run("SuccessSuggestions", func(t *testing.T) {
+		c, rec := prepareSuggestionsQuery(validQuery)
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockSubsUC.EXPECT().Suggestions(ctx, 10, mockUser).Return([]domain.FollowSuggestion{}, nil)
+
+		assert.NoError(t, h.Suggestions()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("IncorrectUserContext", func(t *testing.T) {
+		c, rec := prepareSuggestionsQuery(validQuery)
+
+		mockSubsUC.EXPECT().Suggestions(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.Suggestions()(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		c, rec := prepareSuggestionsQuery(url.Values{"limit": {"100"}})
+		mockCtxUser(c)
+
+		mockSubsUC.EXPECT().Suggestions(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Suggestions()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("InternalServerError", func(t *testing.T) {
+		c, rec := prepareSuggestionsQuery(validQuery)
+		mockCtxUser(c)
+
+		mockSubsUC.EXPECT().Suggestions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("internal error"))
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.Suggestions()(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -17,8 +17,27 @@ type TagUseCase interface {
 	Create(ctx context.Context, tag *domain.Tag) (*domain.Tag, error)
 	UpsertImageTag(ctx context.Context, tag *domain.Tag, imageID domain.ID, executor *domain.User) error
 	DeleteImageTag(ctx context.Context, tagID domain.ID, imageID domain.ID, executor *domain.User) error
-	Search(ctx context.Context, query string) ([]domain.Tag, error)
+	Search(ctx context.Context, query string, executor *domain.User) ([]domain.Tag, error)
 	Delete(ctx context.Context, tagID domain.ID) error
+	GetDetailed(ctx context.Context, tagID domain.ID) (*domain.DetailedTag, error)
+	SetParent(ctx context.Context, tagID domain.ID, parentID *domain.ID) error
+	AddAlias(ctx context.Context, tagID domain.ID, alias string) error
+	RemoveAlias(ctx context.Context, tagID domain.ID, alias string) error
+	GetImages(
+		ctx context.Context, tagID domain.ID, pagInput *domain.PaginationInput, executor *domain.User,
+	) (*domain.Pagination[domain.ImageWithMeta], error)
+	Suggestions(ctx context.Context, imageID domain.ID, executor *domain.User) ([]domain.TagSuggestion, error)
+	GetProposals(
+		ctx context.Context, pagInput *domain.PaginationInput,
+	) (*domain.Pagination[domain.TagProposal], error)
+	ApproveProposal(ctx context.Context, tagID domain.ID) error
+	RejectProposal(ctx context.Context, tagID domain.ID) error
+	Merge(ctx context.Context, tagID domain.ID, targetID domain.ID) error
+	Rename(ctx context.Context, tagID domain.ID, name string, keepAlias bool) error
+	GetUsage(
+		ctx context.Context, days int, sort domain.TagUsageSortMethod, pagInput *domain.PaginationInput,
+	) (*domain.Pagination[domain.TagUsage], error)
+	GetUsageHistory(ctx context.Context, tagID domain.ID, days int) ([]domain.TagUsagePoint, error)
 }
 
 type TagHandlers struct {
@@ -127,6 +146,30 @@ func (h *TagHandlers) DeleteImageTag() echo.HandlerFunc {
 	}
 }
 
+func (h *TagHandlers) Suggestions() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		user, err := GetContextUser(c)
+		if err != nil {
+			h.logger.Errorf("TagHandlers.Suggestions: %v", err)
+			return c.JSON(rest.NewUnauthorizedError("Unauthorized").Response())
+		}
+
+		imageID, err := rest.PipeDomainIdentifier(c, "image_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid image ID").Response())
+		}
+
+		suggestions, err := h.uc.Suggestions(ctx, imageID, user)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "Suggestions")
+		}
+
+		return c.JSON(http.StatusOK, suggestions)
+	}
+}
+
 func (h *TagHandlers) Search() echo.HandlerFunc {
 	type searchDTO struct {
 		Query string `query:"query" validate:"required,gte=1,lte=32,lowercase"`
@@ -144,7 +187,9 @@ func (h *TagHandlers) Search() echo.HandlerFunc {
 			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
 		}
 
-		tags, err := h.uc.Search(ctx, queryInput.Query)
+		user, _ := GetContextUser(c)
+
+		tags, err := h.uc.Search(ctx, queryInput.Query, user)
 		if err != nil {
 			return h.responseWithUseCaseErr(c, err, "Search")
 		}
@@ -175,6 +220,327 @@ func (h *TagHandlers) Delete() echo.HandlerFunc {
 	}
 }
 
+func (h *TagHandlers) GetDetailed() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		tagID, err := rest.PipeDomainIdentifier(c, "tag_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid tag ID").Response())
+		}
+
+		tag, err := h.uc.GetDetailed(ctx, tagID)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "GetDetailed")
+		}
+
+		return c.JSON(http.StatusOK, tag)
+	}
+}
+
+func (h *TagHandlers) SetParent() echo.HandlerFunc {
+	type setParentDTO struct {
+		ParentID *domain.ID `json:"parentID"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		tagID, err := rest.PipeDomainIdentifier(c, "tag_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid tag ID").Response())
+		}
+
+		parentInput := new(setParentDTO)
+		if err := rest.DecodeEchoBody(c, parentInput); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Body").Response())
+		}
+
+		if err := h.uc.SetParent(ctx, tagID, parentInput.ParentID); err != nil {
+			return h.responseWithUseCaseErr(c, err, "SetParent")
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+func (h *TagHandlers) AddAlias() echo.HandlerFunc {
+	type addAliasDTO struct {
+		Alias string `json:"alias" validate:"required,gte=1,lte=32,lowercase"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		tagID, err := rest.PipeDomainIdentifier(c, "tag_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid tag ID").Response())
+		}
+
+		aliasInput := new(addAliasDTO)
+		if err := rest.DecodeEchoBody(c, aliasInput); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Body").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, aliasInput); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Body").Response())
+		}
+
+		if err := h.uc.AddAlias(ctx, tagID, aliasInput.Alias); err != nil {
+			return h.responseWithUseCaseErr(c, err, "AddAlias")
+		}
+
+		return c.JSON(http.StatusCreated, true)
+	}
+}
+
+func (h *TagHandlers) RemoveAlias() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		tagID, err := rest.PipeDomainIdentifier(c, "tag_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid tag ID").Response())
+		}
+
+		alias := c.Param("alias")
+		if alias == "" {
+			return c.JSON(rest.NewBadRequestError("Invalid alias").Response())
+		}
+
+		if err := h.uc.RemoveAlias(ctx, tagID, alias); err != nil {
+			return h.responseWithUseCaseErr(c, err, "RemoveAlias")
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+func (h *TagHandlers) GetImages() echo.HandlerFunc {
+	type tagImagesQuery struct {
+		Limit int `query:"limit" validate:"required,gte=1,lte=100"`
+		Page  int `query:"page" validate:"required,gte=1"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		tagID, err := rest.PipeDomainIdentifier(c, "tag_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid tag ID").Response())
+		}
+
+		query := new(tagImagesQuery)
+		if err := rest.DecodeEchoBody(c, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		user, _ := GetContextUser(c)
+
+		pagInput := &domain.PaginationInput{Page: query.Page, PerPage: query.Limit}
+		images, err := h.uc.GetImages(ctx, tagID, pagInput, user)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "GetImages")
+		}
+
+		return c.JSON(http.StatusOK, images)
+	}
+}
+
+func (h *TagHandlers) GetProposals() echo.HandlerFunc {
+	type proposalsQuery struct {
+		Limit int `query:"limit" validate:"required,gte=1,lte=100"`
+		Page  int `query:"page" validate:"required,gte=1"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		query := new(proposalsQuery)
+		if err := rest.DecodeEchoBody(c, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		pagInput := &domain.PaginationInput{Page: query.Page, PerPage: query.Limit}
+		proposals, err := h.uc.GetProposals(ctx, pagInput)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "GetProposals")
+		}
+
+		return c.JSON(http.StatusOK, proposals)
+	}
+}
+
+func (h *TagHandlers) ApproveProposal() echo.HandlerFunc {
+	return h.moderateProposal("ApproveProposal", h.uc.ApproveProposal)
+}
+
+func (h *TagHandlers) RejectProposal() echo.HandlerFunc {
+	return h.moderateProposal("RejectProposal", h.uc.RejectProposal)
+}
+
+func (h *TagHandlers) Merge() echo.HandlerFunc {
+	type mergeDTO struct {
+		TargetID domain.ID `json:"targetID" validate:"required"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		tagID, err := rest.PipeDomainIdentifier(c, "tag_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid tag ID").Response())
+		}
+
+		mergeInput := new(mergeDTO)
+		if err := rest.DecodeEchoBody(c, mergeInput); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Body").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, mergeInput); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Body").Response())
+		}
+
+		if err := h.uc.Merge(ctx, tagID, mergeInput.TargetID); err != nil {
+			return h.responseWithUseCaseErr(c, err, "Merge")
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+func (h *TagHandlers) Rename() echo.HandlerFunc {
+	type renameDTO struct {
+		Name      string `json:"name" validate:"required,gte=1,lte=32,lowercase"`
+		KeepAlias bool   `json:"keepAlias"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		tagID, err := rest.PipeDomainIdentifier(c, "tag_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid tag ID").Response())
+		}
+
+		renameInput := new(renameDTO)
+		if err := rest.DecodeEchoBody(c, renameInput); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Body").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, renameInput); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Body").Response())
+		}
+
+		if err := h.uc.Rename(ctx, tagID, renameInput.Name, renameInput.KeepAlias); err != nil {
+			return h.responseWithUseCaseErr(c, err, "Rename")
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+func (h *TagHandlers) GetUsage() echo.HandlerFunc {
+	type usageQuery struct {
+		Days  int    `query:"days" validate:"omitempty,gte=1,lte=365"`
+		Sort  string `query:"sort" validate:"omitempty,oneof=usage growth"`
+		Limit int    `query:"limit" validate:"required,gte=1,lte=100"`
+		Page  int    `query:"page" validate:"required,gte=1"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		query := new(usageQuery)
+		if err := rest.DecodeEchoBody(c, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		sort := domain.TagUsageSortMethod(query.Sort)
+		if sort == "" {
+			sort = domain.TagUsageTotalSort
+		}
+
+		pagInput := &domain.PaginationInput{Page: query.Page, PerPage: query.Limit}
+		usage, err := h.uc.GetUsage(ctx, orDefaultDays(query.Days), sort, pagInput)
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "GetUsage")
+		}
+
+		return c.JSON(http.StatusOK, usage)
+	}
+}
+
+func (h *TagHandlers) GetUsageHistory() echo.HandlerFunc {
+	type historyQuery struct {
+		Days int `query:"days" validate:"omitempty,gte=1,lte=365"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		tagID, err := rest.PipeDomainIdentifier(c, "tag_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid tag ID").Response())
+		}
+
+		query := new(historyQuery)
+		if err := rest.DecodeEchoBody(c, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, query); err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid Request Query").Response())
+		}
+
+		history, err := h.uc.GetUsageHistory(ctx, tagID, orDefaultDays(query.Days))
+		if err != nil {
+			return h.responseWithUseCaseErr(c, err, "GetUsageHistory")
+		}
+
+		return c.JSON(http.StatusOK, history)
+	}
+}
+
+func (h *TagHandlers) moderateProposal(
+	trace string, moderate func(ctx context.Context, tagID domain.ID) error,
+) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		tagID, err := rest.PipeDomainIdentifier(c, "tag_id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("Invalid tag ID").Response())
+		}
+
+		if err := moderate(ctx, tagID); err != nil {
+			return h.responseWithUseCaseErr(c, err, trace)
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
+// orDefaultDays falls back to the last month when the stats period is omitted
+func orDefaultDays(days int) int {
+	if days == 0 {
+		return 30
+	}
+	return days
+}
+
 func (h *TagHandlers) responseWithUseCaseErr(c echo.Context, err error, trace string) error {
 	var restErr *rest.Error
 	switch {
@@ -182,6 +548,8 @@ func (h *TagHandlers) responseWithUseCaseErr(c echo.Context, err error, trace st
 		restErr = rest.NewBadRequestError("Incorrect image reference provided")
 	case errors.Is(err, usecase.ErrForbidden):
 		restErr = rest.NewForbiddenError("You don't have permission to perform this action")
+	case errors.Is(err, usecase.ErrUnprocessable):
+		restErr = rest.NewBadRequestError("The tag can't be used this way")
 	case errors.Is(err, usecase.ErrAlreadyExists):
 		restErr = rest.NewConflictError("Tag with this name already exists")
 	case errors.Is(err, usecase.ErrNotFound):
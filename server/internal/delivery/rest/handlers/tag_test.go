@@ -401,7 +401,7 @@ func TestTagHandlers_Seacrh(t *testing.T) {
 		c, rec := prepareSearchQuery(&validSearchInput)
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockTagUC.EXPECT().Search(ctx, validSearchInput.Query).Return([]domain.Tag{}, nil)
+		mockTagUC.EXPECT().Search(ctx, validSearchInput.Query, gomock.Any()).Return([]domain.Tag{}, nil)
 
 		assert.NoError(t, h.Search()(c))
 		assert.Equal(t, http.StatusOK, rec.Code)
@@ -414,7 +414,7 @@ func TestTagHandlers_Seacrh(t *testing.T) {
 	t.Run("InvalidQuery", func(t *testing.T) {
 		c, rec := prepareSearchQuery(nil)
 
-		mockTagUC.EXPECT().Search(gomock.Any(), gomock.Any()).Times(0)
+		mockTagUC.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		assert.NoError(t, h.Search()(c))
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -424,7 +424,7 @@ func TestTagHandlers_Seacrh(t *testing.T) {
 		c, rec := prepareSearchQuery(&validSearchInput)
 
 		ctx := rest.GetEchoRequestCtx(c)
-		mockTagUC.EXPECT().Search(ctx, validSearchInput.Query).Return(nil, errors.New("internal error"))
+		mockTagUC.EXPECT().Search(ctx, validSearchInput.Query, gomock.Any()).Return(nil, errors.New("internal error"))
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
 
 		assert.NoError(t, h.Search()(c))
@@ -511,3 +511,609 @@ func TestTagHandlers_Delete(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, rec.Code)
 	})
 }
+
+func TestTagHandlers_SetParent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagUC := handlersMock.NewMockTagUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	h := handlers.NewTagHandlers(mockTagUC, mockLog)
+	e := echo.New()
+
+	tagID := handlersMock.DomainID()
+	parentID := handlersMock.DomainID()
+
+	prepareSetParentQuery := func(id string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/images/tags/:tag_id/parent", body)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag_id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("SuccessSetParent", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"parentID": parentID})
+		c, rec := prepareSetParentQuery(tagID.String(), bytes.NewBuffer(body))
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().SetParent(ctx, tagID, &parentID).Return(nil)
+
+		assert.NoError(t, h.SetParent()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("SuccessDetach", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"parentID": nil})
+		c, rec := prepareSetParentQuery(tagID.String(), bytes.NewBuffer(body))
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().SetParent(ctx, tagID, nil).Return(nil)
+
+		assert.NoError(t, h.SetParent()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("InvalidTagID", func(t *testing.T) {
+		c, rec := prepareSetParentQuery("abc", nil)
+
+		mockTagUC.EXPECT().SetParent(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.SetParent()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"parentID": parentID})
+		c, rec := prepareSetParentQuery(tagID.String(), bytes.NewBuffer(body))
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().SetParent(ctx, tagID, &parentID).Return(usecase.ErrUnprocessable)
+
+		assert.NoError(t, h.SetParent()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestTagHandlers_AddAlias(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagUC := handlersMock.NewMockTagUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	h := handlers.NewTagHandlers(mockTagUC, mockLog)
+	e := echo.New()
+
+	tagID := handlersMock.DomainID()
+
+	prepareAddAliasQuery := func(body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/images/tags/:tag_id/aliases", body)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag_id")
+		c.SetParamValues(tagID.String())
+		return c, rec
+	}
+
+	t.Run("SuccessAddAlias", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]string{"alias": "kitty"})
+		c, rec := prepareAddAliasQuery(bytes.NewBuffer(body))
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().AddAlias(ctx, tagID, "kitty").Return(nil)
+
+		assert.NoError(t, h.AddAlias()(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	t.Run("InvalidAlias", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]string{"alias": "Kitty"})
+		c, rec := prepareAddAliasQuery(bytes.NewBuffer(body))
+
+		mockTagUC.EXPECT().AddAlias(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.AddAlias()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("AlreadyExists", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]string{"alias": "kitty"})
+		c, rec := prepareAddAliasQuery(bytes.NewBuffer(body))
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().AddAlias(ctx, tagID, "kitty").Return(usecase.ErrAlreadyExists)
+
+		assert.NoError(t, h.AddAlias()(c))
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+func TestTagHandlers_RemoveAlias(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagUC := handlersMock.NewMockTagUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	h := handlers.NewTagHandlers(mockTagUC, mockLog)
+	e := echo.New()
+
+	tagID := handlersMock.DomainID()
+
+	prepareRemoveAliasQuery := func(alias string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/images/tags/:tag_id/aliases/:alias", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag_id", "alias")
+		c.SetParamValues(tagID.String(), alias)
+		return c, rec
+	}
+
+	t.Run("SuccessRemoveAlias", func(t *testing.T) {
+		c, rec := prepareRemoveAliasQuery("kitty")
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().RemoveAlias(ctx, tagID, "kitty").Return(nil)
+
+		assert.NoError(t, h.RemoveAlias()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		c, rec := prepareRemoveAliasQuery("kitty")
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().RemoveAlias(ctx, tagID, "kitty").Return(usecase.ErrNotFound)
+
+		assert.NoError(t, h.RemoveAlias()(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestTagHandlers_GetImages(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagUC := handlersMock.NewMockTagUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	_, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewTagHandlers(mockTagUC, mockLog)
+	e := echo.New()
+
+	tagID := handlersMock.DomainID()
+
+	prepareGetImagesQuery := func(page, limit string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/images/tags/:tag_id/images", nil)
+		req.URL.RawQuery = url.Values{
+			"page":  []string{page},
+			"limit": []string{limit},
+		}.Encode()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag_id")
+		c.SetParamValues(tagID.String())
+		return c, rec
+	}
+
+	pagInput := &domain.PaginationInput{Page: 1, PerPage: 10}
+
+	t.Run("SuccessGetImages", func(t *testing.T) {
+		c, rec := prepareGetImagesQuery("1", "10")
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().GetImages(ctx, tagID, pagInput, gomock.Not(gomock.Nil())).Return(
+			&domain.Pagination[domain.ImageWithMeta]{PaginationInput: *pagInput}, nil,
+		)
+
+		assert.NoError(t, h.GetImages()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		c, rec := prepareGetImagesQuery("1", "10")
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().GetImages(ctx, tagID, pagInput, gomock.Nil()).Return(
+			&domain.Pagination[domain.ImageWithMeta]{PaginationInput: *pagInput}, nil,
+		)
+
+		assert.NoError(t, h.GetImages()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		c, rec := prepareGetImagesQuery("0", "1000")
+
+		mockTagUC.EXPECT().GetImages(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.GetImages()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("TagNotFound", func(t *testing.T) {
+		c, rec := prepareGetImagesQuery("1", "10")
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().GetImages(ctx, tagID, pagInput, gomock.Any()).Return(nil, usecase.ErrNotFound)
+
+		assert.NoError(t, h.GetImages()(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestTagHandlers_Suggestions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagUC := handlersMock.NewMockTagUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	_, mockCtxUser := handlersMock.NewMockCtxUser()
+	h := handlers.NewTagHandlers(mockTagUC, mockLog)
+	e := echo.New()
+
+	imageID := handlersMock.DomainID()
+
+	prepareSuggestionsQuery := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/images/:image_id/tags/suggestions", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("image_id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("SuccessSuggestions", func(t *testing.T) {
+		c, rec := prepareSuggestionsQuery(imageID.String())
+		mockCtxUser(c)
+
+		suggestions := []domain.TagSuggestion{
+			{Name: "cat", Score: 0.9, Sources: []domain.TagSuggestionSource{domain.TagSuggestionSimilar}},
+		}
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().Suggestions(ctx, imageID, gomock.Any()).Return(suggestions, nil)
+
+		assert.NoError(t, h.Suggestions()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var actual []domain.TagSuggestion
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+		assert.Equal(t, suggestions, actual)
+	})
+
+	t.Run("IncorrectUserContext", func(t *testing.T) {
+		c, rec := prepareSuggestionsQuery(imageID.String())
+
+		mockTagUC.EXPECT().Suggestions(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.Suggestions()(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("InvalidImageID", func(t *testing.T) {
+		c, rec := prepareSuggestionsQuery("abc")
+		mockCtxUser(c)
+
+		mockTagUC.EXPECT().Suggestions(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Suggestions()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		c, rec := prepareSuggestionsQuery(imageID.String())
+		mockCtxUser(c)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().Suggestions(ctx, imageID, gomock.Any()).Return(nil, usecase.ErrForbidden)
+
+		assert.NoError(t, h.Suggestions()(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
+
+func TestTagHandlers_GetProposals(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagUC := handlersMock.NewMockTagUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	h := handlers.NewTagHandlers(mockTagUC, mockLog)
+	e := echo.New()
+
+	prepareGetProposalsQuery := func(page, limit string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/images/tags/proposals", nil)
+		req.URL.RawQuery = url.Values{
+			"page":  []string{page},
+			"limit": []string{limit},
+		}.Encode()
+		rec := httptest.NewRecorder()
+		return e.NewContext(req, rec), rec
+	}
+
+	pagInput := &domain.PaginationInput{Page: 1, PerPage: 10}
+
+	t.Run("SuccessGetProposals", func(t *testing.T) {
+		c, rec := prepareGetProposalsQuery("1", "10")
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().GetProposals(ctx, pagInput).Return(
+			&domain.Pagination[domain.TagProposal]{PaginationInput: *pagInput}, nil,
+		)
+
+		assert.NoError(t, h.GetProposals()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		c, rec := prepareGetProposalsQuery("0", "10")
+
+		mockTagUC.EXPECT().GetProposals(gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.GetProposals()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestTagHandlers_ModerateProposal(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagUC := handlersMock.NewMockTagUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	h := handlers.NewTagHandlers(mockTagUC, mockLog)
+	e := echo.New()
+
+	tagID := handlersMock.DomainID()
+	targetID := handlersMock.DomainID()
+
+	prepareModerateQuery := func(id string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/images/tags/:tag_id/approve", body)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag_id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("SuccessApprove", func(t *testing.T) {
+		c, rec := prepareModerateQuery(tagID.String(), nil)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().ApproveProposal(ctx, tagID).Return(nil)
+
+		assert.NoError(t, h.ApproveProposal()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("RejectNotFound", func(t *testing.T) {
+		c, rec := prepareModerateQuery(tagID.String(), nil)
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().RejectProposal(ctx, tagID).Return(usecase.ErrNotFound)
+
+		assert.NoError(t, h.RejectProposal()(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("InvalidTagID", func(t *testing.T) {
+		c, rec := prepareModerateQuery("abc", nil)
+
+		mockTagUC.EXPECT().ApproveProposal(gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.ApproveProposal()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("SuccessMerge", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"targetID": targetID})
+		c, rec := prepareModerateQuery(tagID.String(), bytes.NewBuffer(body))
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().Merge(ctx, tagID, targetID).Return(nil)
+
+		assert.NoError(t, h.Merge()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("MergeWithoutTarget", func(t *testing.T) {
+		c, rec := prepareModerateQuery(tagID.String(), bytes.NewBufferString("{}"))
+
+		mockTagUC.EXPECT().Merge(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Merge()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("MergeUnprocessable", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"targetID": targetID})
+		c, rec := prepareModerateQuery(tagID.String(), bytes.NewBuffer(body))
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().Merge(ctx, tagID, targetID).Return(usecase.ErrUnprocessable)
+
+		assert.NoError(t, h.Merge()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestTagHandlers_Rename(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagUC := handlersMock.NewMockTagUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	h := handlers.NewTagHandlers(mockTagUC, mockLog)
+	e := echo.New()
+
+	tagID := handlersMock.DomainID()
+
+	prepareRenameQuery := func(body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/images/tags/:tag_id", body)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag_id")
+		c.SetParamValues(tagID.String())
+		return c, rec
+	}
+
+	t.Run("SuccessRename", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"name": "cat", "keepAlias": true})
+		c, rec := prepareRenameQuery(bytes.NewBuffer(body))
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().Rename(ctx, tagID, "cat", true).Return(nil)
+
+		assert.NoError(t, h.Rename()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("InvalidName", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"name": ""})
+		c, rec := prepareRenameQuery(bytes.NewBuffer(body))
+
+		mockTagUC.EXPECT().Rename(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.Rename()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("AlreadyExists", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"name": "cat"})
+		c, rec := prepareRenameQuery(bytes.NewBuffer(body))
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().Rename(ctx, tagID, "cat", false).Return(usecase.ErrAlreadyExists)
+
+		assert.NoError(t, h.Rename()(c))
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+func TestTagHandlers_GetUsage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagUC := handlersMock.NewMockTagUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	h := handlers.NewTagHandlers(mockTagUC, mockLog)
+	e := echo.New()
+
+	prepareGetUsageQuery := func(query url.Values) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/images/tags/stats", nil)
+		req.URL.RawQuery = query.Encode()
+		rec := httptest.NewRecorder()
+		return e.NewContext(req, rec), rec
+	}
+
+	pagInput := &domain.PaginationInput{Page: 1, PerPage: 10}
+	mockPag := &domain.Pagination[domain.TagUsage]{PaginationInput: *pagInput}
+
+	t.Run("SuccessGetUsage", func(t *testing.T) {
+		c, rec := prepareGetUsageQuery(url.Values{
+			"page": {"1"}, "limit": {"10"}, "days": {"7"}, "sort": {"growth"},
+		})
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().GetUsage(ctx, 7, domain.TagUsageGrowthSort, pagInput).Return(mockPag, nil)
+
+		assert.NoError(t, h.GetUsage()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Defaults", func(t *testing.T) {
+		c, rec := prepareGetUsageQuery(url.Values{"page": {"1"}, "limit": {"10"}})
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().GetUsage(ctx, 30, domain.TagUsageTotalSort, pagInput).Return(mockPag, nil)
+
+		assert.NoError(t, h.GetUsage()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("InvalidSort", func(t *testing.T) {
+		c, rec := prepareGetUsageQuery(url.Values{"page": {"1"}, "limit": {"10"}, "sort": {"name"}})
+
+		mockTagUC.EXPECT().GetUsage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.GetUsage()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestTagHandlers_GetUsageHistory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagUC := handlersMock.NewMockTagUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	h := handlers.NewTagHandlers(mockTagUC, mockLog)
+	e := echo.New()
+
+	tagID := handlersMock.DomainID()
+
+	prepareGetUsageHistoryQuery := func(days string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/images/tags/:tag_id/stats", nil)
+		req.URL.RawQuery = url.Values{"days": {days}}.Encode()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag_id")
+		c.SetParamValues(tagID.String())
+		return c, rec
+	}
+
+	t.Run("SuccessGetUsageHistory", func(t *testing.T) {
+		c, rec := prepareGetUsageHistoryQuery("14")
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().GetUsageHistory(ctx, tagID, 14).Return([]domain.TagUsagePoint{}, nil)
+
+		assert.NoError(t, h.GetUsageHistory()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("InvalidDays", func(t *testing.T) {
+		c, rec := prepareGetUsageHistoryQuery("1000")
+
+		mockTagUC.EXPECT().GetUsageHistory(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.GetUsageHistory()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("TagNotFound", func(t *testing.T) {
+		c, rec := prepareGetUsageHistoryQuery("14")
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockTagUC.EXPECT().GetUsageHistory(ctx, tagID, 14).Return(nil, usecase.ErrNotFound)
+
+		assert.NoError(t, h.GetUsageHistory()(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -18,6 +18,7 @@ type userUseCase interface {
 		ctx context.Context, id domain.ID, deny domain.Permission, allow domain.Permission,
 	) error
 	GetDetailed(ctx context.Context, username string, executorID *domain.ID) (*domain.DetailedUser, error)
+	SetPrivate(ctx context.Context, id domain.ID, private bool) error
 }
 
 type UserHandlers struct {
@@ -159,6 +160,43 @@ func (h *UserHandlers) OverwritePermissions() echo.HandlerFunc {
 	}
 }
 
+func (h *UserHandlers) SetPrivacy() echo.HandlerFunc {
+	type privacyDTO struct {
+		Private *bool `json:"private" validate:"required"`
+	}
+
+	return func(c echo.Context) error {
+		ctx := rest.GetEchoRequestCtx(c)
+
+		id, err := rest.PipeDomainIdentifier(c, "id")
+		if err != nil {
+			return c.JSON(rest.NewBadRequestError("ID has incorrect type").Response())
+		}
+
+		dto := new(privacyDTO)
+		if err := rest.DecodeEchoBody(c, dto); err != nil {
+			h.logger.Errorf("SetPrivacy.DecodeBody: %v", err)
+			return c.JSON(rest.NewBadRequestError("SetPrivacy body has incorrect type").Response())
+		}
+
+		if err := validator.ValidateStruct(ctx, dto); err != nil {
+			return c.JSON(rest.NewBadRequestError("SetPrivacy body has incorrect type").Response())
+		}
+
+		if err := h.uc.SetPrivate(ctx, id, *dto.Private); err != nil {
+			switch err {
+			case usecase.ErrNotFound:
+				return c.JSON(rest.NewNotFoundError("User not found").Response())
+			default:
+				h.logger.Errorf("User.SetPrivacy: %v", err)
+				return c.JSON(rest.NewInternalServerError().Response())
+			}
+		}
+
+		return c.JSON(http.StatusOK, true)
+	}
+}
+
 func (h *UserHandlers) Me() echo.HandlerFunc {
 	return func(c echo.Context) error {
 		user, err := GetContextUser(c)
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/labstack/echo/v4"
@@ -430,3 +431,76 @@ func TestUserHanlers_OverwritePermissions(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, rec.Code)
 	})
 }
+
+func TestUserHanlers_SetPrivacy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	targetUserID := handlersMock.DomainID()
+	targetItoaUserID := targetUserID.String()
+
+	mockUserUC := handlersMock.NewMockuserUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+
+	h := handlers.NewUserHandlers(mockUserUC, mockLog)
+	e := echo.New()
+
+	prepareSetPrivacyQuery := func(id string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/users/:id/privacy", body)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("SuccessSetPrivacy", func(t *testing.T) {
+		c, rec := prepareSetPrivacyQuery(targetItoaUserID, strings.NewReader(`{"private":true}`))
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockUserUC.EXPECT().SetPrivate(ctx, targetUserID, true).Return(nil)
+
+		assert.NoError(t, h.SetPrivacy()(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("InvalidInput", func(t *testing.T) {
+		c, rec := prepareSetPrivacyQuery(targetItoaUserID, strings.NewReader(`{}`))
+
+		mockUserUC.EXPECT().SetPrivate(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.SetPrivacy()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("IncorrectUserID", func(t *testing.T) {
+		c, rec := prepareSetPrivacyQuery("abc", strings.NewReader(`{"private":true}`))
+
+		mockUserUC.EXPECT().SetPrivate(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, h.SetPrivacy()(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		c, rec := prepareSetPrivacyQuery(targetItoaUserID, strings.NewReader(`{"private":false}`))
+
+		ctx := rest.GetEchoRequestCtx(c)
+		mockUserUC.EXPECT().SetPrivate(ctx, targetUserID, false).Return(usecase.ErrNotFound)
+
+		assert.NoError(t, h.SetPrivacy()(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("InternalServerError", func(t *testing.T) {
+		c, rec := prepareSetPrivacyQuery(targetItoaUserID, strings.NewReader(`{"private":true}`))
+
+		mockUserUC.EXPECT().SetPrivate(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("internal error"))
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, h.SetPrivacy()(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -8,6 +8,10 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/pkg/rest"
+	"golang.org/x/time/rate"
 )
 
 func TimeoutMiddleware(
@@ -32,3 +36,31 @@ func TimeoutMiddleware(
 		}
 	}
 }
+
+// RateLimitMiddleware limits requests per authorized user (or per IP for anonymous ones)
+// with in-memory token buckets, so it should be placed after auth middlewares
+func RateLimitMiddleware(rps float64, burst int) echo.MiddlewareFunc {
+	store := middleware.NewRateLimiterMemoryStoreWithConfig(
+		middleware.RateLimiterMemoryStoreConfig{
+			Rate:      rate.Limit(rps),
+			Burst:     burst,
+			ExpiresIn: 3 * time.Minute,
+		},
+	)
+
+	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		Store: store,
+		IdentifierExtractor: func(c echo.Context) (string, error) {
+			if user, ok := c.Get("user").(*domain.User); ok && user != nil {
+				return "user:" + user.ID.String(), nil
+			}
+			return "ip:" + c.RealIP(), nil
+		},
+		ErrorHandler: func(c echo.Context, err error) error {
+			return c.JSON(rest.NewError(http.StatusForbidden, "Unable to identify the request").Response())
+		},
+		DenyHandler: func(c echo.Context, _ string, _ error) error {
+			return c.JSON(rest.NewError(http.StatusTooManyRequests, "Too many requests").Response())
+		},
+	})
+}
@@ -8,11 +8,26 @@ import (
 
 func MapAlbumRoutes(g *echo.Group, h *handlers.AlbumHandlers, mw *middlewares.GuardMiddlewares) {
 	g.POST("/", h.Create(), mw.OnlyAuth)
-	g.GET("/users/:user_id", h.GetByAuthorID())
+	g.GET("/users/:user_id", h.GetByAuthorID(), mw.OptionalAuth)
+	g.GET("/followed", h.GetFollowed(), mw.OnlyAuth)
 	g.DELETE("/:album_id", h.Delete(), mw.OnlyAuth)
 	g.PUT("/:album_id", h.Update(), mw.OnlyAuth)
+	g.POST("/:album_id/share", h.Share(), mw.OnlyAuth)
+	g.DELETE("/:album_id/share", h.RevokeShare(), mw.OnlyAuth)
+	g.PUT("/:album_id/cover", h.SetCover(), mw.OnlyAuth)
 
 	g.POST("/:album_id/images/:image_id", h.PutImage(), mw.OnlyAuth)
 	g.DELETE("/:album_id/images/:image_id", h.DeleteImage(), mw.OnlyAuth)
-	g.GET("/:album_id/images", h.GetAlbumImages())
+	g.GET("/:album_id/images", h.GetAlbumImages(), mw.OptionalAuth)
+	g.PUT("/:album_id/images/order", h.SetImagesOrder(), mw.OnlyAuth)
+	g.PUT("/:album_id/images/:image_id/position", h.MoveImage(), mw.OnlyAuth)
+
+	g.GET("/:album_id/members", h.GetMembers(), mw.OptionalAuth)
+	g.POST("/:album_id/members", h.InviteMember(), mw.OnlyAuth)
+	g.POST("/:album_id/members/accept", h.AcceptInvitation(), mw.OnlyAuth)
+	g.PUT("/:album_id/members/:user_id", h.UpdateMemberRole(), mw.OnlyAuth)
+	g.DELETE("/:album_id/members/:user_id", h.RemoveMember(), mw.OnlyAuth)
+
+	g.POST("/:album_id/follow", h.Follow(), mw.OnlyAuth)
+	g.DELETE("/:album_id/follow", h.Unfollow(), mw.OnlyAuth)
 }
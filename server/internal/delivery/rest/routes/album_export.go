@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pillowskiy/gopix/internal/delivery/rest/handlers"
+	"github.com/pillowskiy/gopix/internal/delivery/rest/middlewares"
+)
+
+func MapAlbumExportRoutes(g *echo.Group, h *handlers.AlbumExportHandlers, mw *middlewares.GuardMiddlewares) {
+	g.GET("/:album_id/export", h.Export(), mw.OptionalAuth)
+}
+
+// MapAlbumAsyncExportRoutes maps the asynchronous export, which needs the archive bucket
+func MapAlbumAsyncExportRoutes(g *echo.Group, h *handlers.AlbumExportHandlers, mw *middlewares.GuardMiddlewares) {
+	g.POST("/:album_id/export", h.ExportAsync(), mw.OnlyAuth)
+}
@@ -8,10 +8,18 @@ import (
 
 func MapCommentRoutes(g *echo.Group, h *handlers.CommentHandlers, mw *middlewares.GuardMiddlewares) {
 	g.POST("/:image_id/comments", h.Create(), mw.OnlyAuth)
-	g.GET("/:image_id/comments", h.GetByImageID())
+	g.GET("/:image_id/comments", h.GetByImageID(), mw.OptionalAuth)
+	g.POST("/:image_id/comments/lock", h.LockComments(), mw.OnlyAuth)
+	g.DELETE("/:image_id/comments/lock", h.UnlockComments(), mw.OnlyAuth)
 	g.PUT("/comments/:comment_id", h.Update(), mw.OnlyAuth)
 	g.DELETE("/comments/:comment_id", h.Delete(), mw.OnlyAuth)
-	g.GET("/comments/:comment_id/replies", h.GetReplies())
+	g.GET("/comments/:comment_id/replies", h.GetReplies(), mw.OptionalAuth)
+	g.GET("/comments/:comment_id/revisions", h.GetRevisions(), mw.OnlyAuth)
+
+	g.POST("/comments/:comment_id/pin", h.PinComment(), mw.OnlyAuth)
+	g.DELETE("/comments/:comment_id/pin", h.UnpinComment(), mw.OnlyAuth)
+	g.POST("/comments/:comment_id/hide", h.HideComment(), mw.OnlyAuth)
+	g.DELETE("/comments/:comment_id/hide", h.UnhideComment(), mw.OnlyAuth)
 
 	g.POST("/comments/:comment_id/like", h.LikeComment(), mw.OnlyAuth)
 	g.DELETE("/comments/:comment_id/like", h.UnlikeComment(), mw.OnlyAuth)
@@ -10,8 +10,8 @@ import (
 )
 
 func MapImageRoutes(g *echo.Group, h *handlers.ImageHandlers, mw *middlewares.GuardMiddlewares) {
-	g.GET("/", h.GetDiscover())
-	g.GET("/favorites/:user_id", h.Favorites())
+	g.GET("/", h.GetDiscover(), mw.OptionalAuth)
+	g.GET("/favorites/:user_id", h.Favorites(), mw.OptionalAuth)
 
 	g.POST("/",
 		h.Upload(),
@@ -20,6 +20,14 @@ func MapImageRoutes(g *echo.Group, h *handlers.ImageHandlers, mw *middlewares.Gu
 		middlewares.TimeoutMiddleware(15*time.Minute),
 	)
 
+	g.GET("/search", h.Search(), mw.OptionalAuth)
+	g.POST("/search/similar",
+		h.SearchSimilar(),
+		mw.OptionalAuth,
+		middlewares.RateLimitMiddleware(0.2, 3),
+		middlewares.TimeoutMiddleware(time.Minute),
+	)
+
 	g.DELETE("/:id", h.Delete(), mw.OnlyAuth)
 	g.PUT("/:id", h.Update(), mw.OnlyAuth)
 	g.GET("/:id", h.GetDetailed(), mw.OptionalAuth)
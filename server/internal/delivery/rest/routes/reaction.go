@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pillowskiy/gopix/internal/delivery/rest/handlers"
+	"github.com/pillowskiy/gopix/internal/delivery/rest/middlewares"
+)
+
+func MapReactionRoutes(g *echo.Group, h *handlers.ReactionHandlers, mw *middlewares.GuardMiddlewares) {
+	g.GET("/reactions", h.GetEmojis())
+
+	g.POST("/:image_id/reactions", h.ReactImage(), mw.OnlyAuth)
+	g.DELETE("/:image_id/reactions", h.UnreactImage(), mw.OnlyAuth)
+
+	g.POST("/comments/:comment_id/reactions", h.ReactComment(), mw.OnlyAuth)
+	g.DELETE("/comments/:comment_id/reactions", h.UnreactComment(), mw.OnlyAuth)
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pillowskiy/gopix/internal/delivery/rest/handlers"
+	"github.com/pillowskiy/gopix/internal/delivery/rest/middlewares"
+)
+
+func MapRestrictionRoutes(g *echo.Group, h *handlers.RestrictionHandlers, mw *middlewares.GuardMiddlewares) {
+	g.GET("/@me/blocks", h.Blocked(), mw.OnlyAuth)
+	g.GET("/@me/mutes", h.Muted(), mw.OnlyAuth)
+
+	g.POST("/:id/block", h.Block(), mw.OnlyAuth)
+	g.DELETE("/:id/block", h.Unblock(), mw.OnlyAuth)
+	g.POST("/:id/mute", h.Mute(), mw.OnlyAuth)
+	g.DELETE("/:id/mute", h.Unmute(), mw.OnlyAuth)
+}
@@ -7,6 +7,15 @@ import (
 )
 
 func MapSubscriptionRoutes(g *echo.Group, h *handlers.SubscriptionHandlers, mw *middlewares.GuardMiddlewares) {
+	g.GET("/requests", h.FollowRequests(), mw.OnlyAuth)
+	g.POST("/requests/:user_id/approve", h.ApproveRequest(), mw.OnlyAuth)
+	g.POST("/requests/:user_id/deny", h.DenyRequest(), mw.OnlyAuth)
 	g.POST("/:user_id", h.Follow(), mw.OnlyAuth)
 	g.DELETE("/:user_id", h.Unfollow(), mw.OnlyAuth)
 }
+
+func MapUserSubscriptionRoutes(g *echo.Group, h *handlers.SubscriptionHandlers, mw *middlewares.GuardMiddlewares) {
+	g.GET("/suggestions", h.Suggestions(), mw.OnlyAuth)
+	g.GET("/:id/followers", h.Followers(), mw.OptionalAuth)
+	g.GET("/:id/following", h.Following(), mw.OptionalAuth)
+}
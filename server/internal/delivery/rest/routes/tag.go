@@ -9,8 +9,24 @@ import (
 func MapTagRoutes(g *echo.Group, h *handlers.TagHandlers, mw *middlewares.GuardMiddlewares) {
 	g.GET("/tags", h.Search(), mw.OnlyAuth)
 	g.POST("/tags", h.Create(), mw.OnlyAuth, mw.OnlyAdmin)
+	g.GET("/tags/:tag_id", h.GetDetailed())
+	g.GET("/tags/:tag_id/images", h.GetImages(), mw.OptionalAuth)
 	g.DELETE("/tags/:tag_id", h.Delete(), mw.OnlyAuth, mw.OnlyAdmin)
 
+	g.GET("/tags/proposals", h.GetProposals(), mw.OnlyAuth, mw.OnlyAdmin)
+	g.POST("/tags/:tag_id/approve", h.ApproveProposal(), mw.OnlyAuth, mw.OnlyAdmin)
+	g.POST("/tags/:tag_id/reject", h.RejectProposal(), mw.OnlyAuth, mw.OnlyAdmin)
+
+	g.GET("/tags/stats", h.GetUsage(), mw.OnlyAuth, mw.OnlyAdmin)
+	g.GET("/tags/:tag_id/stats", h.GetUsageHistory(), mw.OnlyAuth, mw.OnlyAdmin)
+	g.PUT("/tags/:tag_id", h.Rename(), mw.OnlyAuth, mw.OnlyAdmin)
+	g.POST("/tags/:tag_id/merge", h.Merge(), mw.OnlyAuth, mw.OnlyAdmin)
+
+	g.PUT("/tags/:tag_id/parent", h.SetParent(), mw.OnlyAuth, mw.OnlyAdmin)
+	g.POST("/tags/:tag_id/aliases", h.AddAlias(), mw.OnlyAuth, mw.OnlyAdmin)
+	g.DELETE("/tags/:tag_id/aliases/:alias", h.RemoveAlias(), mw.OnlyAuth, mw.OnlyAdmin)
+
+	g.GET("/:image_id/tags/suggestions", h.Suggestions(), mw.OnlyAuth)
 	g.PUT("/:image_id/tags", h.UpsertImageTag(), mw.OnlyAuth)
 	g.DELETE("/:image_id/tags/:tag_id", h.DeleteImageTag(), mw.OnlyAuth)
 }
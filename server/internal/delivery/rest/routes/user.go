@@ -9,6 +9,7 @@ import (
 func MapUserRoutes(g *echo.Group, h *handlers.UserHandlers, mw *middlewares.GuardMiddlewares) {
 	g.GET("/@me", h.Me(), mw.OnlyAuth)
 	g.PUT("/:id", h.Update(), mw.OnlyAuth, mw.OwnerOrAdmin)
+	g.PUT("/:id/privacy", h.SetPrivacy(), mw.OnlyAuth, mw.OwnerOrAdmin)
 	g.PUT("/:id/permissions", h.OverwritePermissions(), mw.OnlyAuth, mw.OnlyAdmin)
 	g.GET("/:username", h.GetDetailed(), mw.OptionalAuth)
 }
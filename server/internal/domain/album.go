@@ -2,13 +2,62 @@ package domain
 
 import "time"
 
+type AlbumAccessLevel string
+
+const (
+	AlbumAccessPublic  AlbumAccessLevel = "public"
+	AlbumAccessPrivate AlbumAccessLevel = "private"
+	AlbumAccessLink    AlbumAccessLevel = "link"
+)
+
+type AlbumKind string
+
+const (
+	AlbumKindRegular AlbumKind = "regular"
+	// AlbumKindSmart contents are defined by the album rule instead of the explicitly put images
+	AlbumKindSmart AlbumKind = "smart"
+)
+
+type AlbumImageSortMethod string
+
+const (
+	AlbumImageManualSort  AlbumImageSortMethod = "manual"
+	AlbumImageAddedSort   AlbumImageSortMethod = "added"
+	AlbumImageNewestSort  AlbumImageSortMethod = "newest"
+	AlbumImagePopularSort AlbumImageSortMethod = "popular"
+)
+
 type Album struct {
-	ID          ID        `json:"id" db:"id"`
-	AuthorID    ID        `json:"-" db:"author_id"`
-	Name        string    `json:"name" db:"name"`
-	Description string    `json:"description,omitempty" db:"description"`
-	CreatedAt   time.Time `json:"createdAt" db:"created_at"`
-	UpdatedAt   time.Time `json:"updatedAt" db:"updated_at"`
+	ID          ID               `json:"id" db:"id"`
+	AuthorID    ID               `json:"-" db:"author_id"`
+	Name        string           `json:"name" db:"name"`
+	Description string           `json:"description,omitempty" db:"description"`
+	AccessLevel AlbumAccessLevel `json:"accessLevel" db:"access_level"`
+	Kind        AlbumKind        `json:"kind" db:"kind"`
+	Rule        *AlbumRule       `json:"rule,omitempty" db:"-"`
+	// ShareToken grants access to the link albums, it's exposed to the album modifiers only
+	ShareToken *string `json:"shareToken,omitempty" db:"share_token"`
+	// CoverID is the explicitly selected cover image, the first images are used when it's not set
+	CoverID   *ID       `json:"coverID,omitempty" db:"cover_id"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+// AlbumRule matches the images satisfying all of the set criteria
+type AlbumRule struct {
+	// Tags matches the images having every listed tag
+	Tags     []string   `json:"tags,omitempty"`
+	AuthorID *ID        `json:"authorID,omitempty"`
+	From     *time.Time `json:"from,omitempty"`
+	To       *time.Time `json:"to,omitempty"`
+	MinLikes int        `json:"minLikes,omitempty"`
+	// SimilarTo is the seed image, the matched images are ordered by the similarity to it
+	SimilarTo *ID `json:"similarTo,omitempty"`
+}
+
+func (r *AlbumRule) IsEmpty() bool {
+	return len(r.Tags) == 0 && r.AuthorID == nil && r.From == nil && r.To == nil &&
+		r.MinLikes == 0 && r.SimilarTo == nil
 }
 
 type DetailedAlbum struct {
@@ -22,3 +71,55 @@ type AlbumAuthor struct {
 	Username  string `json:"username" db:"username"`
 	AvatarURL string `json:"avatarURL" db:"avatar_url"`
 }
+
+type AlbumRole string
+
+const (
+	AlbumRoleViewer      AlbumRole = "viewer"
+	AlbumRoleContributor AlbumRole = "contributor"
+	AlbumRoleEditor      AlbumRole = "editor"
+)
+
+var albumRoleRanks = map[AlbumRole]int{
+	AlbumRoleViewer:      1,
+	AlbumRoleContributor: 2,
+	AlbumRoleEditor:      3,
+}
+
+// Includes reports whether the role grants at least the permissions of the other one
+func (r AlbumRole) Includes(other AlbumRole) bool {
+	rank, ok := albumRoleRanks[r]
+	return ok && rank >= albumRoleRanks[other]
+}
+
+type AlbumMember struct {
+	AlbumID   ID          `json:"albumID" db:"album_id"`
+	UserID    ID          `json:"-" db:"user_id"`
+	Role      AlbumRole   `json:"role" db:"role"`
+	Accepted  bool        `json:"accepted" db:"accepted"`
+	InvitedBy *ID         `json:"-" db:"invited_by"`
+	CreatedAt time.Time   `json:"createdAt" db:"created_at"`
+	User      AlbumAuthor `json:"user" db:"user"`
+}
+
+// AlbumExport is the manifest of the album archive, the images are archived in the listed order
+type AlbumExport struct {
+	AlbumID     ID                 `json:"albumID"`
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	ExportedAt  time.Time          `json:"exportedAt"`
+	Images      []AlbumExportImage `json:"images"`
+}
+
+type AlbumExportImage struct {
+	ID ID `json:"id"`
+	// File is the name of the image entry in the archive
+	File        string    `json:"file"`
+	Path        string    `json:"-"`
+	Title       string    `json:"title,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Tags        []string  `json:"tags"`
+	UploadedAt  time.Time `json:"uploadedAt"`
+	// Missing is set when the image file couldn't be read from the storage
+	Missing bool `json:"missing,omitempty"`
+}
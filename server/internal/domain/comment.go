@@ -11,20 +11,79 @@ const (
 	CommentOldestSort CommentSortMethod = "oldest"
 )
 
+type CommentViewMode string
+
+const (
+	// CommentTreeView nests the replies of every comment into its replies field
+	CommentTreeView CommentViewMode = "tree"
+	// CommentFlatView lists the threads depth-first, the nesting is restored by the depth and parent ID
+	CommentFlatView CommentViewMode = "flat"
+)
+
 type Comment struct {
 	ID        ID        `json:"id" db:"id"`
 	AuthorID  ID        `json:"-" db:"author_id"`
 	ImageID   ID        `json:"-" db:"image_id"`
-	ParentID  *ID       `json:"-" db:"parent_id"`
+	ParentID  *ID       `json:"parentID,omitempty" db:"parent_id"`
+	Depth     int       `json:"depth" db:"depth"`
 	Text      string    `json:"text" db:"comment"`
 	CreatedAt time.Time `json:"createdAt" db:"created_at"`
 	UpdatedAt time.Time `json:"updatedAt" db:"updated_at"`
+	// EditedAt is set once the text was changed by the edit
+	EditedAt *time.Time `json:"editedAt,omitempty" db:"edited_at"`
+	// DeletedAt is set for the tombstones of deleted comments that are kept for their replies
+	DeletedAt *time.Time `json:"deletedAt,omitempty" db:"deleted_at"`
+	// PinnedAt is set for the root comment pinned on top of the image comments
+	PinnedAt *time.Time `json:"pinnedAt,omitempty" db:"pinned_at"`
+	// HiddenAt is set for the comments hidden by the moderators, they are visible only to their authors
+	HiddenAt *time.Time       `json:"hiddenAt,omitempty" db:"hidden_at"`
+	Mentions []CommentMention `json:"mentions,omitempty" db:"-"`
+}
+
+func (c *Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+func (c *Comment) IsHidden() bool {
+	return c.HiddenAt != nil
+}
+
+// CommentVisibility limits the comments to the ones the viewer is allowed to see
+type CommentVisibility struct {
+	ViewerID *ID
+	// ShowHidden is set for the moderators of the image comments
+	ShowHidden bool
+}
+
+func (v *CommentVisibility) CanSee(comment *Comment) bool {
+	if !comment.IsHidden() || v.ShowHidden {
+		return true
+	}
+	return v.ViewerID != nil && *v.ViewerID == comment.AuthorID
+}
+
+// CommentRevision is the text of the comment before it was edited or deleted
+type CommentRevision struct {
+	ID        ID        `json:"id" db:"id"`
+	CommentID ID        `json:"commentID" db:"comment_id"`
+	Text      string    `json:"text" db:"comment"`
+	EditedBy  *ID       `json:"editedBy" db:"edited_by"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+}
+
+// CommentMention is a user resolved from the @username mention of the comment text
+type CommentMention struct {
+	UserID   ID     `json:"userID" db:"user_id"`
+	Username string `json:"username" db:"username"`
 }
 
 type DetailedComment struct {
 	Comment
-	Stats  CommentStats  `json:"stats" db:"stats"`
-	Author CommentAuthor `json:"author" db:"author"`
+	Stats     CommentStats    `json:"stats" db:"stats"`
+	Author    CommentAuthor   `json:"author" db:"author"`
+	Reactions []ReactionCount `json:"reactions" db:"-"`
+	// Replies are filled only in the tree view
+	Replies []DetailedComment `json:"replies,omitempty" db:"-"`
 }
 
 type CommentStats struct {
@@ -13,6 +13,13 @@ const (
 	ImageMostViewedSort ImageSortMethod = "mostViewed"
 )
 
+type ImageSearchMode string
+
+const (
+	ImageSearchSemantic ImageSearchMode = "semantic"
+	ImageSearchText     ImageSearchMode = "text"
+)
+
 type ImageAccessLevel string
 
 const (
@@ -29,8 +36,10 @@ type Image struct {
 	Description string           `json:"description,omitempty" db:"description"`
 	AccessLevel ImageAccessLevel `json:"accessLevel" db:"access_level"`
 	ExpiresAt   *time.Time       `json:"expiresAt,omitempty" db:"expires_at"`
-	CreatedAt   time.Time        `json:"createdAt" db:"uploaded_at"`
-	UpdatedAt   time.Time        `json:"updatedAt" db:"updated_at"`
+	// CommentsLocked disallows new comments on the image for everyone except its moderators
+	CommentsLocked bool      `json:"commentsLocked" db:"comments_locked"`
+	CreatedAt      time.Time `json:"createdAt" db:"uploaded_at"`
+	UpdatedAt      time.Time `json:"updatedAt" db:"updated_at"`
 }
 
 type ImageProperties struct {
@@ -44,6 +53,8 @@ type ImageStates struct {
 	ImageID ID   `json:"imageID" db:"image_id"`
 	Viewed  bool `json:"viewed" db:"viewed"`
 	Liked   bool `json:"liked" db:"liked"`
+	// Reactions are the emojis the user has reacted with
+	Reactions []string `json:"reactions" db:"-"`
 }
 
 type ImageWithMeta struct {
@@ -57,6 +68,8 @@ type DetailedImage struct {
 	Likes int        `json:"likes" db:"likes"`
 	Views int        `json:"views" db:"views"`
 	Tags  []ImageTag `json:"tags" db:"tags"`
+	// Reactions are filled in order of the configured emoji set, emojis without reactions are omitted
+	Reactions []ReactionCount `json:"reactions" db:"-"`
 }
 
 type ImageAuthor struct {
@@ -68,4 +81,6 @@ type ImageAuthor struct {
 type ImageTag struct {
 	ID   ID     `json:"id" db:"id"`
 	Name string `json:"name" db:"name"`
+	// Pending tags are shown only on the images of their proposer until approved
+	Pending bool `json:"pending,omitempty" db:"pending"`
 }
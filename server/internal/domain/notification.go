@@ -12,6 +12,8 @@ type Notification struct {
 	Hidden  bool      `json:"hidden" db:"hidden"`
 	Read    bool      `json:"read" db:"read"`
 	SentAt  time.Time `json:"sentAt" db:"sent_at"`
+	// ActorID is the user whose action caused the notification, if any
+	ActorID *ID `json:"-" db:"-"`
 }
 
 type NotificationStats struct {
@@ -15,3 +15,8 @@ type OffsetPaginationInput struct {
 	PaginationInput
 	Offset int `json:"offset"`
 }
+
+type CursorPagination[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
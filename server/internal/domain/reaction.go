@@ -0,0 +1,23 @@
+package domain
+
+type ReactionTarget string
+
+const (
+	ReactionTargetImage   ReactionTarget = "image"
+	ReactionTargetComment ReactionTarget = "comment"
+)
+
+type Reaction struct {
+	Target   ReactionTarget `json:"target" db:"target_type"`
+	TargetID ID             `json:"targetID" db:"target_id"`
+	UserID   ID             `json:"-" db:"user_id"`
+	Emoji    string         `json:"emoji" db:"emoji"`
+}
+
+// ReactionCount is the aggregated number of the reactions with the emoji,
+// reacted is set when the viewer is known and has reacted with it
+type ReactionCount struct {
+	Emoji   string `json:"emoji"`
+	Count   int    `json:"count"`
+	Reacted bool   `json:"reacted"`
+}
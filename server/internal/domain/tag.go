@@ -2,9 +2,85 @@ package domain
 
 import "time"
 
+type TagStatus string
+
+const (
+	TagStatusApproved TagStatus = "approved"
+	// TagStatusPending is a tag proposed by a user and waiting for the moderation
+	TagStatusPending TagStatus = "pending"
+)
+
 type Tag struct {
-	ID        ID        `json:"id" db:"id"`
-	Name      string    `json:"name" db:"name"`
-	CreatedAt time.Time `json:"-" db:"created_at"`
-	UpdatedAt time.Time `json:"-" db:"updated_at"`
+	ID         ID        `json:"id" db:"id"`
+	Name       string    `json:"name" db:"name"`
+	ParentID   *ID       `json:"parentID,omitempty" db:"parent_id"`
+	Status     TagStatus `json:"status" db:"status"`
+	ProposerID *ID       `json:"proposerID,omitempty" db:"proposer_id"`
+	CreatedAt  time.Time `json:"-" db:"created_at"`
+	UpdatedAt  time.Time `json:"-" db:"updated_at"`
+}
+
+func (t *Tag) IsPending() bool {
+	return t.Status == TagStatusPending
+}
+
+// CanBeAttachedTo reports whether the tag may be used on the image,
+// the pending tags are limited to the images of their proposer
+func (t *Tag) CanBeAttachedTo(image *Image) bool {
+	if !t.IsPending() {
+		return true
+	}
+
+	return t.ProposerID != nil && image != nil && *t.ProposerID == image.AuthorID
+}
+
+// TagProposal is a pending tag in the moderation queue
+type TagProposal struct {
+	Tag
+	Proposer    ImageAuthor `json:"proposer" db:"proposer"`
+	ImagesCount int         `json:"imagesCount" db:"images_count"`
+}
+
+// DetailedTag is the tag along with its aliases and direct children
+type DetailedTag struct {
+	Tag
+	Aliases     []string `json:"aliases"`
+	Children    []Tag    `json:"children"`
+	ImagesCount int      `json:"imagesCount"`
+}
+
+type TagUsageSortMethod string
+
+const (
+	TagUsageTotalSort  TagUsageSortMethod = "usage"
+	TagUsageGrowthSort TagUsageSortMethod = "growth"
+)
+
+// TagUsage is the number of images with the tag, RecentCount counts only the images tagged within the period
+type TagUsage struct {
+	Tag
+	ImagesCount int `json:"imagesCount" db:"images_count"`
+	RecentCount int `json:"recentCount" db:"recent_count"`
+}
+
+// TagUsagePoint is the number of images tagged during the day
+type TagUsagePoint struct {
+	Date  time.Time `json:"date" db:"date"`
+	Count int       `json:"count" db:"count"`
+}
+
+type TagSuggestionSource string
+
+const (
+	// TagSuggestionSimilar is a tag of the visually similar images
+	TagSuggestionSimilar TagSuggestionSource = "similar"
+	// TagSuggestionCooccurrence is a tag frequently used together with the image tags
+	TagSuggestionCooccurrence TagSuggestionSource = "cooccurrence"
+)
+
+// TagSuggestion is a proposed tag for the image, the score is in [0, 1] range
+type TagSuggestion struct {
+	Name    string                `json:"name" db:"name"`
+	Score   float64               `json:"score" db:"score"`
+	Sources []TagSuggestionSource `json:"sources" db:"-"`
 }
@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"encoding/base64"
+	"errors"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +23,7 @@ type User struct {
 	Email        string    `json:"email" db:"email"`
 	Permissions  int       `json:"permissions" db:"permissions"`
 	AvatarURL    string    `json:"avatarURL" db:"avatar_url"`
+	Private      bool      `json:"private" db:"private"`
 	PasswordHash string    `json:"-" db:"password_hash"`
 	External     bool      `json:"-" db:"external"`
 	CreatedAt    time.Time `json:"-" db:"created_at"`
@@ -35,6 +39,92 @@ type FollowingStats struct {
 	Followers   int  `json:"followers" db:"followers"`
 	Following   int  `json:"following" db:"following"`
 	IsFollowing bool `json:"isFollowing" db:"is_following"`
+	IsRequested bool `json:"isRequested" db:"is_requested"`
+}
+
+// Follow is an entry of the user followers or following list,
+// the flags are relative to the viewer of the list
+type Follow struct {
+	ImageAuthor
+	FollowedAt  time.Time `json:"followedAt" db:"followed_at"`
+	IsFollowing bool      `json:"isFollowing" db:"is_following"`
+	FollowsYou  bool      `json:"followsYou" db:"follows_you"`
+}
+
+// FollowRequest is a pending follow of the private user
+type FollowRequest struct {
+	ImageAuthor
+	RequestedAt time.Time `json:"requestedAt" db:"requested_at"`
+}
+
+type FollowSuggestionSource string
+
+const (
+	// FollowSuggestionMutual is an account followed by the accounts the user follows
+	FollowSuggestionMutual FollowSuggestionSource = "mutual"
+	// FollowSuggestionLiked is an author of the images the user liked
+	FollowSuggestionLiked FollowSuggestionSource = "liked"
+	// FollowSuggestionSimilar is an author of the images visually similar to the user favorites
+	FollowSuggestionSimilar FollowSuggestionSource = "similar"
+)
+
+// FollowSuggestion is a proposed account to follow, the score is in [0, 1] range
+type FollowSuggestion struct {
+	ImageAuthor
+	Score   float64                  `json:"score" db:"score"`
+	Sources []FollowSuggestionSource `json:"sources" db:"-"`
+}
+
+type FollowListInput struct {
+	Query  string
+	Limit  int
+	Cursor *FollowCursor
+}
+
+// FollowCursor points to the last follow of the previous page,
+// the lists are ordered by the follow date and the user id
+type FollowCursor struct {
+	FollowedAt time.Time
+	UserID     ID
+}
+
+func NewFollowCursor(follow *Follow) *FollowCursor {
+	return &FollowCursor{FollowedAt: follow.FollowedAt, UserID: follow.ID}
+}
+
+func ParseFollowCursor(cursor string) (*FollowCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	micros, id, found := strings.Cut(string(raw), ":")
+	if !found {
+		return nil, errors.New("cursor has incorrect format")
+	}
+
+	followedAt, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := ParseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FollowCursor{FollowedAt: time.UnixMicro(followedAt).UTC(), UserID: userID}, nil
+}
+
+func (c *FollowCursor) String() string {
+	raw := strconv.FormatInt(c.FollowedAt.UnixMicro(), 10) + ":" + c.UserID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// RestrictedUser is an entry of the user block or mute list
+type RestrictedUser struct {
+	ImageAuthor
+	RestrictedAt time.Time `json:"restrictedAt" db:"restricted_at"`
 }
 
 type UserWithToken struct {
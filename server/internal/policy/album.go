@@ -1,6 +1,10 @@
 package policy
 
-import "github.com/pillowskiy/gopix/internal/domain"
+import (
+	"crypto/subtle"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+)
 
 type albumAccessPolicy struct{}
 
@@ -17,3 +21,74 @@ func (p *albumAccessPolicy) CanModify(user *domain.User, album *domain.Album) bo
 	isAdmin := user.HasPermission(domain.PermissionsAdmin)
 	return isOwner || isAdmin
 }
+
+// CanView allows link albums to anyone who knows the share token,
+// member is the executor membership in the album and may be nil
+func (p *albumAccessPolicy) CanView(
+	user *domain.User, album *domain.Album, member *domain.AlbumMember, shareToken string,
+) bool {
+	if album.AccessLevel == domain.AlbumAccessPublic || p.CanModify(user, album) {
+		return true
+	}
+
+	if p.hasRole(user, member, domain.AlbumRoleViewer) {
+		return true
+	}
+
+	if album.AccessLevel != domain.AlbumAccessLink || album.ShareToken == nil || shareToken == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(*album.ShareToken), []byte(shareToken)) == 1
+}
+
+func (p *albumAccessPolicy) CanPutImage(user *domain.User, album *domain.Album, member *domain.AlbumMember) bool {
+	return p.CanModify(user, album) || p.hasRole(user, member, domain.AlbumRoleContributor)
+}
+
+// CanAttachImage allows to put public images to albums, the other ones only by their authors,
+// album listings show such images only to their authors as well
+func (p *albumAccessPolicy) CanAttachImage(user *domain.User, image *domain.Image) bool {
+	if image.AccessLevel == domain.ImageAccessPublic {
+		return true
+	}
+
+	return user != nil && user.ID == image.AuthorID
+}
+
+// Contributors can remove only the images they have added themselves
+func (p *albumAccessPolicy) CanDeleteImage(
+	user *domain.User, album *domain.Album, member *domain.AlbumMember, addedBy *domain.ID,
+) bool {
+	if p.CanModify(user, album) || p.hasRole(user, member, domain.AlbumRoleEditor) {
+		return true
+	}
+
+	isAdder := user != nil && addedBy != nil && *addedBy == user.ID
+	return isAdder && p.hasRole(user, member, domain.AlbumRoleContributor)
+}
+
+// CanArrange allows editors to change the images order and the album cover
+func (p *albumAccessPolicy) CanArrange(user *domain.User, album *domain.Album, member *domain.AlbumMember) bool {
+	return p.CanModify(user, album) || p.hasRole(user, member, domain.AlbumRoleEditor)
+}
+
+// CanManageMember allows editors to manage members below their own role,
+// role is the current or the granted role of the managed member
+func (p *albumAccessPolicy) CanManageMember(
+	user *domain.User, album *domain.Album, member *domain.AlbumMember, role domain.AlbumRole,
+) bool {
+	if p.CanModify(user, album) {
+		return true
+	}
+
+	return role != domain.AlbumRoleEditor && p.hasRole(user, member, domain.AlbumRoleEditor)
+}
+
+func (p *albumAccessPolicy) hasRole(user *domain.User, member *domain.AlbumMember, role domain.AlbumRole) bool {
+	if user == nil || member == nil || member.UserID != user.ID || !member.Accepted {
+		return false
+	}
+
+	return member.Role.Includes(role)
+}
@@ -17,3 +17,16 @@ func (p *commentAccessPolicy) CanModify(user *domain.User, comment *domain.Comme
 	isAdmin := user.HasPermission(domain.PermissionsAdmin)
 	return isOwner || isAdmin
 }
+
+func (p *commentAccessPolicy) CanModerate(user *domain.User) bool {
+	return user != nil && user.HasPermission(domain.PermissionsAdmin)
+}
+
+// CanModerateImage allows the image author to moderate the comments on the image, admins can do it anywhere
+func (p *commentAccessPolicy) CanModerateImage(user *domain.User, image *domain.Image) bool {
+	if user == nil {
+		return false
+	}
+
+	return user.ID == image.AuthorID || user.HasPermission(domain.PermissionsAdmin)
+}
@@ -17,3 +17,7 @@ func (p *tagAccessPolicy) CanModifyImageTags(user *domain.User, image *domain.Im
 	isAdmin := user.HasPermission(domain.PermissionsAdmin)
 	return isAuthor || isAdmin
 }
+
+func (p *tagAccessPolicy) CanModerateTags(user *domain.User) bool {
+	return user != nil && user.HasPermission(domain.PermissionsAdmin)
+}
@@ -0,0 +1,202 @@
+package fakerepo
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
+)
+
+const (
+	vectorDim    = 64
+	similarLimit = 20
+)
+
+var errVectorExists = errors.New("vector already exists")
+
+// vectorRepository is an in-process replacement of the vectorization service
+// for tests and offline development. The embeddings are byte histograms for images
+// and hashed tokens for texts, so results are stable but not meaningful
+type vectorRepository struct {
+	mut     sync.RWMutex
+	vectors map[domain.ID][]float64
+}
+
+func NewVectorizationRepository() *vectorRepository {
+	return &vectorRepository{vectors: make(map[domain.ID][]float64)}
+}
+
+func (repo *vectorRepository) Features(
+	ctx context.Context, imageID domain.ID, file *domain.FileNode,
+) error {
+	vec, err := imageVector(file)
+	if err != nil {
+		return err
+	}
+
+	repo.mut.Lock()
+	defer repo.mut.Unlock()
+
+	if _, ok := repo.vectors[imageID]; ok {
+		return errVectorExists
+	}
+	repo.vectors[imageID] = vec
+
+	return nil
+}
+
+func (repo *vectorRepository) BatchFeatures(
+	ctx context.Context, files map[domain.ID]*domain.FileNode,
+) (map[domain.ID]error, error) {
+	errs := make(map[domain.ID]error, len(files))
+	for imageID, file := range files {
+		errs[imageID] = repo.Features(ctx, imageID, file)
+	}
+
+	return errs, nil
+}
+
+func (repo *vectorRepository) ReplaceFeatures(
+	ctx context.Context, files map[domain.ID]*domain.FileNode,
+) (map[domain.ID]error, error) {
+	errs := make(map[domain.ID]error, len(files))
+	for imageID, file := range files {
+		vec, err := imageVector(file)
+		if err == nil {
+			repo.mut.Lock()
+			repo.vectors[imageID] = vec
+			repo.mut.Unlock()
+		}
+		errs[imageID] = err
+	}
+
+	return errs, nil
+}
+
+func (repo *vectorRepository) Similar(ctx context.Context, imageID domain.ID) ([]domain.ID, error) {
+	repo.mut.RLock()
+	vec, ok := repo.vectors[imageID]
+	repo.mut.RUnlock()
+
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+
+	return repo.nearest(vec, similarLimit), nil
+}
+
+func (repo *vectorRepository) SearchByImage(ctx context.Context, file *domain.FileNode) ([]domain.ID, error) {
+	vec, err := imageVector(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.nearest(vec, similarLimit), nil
+}
+
+func (repo *vectorRepository) SearchByText(ctx context.Context, query string, limit int) ([]domain.ID, error) {
+	return repo.nearest(textVector(query), limit), nil
+}
+
+func (repo *vectorRepository) DeleteFeatures(ctx context.Context, imageID domain.ID) error {
+	repo.mut.Lock()
+	defer repo.mut.Unlock()
+
+	if _, ok := repo.vectors[imageID]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(repo.vectors, imageID)
+
+	return nil
+}
+
+func (repo *vectorRepository) nearest(vec []float64, limit int) []domain.ID {
+	type neighbor struct {
+		id       domain.ID
+		distance float64
+	}
+
+	repo.mut.RLock()
+	neighbors := make([]neighbor, 0, len(repo.vectors))
+	for id, candidate := range repo.vectors {
+		neighbors = append(neighbors, neighbor{id: id, distance: distance(vec, candidate)})
+	}
+	repo.mut.RUnlock()
+
+	sort.Slice(neighbors, func(i, j int) bool {
+		if neighbors[i].distance == neighbors[j].distance {
+			return neighbors[i].id < neighbors[j].id
+		}
+		return neighbors[i].distance < neighbors[j].distance
+	})
+
+	ids := make([]domain.ID, 0, min(limit, len(neighbors)))
+	for i := 0; i < len(neighbors) && i < limit; i++ {
+		ids = append(ids, neighbors[i].id)
+	}
+
+	return ids
+}
+
+func imageVector(file *domain.FileNode) ([]float64, error) {
+	defer file.Restore()
+
+	data, err := io.ReadAll(file.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	vec := make([]float64, vectorDim)
+	for _, b := range data {
+		vec[int(b)%vectorDim]++
+	}
+
+	return normalize(vec), nil
+}
+
+func textVector(text string) []float64 {
+	vec := make([]float64, vectorDim)
+	for _, token := range strings.Fields(strings.ToLower(text)) {
+		h := fnv.New32a()
+		h.Write([]byte(token))
+		vec[h.Sum32()%vectorDim]++
+	}
+
+	return normalize(vec)
+}
+
+func normalize(vec []float64) []float64 {
+	var norm float64
+	for _, v := range vec {
+		norm += v * v
+	}
+
+	norm = math.Sqrt(norm)
+	if norm == 0 {
+		return vec
+	}
+
+	for i := range vec {
+		vec[i] /= norm
+	}
+
+	return vec
+}
+
+// L2 distance, the same metric the vectorization service uses
+func distance(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+
+	return math.Sqrt(sum)
+}
@@ -0,0 +1,94 @@
+package grpcrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/pillowskiy/gopix/internal/repository"
+	"github.com/pillowskiy/gopix/pkg/retry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ClientConfig struct {
+	MaxRetries       int
+	RetryBackoff     time.Duration
+	MaxConcurrency   int
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+	// Name is used as a prefix for the client metrics
+	Name string
+}
+
+type call struct {
+	// Name is a short name of the operation used in metrics
+	name       string
+	timeout    time.Duration
+	idempotent bool
+}
+
+// invoker runs grpc calls through the same retry executor the http client uses
+type invoker struct {
+	exec *retry.Executor
+}
+
+func newInvoker(cfg ClientConfig) *invoker {
+	exec := retry.New(retry.Config{
+		Name:             cfg.Name,
+		MaxRetries:       cfg.MaxRetries,
+		Backoff:          cfg.RetryBackoff,
+		MaxConcurrency:   cfg.MaxConcurrency,
+		BreakerThreshold: cfg.BreakerThreshold,
+		BreakerCooldown:  cfg.BreakerCooldown,
+	}, retry.Policy{Retryable: retryable, Rejected: rejected, Reason: failureReason})
+
+	return &invoker{exec: exec}
+}
+
+func (inv *invoker) invoke(ctx context.Context, c *call, fn func(ctx context.Context) error) error {
+	err := inv.exec.Do(ctx, &retry.Call{Name: c.name, Timeout: c.timeout, Idempotent: c.idempotent}, fn)
+	return mapError(err)
+}
+
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// rejected reports whether the service is healthy, it just didn't like the request
+func rejected(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded,
+		codes.Internal, codes.Unknown, codes.DataLoss:
+		return false
+	default:
+		return true
+	}
+}
+
+// mapError translates the status codes the repositories have an error for
+func mapError(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return repository.ErrNotFound
+	case codes.InvalidArgument:
+		return repository.ErrIncorrectInput
+	default:
+		return err
+	}
+}
+
+func failureReason(err error) string {
+	switch status.Code(err) {
+	case codes.DeadlineExceeded:
+		return "timeout"
+	case codes.Unavailable:
+		return "network"
+	default:
+		return "status"
+	}
+}
@@ -0,0 +1,346 @@
+package grpcrepo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
+	"github.com/pillowskiy/gopix/pkg/pb/vecpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testServer struct {
+	vecpb.UnimplementedVectorizationServiceServer
+
+	features      func(grpc.ClientStreamingServer[vecpb.FeaturesRequest, vecpb.FeaturesResponse]) error
+	batchFeatures func(grpc.ClientStreamingServer[vecpb.FeaturesRequest, vecpb.BatchFeaturesResponse]) error
+	similar       func(context.Context, *vecpb.SimilarRequest) (*vecpb.SearchResponse, error)
+}
+
+func (s *testServer) Features(
+	stream grpc.ClientStreamingServer[vecpb.FeaturesRequest, vecpb.FeaturesResponse],
+) error {
+	return s.features(stream)
+}
+
+func (s *testServer) BatchFeatures(
+	stream grpc.ClientStreamingServer[vecpb.FeaturesRequest, vecpb.BatchFeaturesResponse],
+) error {
+	return s.batchFeatures(stream)
+}
+
+func (s *testServer) Similar(ctx context.Context, req *vecpb.SimilarRequest) (*vecpb.SearchResponse, error) {
+	return s.similar(ctx, req)
+}
+
+func newTestRepository(t *testing.T, srv *testServer, cfg ClientConfig) *vectorRepository {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	vecpb.RegisterVectorizationServiceServer(server, srv)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to create grpc client: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	cfg.Name = "test_grpc_service"
+	if cfg.BreakerThreshold == 0 {
+		cfg.BreakerThreshold = 100
+	}
+
+	return &vectorRepository{
+		conn:            conn,
+		client:          vecpb.NewVectorizationServiceClient(conn),
+		inv:             newInvoker(cfg),
+		featuresTimeout: time.Second,
+		searchTimeout:   time.Second,
+		deleteTimeout:   time.Second,
+	}
+}
+
+func testFileNode(content []byte) *domain.FileNode {
+	return &domain.FileNode{
+		File:        domain.File{Reader: bytes.NewReader(content), Size: int64(len(content))},
+		Name:        "test.png",
+		ContentType: "image/png",
+	}
+}
+
+type receivedImage struct {
+	metadata *vecpb.ImageMetadata
+	content  []byte
+	chunks   int
+}
+
+// receiveImages reads the whole stream the way the vectorization service does
+func receiveImages(stream interface {
+	Recv() (*vecpb.FeaturesRequest, error)
+}) ([]*receivedImage, error) {
+	var images []*receivedImage
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return images, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if metadata := req.GetMetadata(); metadata != nil {
+			images = append(images, &receivedImage{metadata: metadata})
+			continue
+		}
+
+		if len(images) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "chunk before metadata")
+		}
+		img := images[len(images)-1]
+		img.content = append(img.content, req.GetChunk()...)
+		img.chunks++
+	}
+}
+
+func TestVectorRepository_BatchFeatures(t *testing.T) {
+	t.Parallel()
+
+	t.Run("StreamsEveryImage", func(t *testing.T) {
+		received := make(chan []*receivedImage, 1)
+		repo := newTestRepository(t, &testServer{
+			batchFeatures: func(stream grpc.ClientStreamingServer[vecpb.FeaturesRequest, vecpb.BatchFeaturesResponse]) error {
+				images, err := receiveImages(stream)
+				if err != nil {
+					return err
+				}
+				received <- images
+
+				results := make([]*vecpb.FeaturesResult, 0, len(images))
+				for _, img := range images {
+					res := &vecpb.FeaturesResult{Id: img.metadata.GetId()}
+					if img.metadata.GetId() == 2 {
+						res.Error = "bad image"
+					}
+					results = append(results, res)
+				}
+				return stream.SendAndClose(&vecpb.BatchFeaturesResponse{Results: results})
+			},
+		}, ClientConfig{})
+
+		large := bytes.Repeat([]byte{1}, chunkSize*2+1)
+		small := []byte("small image")
+		errs, err := repo.BatchFeatures(context.Background(), map[domain.ID]*domain.FileNode{
+			1: testFileNode(large),
+			2: testFileNode(small),
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.NoError(t, errs[1])
+		assert.EqualError(t, errs[2], "bad image")
+
+		images := <-received
+		if !assert.Len(t, images, 2) {
+			return
+		}
+		for _, img := range images {
+			assert.Equal(t, "image/png", img.metadata.GetContentType())
+			assert.False(t, img.metadata.GetReplace())
+
+			switch img.metadata.GetId() {
+			case 1:
+				assert.Equal(t, large, img.content)
+				assert.Equal(t, 3, img.chunks)
+			case 2:
+				assert.Equal(t, small, img.content)
+				assert.Equal(t, 1, img.chunks)
+			}
+		}
+	})
+
+	t.Run("MissingResult", func(t *testing.T) {
+		repo := newTestRepository(t, &testServer{
+			batchFeatures: func(stream grpc.ClientStreamingServer[vecpb.FeaturesRequest, vecpb.BatchFeaturesResponse]) error {
+				if _, err := receiveImages(stream); err != nil {
+					return err
+				}
+				return stream.SendAndClose(&vecpb.BatchFeaturesResponse{
+					Results: []*vecpb.FeaturesResult{{Id: 1}, {Id: 42}},
+				})
+			},
+		}, ClientConfig{})
+
+		errs, err := repo.BatchFeatures(context.Background(), map[domain.ID]*domain.FileNode{
+			1: testFileNode([]byte("first")),
+			2: testFileNode([]byte("second")),
+		})
+		if assert.NoError(t, err) && assert.Len(t, errs, 2) {
+			assert.NoError(t, errs[1])
+			assert.ErrorIs(t, errs[2], errMissingResult)
+		}
+	})
+}
+
+func TestVectorRepository_ReplaceFeatures(t *testing.T) {
+	t.Parallel()
+
+	var hits atomic.Int32
+	received := make(chan []*receivedImage, 2)
+	repo := newTestRepository(t, &testServer{
+		batchFeatures: func(stream grpc.ClientStreamingServer[vecpb.FeaturesRequest, vecpb.BatchFeaturesResponse]) error {
+			images, err := receiveImages(stream)
+			if err != nil {
+				return err
+			}
+			received <- images
+
+			// Replacing is idempotent, so the first failed attempt is retried
+			if hits.Add(1) == 1 {
+				return status.Error(codes.Unavailable, "unavailable")
+			}
+
+			results := make([]*vecpb.FeaturesResult, 0, len(images))
+			for _, img := range images {
+				results = append(results, &vecpb.FeaturesResult{Id: img.metadata.GetId()})
+			}
+			return stream.SendAndClose(&vecpb.BatchFeaturesResponse{Results: results})
+		},
+	}, ClientConfig{MaxRetries: 1, RetryBackoff: time.Millisecond})
+
+	content := []byte("image")
+	errs, err := repo.ReplaceFeatures(context.Background(), map[domain.ID]*domain.FileNode{
+		1: testFileNode(content),
+	})
+	if assert.NoError(t, err) {
+		assert.NoError(t, errs[1])
+	}
+	assert.Equal(t, int32(2), hits.Load())
+
+	for range 2 {
+		images := <-received
+		if assert.Len(t, images, 1) {
+			assert.True(t, images[0].metadata.GetReplace())
+			assert.Equal(t, content, images[0].content)
+		}
+	}
+}
+
+func TestVectorRepository_Retries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("RetryIdempotent", func(t *testing.T) {
+		var hits atomic.Int32
+		repo := newTestRepository(t, &testServer{
+			similar: func(_ context.Context, req *vecpb.SimilarRequest) (*vecpb.SearchResponse, error) {
+				if hits.Add(1) < 3 {
+					return nil, status.Error(codes.Unavailable, "unavailable")
+				}
+				return &vecpb.SearchResponse{Neighbors: []*vecpb.Neighbor{{Id: req.GetId() + 1}}}, nil
+			},
+		}, ClientConfig{MaxRetries: 3, RetryBackoff: time.Millisecond})
+
+		ids, err := repo.Similar(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.ID{2}, ids)
+		assert.Equal(t, int32(3), hits.Load())
+	})
+
+	t.Run("NoRetryForInsert", func(t *testing.T) {
+		var hits atomic.Int32
+		repo := newTestRepository(t, &testServer{
+			features: func(stream grpc.ClientStreamingServer[vecpb.FeaturesRequest, vecpb.FeaturesResponse]) error {
+				hits.Add(1)
+				return status.Error(codes.Unavailable, "unavailable")
+			},
+		}, ClientConfig{MaxRetries: 3, RetryBackoff: time.Millisecond})
+
+		err := repo.Features(context.Background(), 1, testFileNode([]byte("image")))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, int32(1), hits.Load())
+	})
+
+	t.Run("NoRetryForRejected", func(t *testing.T) {
+		var hits atomic.Int32
+		repo := newTestRepository(t, &testServer{
+			similar: func(context.Context, *vecpb.SimilarRequest) (*vecpb.SearchResponse, error) {
+				hits.Add(1)
+				return nil, status.Error(codes.NotFound, "not found")
+			},
+		}, ClientConfig{MaxRetries: 3, RetryBackoff: time.Millisecond})
+
+		_, err := repo.Similar(context.Background(), 1)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+		assert.Equal(t, int32(1), hits.Load())
+	})
+}
+
+func TestMapError(t *testing.T) {
+	t.Parallel()
+
+	otherErr := status.Error(codes.Internal, "internal")
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"NotFound", status.Error(codes.NotFound, "not found"), repository.ErrNotFound},
+		{"InvalidArgument", status.Error(codes.InvalidArgument, "invalid"), repository.ErrIncorrectInput},
+		{"Other", otherErr, otherErr},
+		{"Nil", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mapError(tt.err))
+		})
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		code      codes.Code
+		retryable bool
+		rejected  bool
+	}{
+		{codes.Unavailable, true, false},
+		{codes.ResourceExhausted, true, false},
+		{codes.DeadlineExceeded, true, false},
+		{codes.Internal, false, false},
+		{codes.Unknown, false, false},
+		{codes.DataLoss, false, false},
+		{codes.NotFound, false, true},
+		{codes.InvalidArgument, false, true},
+		{codes.AlreadyExists, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			err := status.Error(tt.code, "test")
+			assert.Equal(t, tt.retryable, retryable(err))
+			assert.Equal(t, tt.rejected, rejected(err))
+		})
+	}
+}
@@ -0,0 +1,330 @@
+package grpcrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pillowskiy/gopix/internal/config"
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/pkg/pb/vecpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	defaultFeaturesTimeout  = 30 * time.Second
+	defaultSearchTimeout    = 10 * time.Second
+	defaultDeleteTimeout    = 5 * time.Second
+	defaultMaxConcurrency   = 16
+	defaultRetryBackoff     = 100 * time.Millisecond
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 30 * time.Second
+
+	similarLimit = 20
+	// Images are streamed in chunks well below the default 4MB message limit
+	chunkSize = 64 << 10
+)
+
+var errMissingResult = errors.New("service did not report the result")
+
+type vectorRepository struct {
+	conn   *grpc.ClientConn
+	client vecpb.VectorizationServiceClient
+	inv    *invoker
+
+	featuresTimeout time.Duration
+	searchTimeout   time.Duration
+	deleteTimeout   time.Duration
+}
+
+func NewVectorizationRepository(cfg *config.VecService) (*vectorRepository, error) {
+	conn, err := grpc.NewClient(cfg.GRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grpc client: %w", err)
+	}
+
+	invCfg := ClientConfig{
+		Name:             "vec_service",
+		MaxRetries:       cfg.MaxRetries,
+		RetryBackoff:     orDefault(cfg.RetryBackoffMS*time.Millisecond, defaultRetryBackoff),
+		MaxConcurrency:   cfg.MaxConcurrency,
+		BreakerThreshold: cfg.BreakerThreshold,
+		BreakerCooldown:  orDefault(cfg.BreakerCooldown*time.Second, defaultBreakerCooldown),
+	}
+
+	if invCfg.MaxConcurrency <= 0 {
+		invCfg.MaxConcurrency = defaultMaxConcurrency
+	}
+	if invCfg.BreakerThreshold <= 0 {
+		invCfg.BreakerThreshold = defaultBreakerThreshold
+	}
+
+	return &vectorRepository{
+		conn:            conn,
+		client:          vecpb.NewVectorizationServiceClient(conn),
+		inv:             newInvoker(invCfg),
+		featuresTimeout: orDefault(cfg.FeaturesTimeout*time.Second, defaultFeaturesTimeout),
+		searchTimeout:   orDefault(cfg.SearchTimeout*time.Second, defaultSearchTimeout),
+		deleteTimeout:   orDefault(cfg.DeleteTimeout*time.Second, defaultDeleteTimeout),
+	}, nil
+}
+
+func (repo *vectorRepository) Close() error {
+	return repo.conn.Close()
+}
+
+func (repo *vectorRepository) Features(
+	ctx context.Context, imageID domain.ID, file *domain.FileNode,
+) error {
+	// Not idempotent, a retried insert may conflict with the vector stored by the first attempt
+	c := &call{name: "features", timeout: repo.featuresTimeout}
+	return repo.inv.invoke(ctx, c, func(ctx context.Context) error {
+		stream, err := repo.client.Features(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := sendImage(stream, imageID, file, false); err != nil {
+			return err
+		}
+
+		_, err = stream.CloseAndRecv()
+		return err
+	})
+}
+
+func (repo *vectorRepository) BatchFeatures(
+	ctx context.Context, files map[domain.ID]*domain.FileNode,
+) (map[domain.ID]error, error) {
+	c := &call{name: "batch_features", timeout: repo.featuresTimeout * time.Duration(len(files))}
+	return repo.batchFeatures(ctx, c, files, false)
+}
+
+// ReplaceFeatures is idempotent, so unlike the inserts it is retried
+func (repo *vectorRepository) ReplaceFeatures(
+	ctx context.Context, files map[domain.ID]*domain.FileNode,
+) (map[domain.ID]error, error) {
+	c := &call{
+		name:       "replace_features",
+		timeout:    repo.featuresTimeout * time.Duration(len(files)),
+		idempotent: true,
+	}
+	return repo.batchFeatures(ctx, c, files, true)
+}
+
+func (repo *vectorRepository) batchFeatures(
+	ctx context.Context, c *call, files map[domain.ID]*domain.FileNode, replace bool,
+) (map[domain.ID]error, error) {
+	if len(files) == 0 {
+		return map[domain.ID]error{}, nil
+	}
+
+	var results []*vecpb.FeaturesResult
+	err := repo.inv.invoke(ctx, c, func(ctx context.Context) error {
+		stream, err := repo.client.BatchFeatures(ctx)
+		if err != nil {
+			return err
+		}
+
+		for imageID, file := range files {
+			if err := sendImage(stream, imageID, file, replace); err != nil {
+				return err
+			}
+		}
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			return err
+		}
+
+		results = resp.GetResults()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make(map[domain.ID]error, len(files))
+	for imageID := range files {
+		errs[imageID] = errMissingResult
+	}
+
+	for _, res := range results {
+		imageID := domain.ID(res.GetId())
+		if _, ok := errs[imageID]; !ok {
+			continue
+		}
+
+		if res.GetError() != "" {
+			errs[imageID] = errors.New(res.GetError())
+		} else {
+			errs[imageID] = nil
+		}
+	}
+
+	return errs, nil
+}
+
+func (repo *vectorRepository) Similar(
+	ctx context.Context, imageID domain.ID,
+) ([]domain.ID, error) {
+	var resp *vecpb.SearchResponse
+	c := &call{name: "similar", timeout: repo.searchTimeout, idempotent: true}
+	err := repo.inv.invoke(ctx, c, func(ctx context.Context) (err error) {
+		resp, err = repo.client.Similar(ctx, &vecpb.SimilarRequest{
+			Id:    uint64(imageID),
+			Limit: similarLimit,
+		})
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return neighborIDs(resp), nil
+}
+
+func (repo *vectorRepository) SearchByImage(
+	ctx context.Context, file *domain.FileNode,
+) ([]domain.ID, error) {
+	var resp *vecpb.SearchResponse
+	c := &call{name: "search_by_image", timeout: repo.searchTimeout, idempotent: true}
+	err := repo.inv.invoke(ctx, c, func(ctx context.Context) error {
+		// The reader is consumed by the previous attempt
+		if err := file.Restore(); err != nil {
+			return err
+		}
+
+		stream, err := repo.client.SearchByImage(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(&vecpb.SearchByImageRequest{
+			Payload: &vecpb.SearchByImageRequest_Options{Options: &vecpb.SearchOptions{
+				Limit:       similarLimit,
+				ContentType: file.ContentType,
+			}},
+		})
+		if err == nil {
+			err = sendChunks(file.Reader, func(chunk []byte) error {
+				return stream.Send(&vecpb.SearchByImageRequest{
+					Payload: &vecpb.SearchByImageRequest_Chunk{Chunk: chunk},
+				})
+			})
+		}
+		if err := ignoreEOF(err); err != nil {
+			return err
+		}
+
+		resp, err = stream.CloseAndRecv()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return neighborIDs(resp), nil
+}
+
+func (repo *vectorRepository) SearchByText(
+	ctx context.Context, query string, limit int,
+) ([]domain.ID, error) {
+	var resp *vecpb.SearchResponse
+	c := &call{name: "search_by_text", timeout: repo.searchTimeout, idempotent: true}
+	err := repo.inv.invoke(ctx, c, func(ctx context.Context) (err error) {
+		resp, err = repo.client.SearchByText(ctx, &vecpb.SearchByTextRequest{
+			Query: query,
+			Limit: uint32(max(limit, 0)),
+		})
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return neighborIDs(resp), nil
+}
+
+func (repo *vectorRepository) DeleteFeatures(ctx context.Context, imageID domain.ID) error {
+	c := &call{name: "delete_features", timeout: repo.deleteTimeout, idempotent: true}
+	return repo.inv.invoke(ctx, c, func(ctx context.Context) error {
+		_, err := repo.client.DeleteFeatures(ctx, &vecpb.DeleteFeaturesRequest{Id: uint64(imageID)})
+		return err
+	})
+}
+
+type featuresStream interface {
+	Send(*vecpb.FeaturesRequest) error
+}
+
+// sendImage sends the image metadata followed by its content.
+// io.EOF means the server has closed the stream,
+// the actual status is returned by CloseAndRecv
+func sendImage(stream featuresStream, imageID domain.ID, file *domain.FileNode, replace bool) error {
+	defer file.Restore()
+
+	err := stream.Send(&vecpb.FeaturesRequest{
+		Payload: &vecpb.FeaturesRequest_Metadata{Metadata: &vecpb.ImageMetadata{
+			Id:          uint64(imageID),
+			Name:        file.Name,
+			ContentType: file.ContentType,
+			Size:        file.Size,
+			Replace:     replace,
+		}},
+	})
+	if err != nil {
+		return ignoreEOF(err)
+	}
+
+	return sendChunks(file.Reader, func(chunk []byte) error {
+		return stream.Send(&vecpb.FeaturesRequest{
+			Payload: &vecpb.FeaturesRequest_Chunk{Chunk: chunk},
+		})
+	})
+}
+
+func sendChunks(r io.Reader, send func(chunk []byte) error) error {
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if sendErr := send(buf[:n]); sendErr != nil {
+				return ignoreEOF(sendErr)
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read image: %w", err)
+		}
+	}
+}
+
+func ignoreEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
+func neighborIDs(resp *vecpb.SearchResponse) []domain.ID {
+	ids := make([]domain.ID, 0, len(resp.GetNeighbors()))
+	for _, neighbor := range resp.GetNeighbors() {
+		ids = append(ids, domain.ID(neighbor.GetId()))
+	}
+
+	return ids
+}
+
+func orDefault(value time.Duration, def time.Duration) time.Duration {
+	if value <= 0 {
+		return def
+	}
+	return value
+}
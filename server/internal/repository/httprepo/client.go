@@ -0,0 +1,177 @@
+package httprepo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pillowskiy/gopix/pkg/retry"
+)
+
+// The response body is read only partially on errors,
+// the service may respond with an html page or a stack trace
+const maxErrorBodySize = 4 << 10
+
+type ClientConfig struct {
+	BaseURL          string
+	MaxRetries       int
+	RetryBackoff     time.Duration
+	MaxConcurrency   int
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+	// Name is used as a prefix for the client metrics
+	Name string
+}
+
+// ServiceError is returned when the service responded with an unexpected status
+type ServiceError struct {
+	Status  int
+	Message string
+}
+
+func (e *ServiceError) Error() string {
+	return fmt.Sprintf("server returned unexpected status: %d. message: %s", e.Status, e.Message)
+}
+
+func (e *ServiceError) retryable() bool {
+	return e.Status == http.StatusTooManyRequests ||
+		(e.Status >= 500 && e.Status != http.StatusNotImplemented)
+}
+
+type request struct {
+	// Call is a short name of the operation used in metrics
+	call       string
+	method     string
+	path       string
+	timeout    time.Duration
+	idempotent bool
+
+	body        []byte
+	contentType string
+
+	expectStatus int
+}
+
+// client is a small http client running every request through the retry executor
+type client struct {
+	cfg  ClientConfig
+	http *http.Client
+	exec *retry.Executor
+}
+
+func newClient(cfg ClientConfig) *client {
+	exec := retry.New(retry.Config{
+		Name:             cfg.Name,
+		MaxRetries:       cfg.MaxRetries,
+		Backoff:          cfg.RetryBackoff,
+		MaxConcurrency:   cfg.MaxConcurrency,
+		BreakerThreshold: cfg.BreakerThreshold,
+		BreakerCooldown:  cfg.BreakerCooldown,
+	}, retry.Policy{Retryable: retryable, Rejected: rejected, Reason: failureReason})
+
+	return &client{cfg: cfg, http: &http.Client{}, exec: exec}
+}
+
+func (c *client) do(ctx context.Context, req *request, out interface{}) error {
+	call := &retry.Call{Name: req.call, Timeout: req.timeout, Idempotent: req.idempotent}
+	return c.exec.Do(ctx, call, func(ctx context.Context) error {
+		return c.send(ctx, req, out)
+	})
+}
+
+func (c *client) send(ctx context.Context, req *request, out interface{}) error {
+	var body io.Reader
+	if req.body != nil {
+		body = bytes.NewReader(req.body)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, c.cfg.BaseURL+req.path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	if req.contentType != "" {
+		httpReq.Header.Set("Content-Type", req.contentType)
+	}
+
+	resp, err := c.http.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != req.expectStatus {
+		return readServiceError(resp)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return nil
+}
+
+func retryable(err error) bool {
+	var serviceErr *ServiceError
+	if errors.As(err, &serviceErr) {
+		return serviceErr.retryable()
+	}
+
+	// Network errors and per-call timeouts
+	return true
+}
+
+// rejected reports whether the service is healthy, it just didn't like the request
+func rejected(err error) bool {
+	var serviceErr *ServiceError
+	return errors.As(err, &serviceErr) && !serviceErr.retryable()
+}
+
+func readServiceError(resp *http.Response) error {
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	payload := make(map[string]interface{})
+	if err := json.Unmarshal(data, &payload); err == nil {
+		for _, key := range []string{"error", "message"} {
+			if msg, ok := payload[key].(string); ok {
+				return &ServiceError{Status: resp.StatusCode, Message: msg}
+			}
+		}
+	}
+
+	msg := strings.TrimSpace(string(data))
+	if msg == "" {
+		msg = http.StatusText(resp.StatusCode)
+	}
+
+	return &ServiceError{Status: resp.StatusCode, Message: msg}
+}
+
+func failureReason(err error) string {
+	var serviceErr *ServiceError
+	var netErr net.Error
+
+	switch {
+	case errors.As(err, &serviceErr):
+		return "status"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "timeout"
+	case errors.As(err, &netErr):
+		return "network"
+	default:
+		return "decode"
+	}
+}
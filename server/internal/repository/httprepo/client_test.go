@@ -0,0 +1,211 @@
+package httprepo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pillowskiy/gopix/pkg/breaker"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, cfg ClientConfig) (*client, *atomic.Int32) {
+	t.Helper()
+
+	hits := new(atomic.Int32)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg.Name = "test_service"
+	cfg.BaseURL = server.URL
+	if cfg.BreakerThreshold == 0 {
+		cfg.BreakerThreshold = 100
+	}
+
+	return newClient(cfg), hits
+}
+
+func testRequest(idempotent bool) *request {
+	return &request{
+		call:         "test",
+		method:       http.MethodGet,
+		path:         "/test",
+		idempotent:   idempotent,
+		expectStatus: http.StatusOK,
+	}
+}
+
+func TestClient_Retries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("RetryOn5xx", func(t *testing.T) {
+		var calls atomic.Int32
+		c, hits := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			if calls.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		}, ClientConfig{MaxRetries: 3, RetryBackoff: time.Millisecond})
+
+		var out struct {
+			OK bool `json:"ok"`
+		}
+		err := c.do(context.Background(), testRequest(true), &out)
+		assert.NoError(t, err)
+		assert.True(t, out.OK)
+		assert.Equal(t, int32(3), hits.Load())
+	})
+
+	t.Run("GiveUpAfterMaxRetries", func(t *testing.T) {
+		backoff := 10 * time.Millisecond
+		c, hits := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}, ClientConfig{MaxRetries: 2, RetryBackoff: backoff})
+
+		start := time.Now()
+		err := c.do(context.Background(), testRequest(true), nil)
+
+		var serviceErr *ServiceError
+		if assert.ErrorAs(t, err, &serviceErr) {
+			assert.Equal(t, http.StatusInternalServerError, serviceErr.Status)
+		}
+		assert.Equal(t, int32(3), hits.Load())
+		// Full jitter never waits longer than the exponential backoff: 10ms + 20ms
+		assert.Less(t, time.Since(start), 3*backoff+time.Second)
+	})
+
+	t.Run("RetryOnTimeout", func(t *testing.T) {
+		var calls atomic.Int32
+		c, hits := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				select {
+				case <-r.Context().Done():
+				case <-time.After(time.Second):
+				}
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}, ClientConfig{MaxRetries: 1, RetryBackoff: time.Millisecond})
+
+		req := testRequest(true)
+		req.timeout = 50 * time.Millisecond
+
+		assert.NoError(t, c.do(context.Background(), req, nil))
+		assert.Equal(t, int32(2), hits.Load())
+	})
+
+	t.Run("NoRetryOn4xx", func(t *testing.T) {
+		c, hits := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}, ClientConfig{MaxRetries: 3, RetryBackoff: time.Millisecond, BreakerThreshold: 1})
+
+		err := c.do(context.Background(), testRequest(true), nil)
+
+		var serviceErr *ServiceError
+		if assert.ErrorAs(t, err, &serviceErr) {
+			assert.Equal(t, http.StatusBadRequest, serviceErr.Status)
+		}
+		assert.Equal(t, int32(1), hits.Load())
+		assert.Equal(t, breaker.StateClosed, c.exec.BreakerState())
+	})
+
+	t.Run("NoRetryNotIdempotent", func(t *testing.T) {
+		c, hits := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}, ClientConfig{MaxRetries: 3, RetryBackoff: time.Millisecond})
+
+		assert.Error(t, c.do(context.Background(), testRequest(false), nil))
+		assert.Equal(t, int32(1), hits.Load())
+	})
+
+	t.Run("CanceledDuringBackoff", func(t *testing.T) {
+		c, hits := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}, ClientConfig{MaxRetries: 3, RetryBackoff: time.Hour})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		err := c.do(ctx, testRequest(true), nil)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int32(1), hits.Load())
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
+
+func TestClient_Breaker(t *testing.T) {
+	t.Parallel()
+
+	var healthy atomic.Bool
+	cooldown := 50 * time.Millisecond
+	c, hits := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}, ClientConfig{BreakerThreshold: 2, BreakerCooldown: cooldown})
+
+	ctx := context.Background()
+
+	assert.Error(t, c.do(ctx, testRequest(true), nil))
+	assert.Equal(t, breaker.StateClosed, c.exec.BreakerState())
+	assert.Error(t, c.do(ctx, testRequest(true), nil))
+	assert.Equal(t, breaker.StateOpen, c.exec.BreakerState())
+
+	// The open breaker fails fast without reaching the service
+	assert.ErrorIs(t, c.do(ctx, testRequest(true), nil), breaker.ErrOpen)
+	assert.Equal(t, int32(2), hits.Load())
+
+	// The failed trial call opens the breaker again
+	time.Sleep(cooldown)
+	assert.Error(t, c.do(ctx, testRequest(true), nil))
+	assert.Equal(t, breaker.StateOpen, c.exec.BreakerState())
+	assert.Equal(t, int32(3), hits.Load())
+
+	healthy.Store(true)
+	time.Sleep(cooldown)
+	assert.NoError(t, c.do(ctx, testRequest(true), nil))
+	assert.Equal(t, breaker.StateClosed, c.exec.BreakerState())
+}
+
+func TestClient_ServiceError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		body    string
+		message string
+	}{
+		{name: "JSONError", body: `{"error":"image is broken"}`, message: "image is broken"},
+		{name: "JSONMessage", body: `{"message":"no such image"}`, message: "no such image"},
+		{name: "HTMLBody", body: "<html><body>Bad Request</body></html>\n", message: "<html><body>Bad Request</body></html>"},
+		{name: "EmptyBody", body: "", message: http.StatusText(http.StatusBadRequest)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, _ := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(tc.body))
+			}, ClientConfig{})
+
+			err := c.do(context.Background(), testRequest(true), nil)
+
+			var serviceErr *ServiceError
+			if assert.True(t, errors.As(err, &serviceErr)) {
+				assert.Equal(t, http.StatusBadRequest, serviceErr.Status)
+				assert.Equal(t, tc.message, serviceErr.Message)
+			}
+		})
+	}
+}
@@ -3,18 +3,38 @@ package httprepo
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
+	"github.com/pillowskiy/gopix/internal/config"
 	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
+)
+
+const (
+	defaultFeaturesTimeout  = 30 * time.Second
+	defaultSearchTimeout    = 10 * time.Second
+	defaultDeleteTimeout    = 5 * time.Second
+	defaultMaxConcurrency   = 16
+	defaultRetryBackoff     = 100 * time.Millisecond
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 30 * time.Second
+
+	similarLimit = 20
 )
 
 type vectorRepository struct {
-	baseURL string
-	client  *http.Client
+	client *client
+
+	featuresTimeout time.Duration
+	searchTimeout   time.Duration
+	deleteTimeout   time.Duration
 }
 
 type similarResponse struct {
@@ -22,122 +42,222 @@ type similarResponse struct {
 	Distance float64   `json:"distance"`
 }
 
-func NewVectorizationRepository(baseURL string) *vectorRepository {
-	return &vectorRepository{baseURL: baseURL, client: &http.Client{}}
+func NewVectorizationRepository(cfg *config.VecService) *vectorRepository {
+	clientCfg := ClientConfig{
+		Name:             "vec_service",
+		BaseURL:          cfg.URL,
+		MaxRetries:       cfg.MaxRetries,
+		RetryBackoff:     cfg.RetryBackoffMS * time.Millisecond,
+		MaxConcurrency:   cfg.MaxConcurrency,
+		BreakerThreshold: cfg.BreakerThreshold,
+		BreakerCooldown:  cfg.BreakerCooldown * time.Second,
+	}
+
+	if clientCfg.RetryBackoff <= 0 {
+		clientCfg.RetryBackoff = defaultRetryBackoff
+	}
+	if clientCfg.MaxConcurrency <= 0 {
+		clientCfg.MaxConcurrency = defaultMaxConcurrency
+	}
+	if clientCfg.BreakerThreshold <= 0 {
+		clientCfg.BreakerThreshold = defaultBreakerThreshold
+	}
+	if clientCfg.BreakerCooldown <= 0 {
+		clientCfg.BreakerCooldown = defaultBreakerCooldown
+	}
+
+	return &vectorRepository{
+		client:          newClient(clientCfg),
+		featuresTimeout: orDefault(cfg.FeaturesTimeout*time.Second, defaultFeaturesTimeout),
+		searchTimeout:   orDefault(cfg.SearchTimeout*time.Second, defaultSearchTimeout),
+		deleteTimeout:   orDefault(cfg.DeleteTimeout*time.Second, defaultDeleteTimeout),
+	}
 }
 
 func (repo *vectorRepository) Features(
 	ctx context.Context, imageID domain.ID, file *domain.FileNode,
 ) error {
-	url := fmt.Sprintf("%s/features", repo.baseURL)
+	return repo.features(ctx, imageID, file, false)
+}
 
-	var body bytes.Buffer
-	writer := multipart.NewWriter(&body)
+// The http api has no batch endpoint, the images are sent one by one
+func (repo *vectorRepository) BatchFeatures(
+	ctx context.Context, files map[domain.ID]*domain.FileNode,
+) (map[domain.ID]error, error) {
+	return repo.batchFeatures(ctx, files, false)
+}
 
-	err := writer.WriteField("id", imageID.String())
-	if err != nil {
-		return fmt.Errorf("failed to add id field: %w", err)
-	}
+func (repo *vectorRepository) ReplaceFeatures(
+	ctx context.Context, files map[domain.ID]*domain.FileNode,
+) (map[domain.ID]error, error) {
+	return repo.batchFeatures(ctx, files, true)
+}
 
-	part, err := writer.CreateFormFile("image", file.Name)
-	if err != nil {
-		return fmt.Errorf("failed to create form file: %w", err)
+func (repo *vectorRepository) batchFeatures(
+	ctx context.Context, files map[domain.ID]*domain.FileNode, replace bool,
+) (map[domain.ID]error, error) {
+	errs := make(map[domain.ID]error, len(files))
+	for imageID, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		errs[imageID] = repo.features(ctx, imageID, file, replace)
 	}
 
-	if _, err := io.Copy(part, file.Reader); err != nil {
-		return fmt.Errorf("failed to copy reader into writer: %w", err)
-	}
-	writer.Close()
+	return errs, nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", url, &body)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+func (repo *vectorRepository) features(
+	ctx context.Context, imageID domain.ID, file *domain.FileNode, replace bool,
+) error {
+	fields := map[string]string{"id": imageID.String()}
+	if replace {
+		fields["replace"] = "true"
 	}
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", writer.FormDataContentType())
 
-	resp, err := repo.client.Do(req)
+	body, contentType, err := multipartImageBody(file, fields)
 	if err != nil {
-		return fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusCreated {
-		data := make(map[string]interface{})
-		if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-			return fmt.Errorf("failed to unmarshal response body: %w", err)
-		}
-		return fmt.Errorf("server returned non-200 status: %d. message: %v", resp.StatusCode, data)
+		return err
 	}
 
-	return nil
+	// Inserts are not idempotent, a retried insert may conflict with the vector stored by the first attempt
+	return repo.client.do(ctx, &request{
+		call:         "features",
+		method:       http.MethodPost,
+		path:         "/features",
+		timeout:      repo.featuresTimeout,
+		idempotent:   replace,
+		body:         body,
+		contentType:  contentType,
+		expectStatus: http.StatusCreated,
+	}, nil)
 }
 
 func (repo *vectorRepository) Similar(
 	ctx context.Context, imageID domain.ID,
 ) ([]domain.ID, error) {
-	url := fmt.Sprintf("%s/similar/%s?limit=%v", repo.baseURL, imageID.String(), 20)
+	var data []similarResponse
+	err := repo.client.do(ctx, &request{
+		call:         "similar",
+		method:       http.MethodGet,
+		path:         fmt.Sprintf("/similar/%s?limit=%v", imageID.String(), similarLimit),
+		timeout:      repo.searchTimeout,
+		idempotent:   true,
+		expectStatus: http.StatusOK,
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return similarIDs(data), nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+func (repo *vectorRepository) SearchByImage(
+	ctx context.Context, file *domain.FileNode,
+) ([]domain.ID, error) {
+	body, contentType, err := multipartImageBody(file, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, err
 	}
-	req.Header.Set("Accept", "application/json")
 
-	resp, err := repo.client.Do(req)
+	var data []similarResponse
+	err = repo.client.do(ctx, &request{
+		call:         "search_by_image",
+		method:       http.MethodPost,
+		path:         fmt.Sprintf("/search/image?limit=%v", similarLimit),
+		timeout:      repo.searchTimeout,
+		idempotent:   true,
+		body:         body,
+		contentType:  contentType,
+		expectStatus: http.StatusOK,
+	}, &data)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	decoder := json.NewDecoder(resp.Body)
-	if resp.StatusCode != http.StatusOK {
-		data := make(map[string]interface{})
-		if err := decoder.Decode(&data); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
-		}
+	return similarIDs(data), nil
+}
 
-		return nil, fmt.Errorf(
-			"server returned non-200 status: %d. %v", resp.StatusCode, data,
-		)
-	}
+func (repo *vectorRepository) SearchByText(
+	ctx context.Context, query string, limit int,
+) ([]domain.ID, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("limit", strconv.Itoa(limit))
 
 	var data []similarResponse
-	if err := decoder.Decode(&data); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	err := repo.client.do(ctx, &request{
+		call:         "search_by_text",
+		method:       http.MethodGet,
+		path:         "/search?" + params.Encode(),
+		timeout:      repo.searchTimeout,
+		idempotent:   true,
+		expectStatus: http.StatusOK,
+	}, &data)
+	if err != nil {
+		return nil, err
 	}
 
-	ids := make([]domain.ID, 0, len(data))
-	for _, value := range data {
-		ids = append(ids, value.ImageID)
+	return similarIDs(data), nil
+}
+
+func (repo *vectorRepository) DeleteFeatures(ctx context.Context, imageID domain.ID) error {
+	err := repo.client.do(ctx, &request{
+		call:         "delete_features",
+		method:       http.MethodDelete,
+		path:         fmt.Sprintf("/features/%s", imageID.String()),
+		timeout:      repo.deleteTimeout,
+		idempotent:   true,
+		expectStatus: http.StatusOK,
+	}, nil)
+
+	var serviceErr *ServiceError
+	if errors.As(err, &serviceErr) && serviceErr.Status == http.StatusNotFound {
+		return repository.ErrNotFound
 	}
 
-	return ids, nil
+	return err
 }
 
-func (repo *vectorRepository) DeleteFeatures(ctx context.Context, imageID domain.ID) error {
-	url := fmt.Sprintf("%s/features/%s", repo.baseURL, imageID.String())
+// The body is buffered once, so it can be sent again on retries
+func multipartImageBody(file *domain.FileNode, fields map[string]string) ([]byte, string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
 
-	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			return nil, "", fmt.Errorf("failed to add %s field: %w", key, err)
+		}
 	}
-	req.Header.Set("Accept", "application/json")
 
-	resp, err := repo.client.Do(req)
+	part, err := writer.CreateFormFile("image", file.Name)
 	if err != nil {
-		return fmt.Errorf("failed to send request: %w", err)
+		return nil, "", fmt.Errorf("failed to create form file: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		data := make(map[string]interface{})
-		if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-			return fmt.Errorf("failed to unmarshal response body: %w", err)
-		}
+	if _, err := io.Copy(part, file.Reader); err != nil {
+		return nil, "", fmt.Errorf("failed to copy reader into writer: %w", err)
+	}
 
-		return fmt.Errorf("server returned non-200 status: %d. message: %v", resp.StatusCode, data)
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to close multipart writer: %w", err)
 	}
 
-	return nil
+	return body.Bytes(), writer.FormDataContentType(), nil
+}
+
+func similarIDs(data []similarResponse) []domain.ID {
+	ids := make([]domain.ID, 0, len(data))
+	for _, value := range data {
+		ids = append(ids, value.ImageID)
+	}
+
+	return ids
+}
+
+func orDefault(value time.Duration, def time.Duration) time.Duration {
+	if value <= 0 {
+		return def
+	}
+	return value
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pillowskiy/gopix/internal/domain"
@@ -12,6 +14,42 @@ import (
 	"github.com/pkg/errors"
 )
 
+var albumImagesSortQuery = pgutils.NewSortQueryBuilder().
+	AddField(string(domain.AlbumImageManualSort), pgutils.SortField{Field: "ia.position", Order: pgutils.SortOrderASC}).
+	AddField(string(domain.AlbumImageAddedSort), pgutils.SortField{Field: "ia.added_at", Order: pgutils.SortOrderDESC}).
+	AddField(string(domain.AlbumImageNewestSort), pgutils.SortField{Field: "i.uploaded_at", Order: pgutils.SortOrderDESC}).
+	AddField(string(domain.AlbumImagePopularSort), pgutils.SortField{Field: "an.likes_count", Order: pgutils.SortOrderDESC})
+
+// Smart albums have no manual order, it falls back to the similarity or the newest images first
+var ruleImagesSortQuery = pgutils.NewSortQueryBuilder().
+	AddField(string(domain.AlbumImageAddedSort), pgutils.SortField{Field: "i.uploaded_at", Order: pgutils.SortOrderDESC}).
+	AddField(string(domain.AlbumImageNewestSort), pgutils.SortField{Field: "i.uploaded_at", Order: pgutils.SortOrderDESC}).
+	AddField(string(domain.AlbumImagePopularSort), pgutils.SortField{
+		Field: "COALESCE(an.likes_count, 0)", Order: pgutils.SortOrderDESC,
+	})
+
+type albumRow struct {
+	domain.Album
+	RuleJSON []byte `db:"rule_json"`
+}
+
+func (r *albumRow) toAlbum() (*domain.Album, error) {
+	album := r.Album
+	if len(r.RuleJSON) > 0 {
+		album.Rule = new(domain.AlbumRule)
+		if err := json.Unmarshal(r.RuleJSON, album.Rule); err != nil {
+			return nil, err
+		}
+	}
+
+	return &album, nil
+}
+
+type albumImagePosition struct {
+	ImageID  domain.ID `db:"image_id"`
+	Position int       `db:"position"`
+}
+
 type albumRepository struct {
 	db *sqlx.DB
 }
@@ -21,37 +59,243 @@ func NewAlbumRepository(db *sqlx.DB) *albumRepository {
 }
 
 func (repo *albumRepository) Create(ctx context.Context, album *domain.Album) (*domain.Album, error) {
-	q := `INSERT INTO albums (name,description,author_id) VALUES ($1, $2, $3) RETURNING *`
-	rowx := repo.db.QueryRowxContext(ctx, q, album.Name, album.Description, album.AuthorID)
+	ruleJSON, err := marshalAlbumRule(album.Rule)
+	if err != nil {
+		return nil, errors.Wrap(err, "AlbumRepository.Create.marshalAlbumRule")
+	}
 
-	createdAlbum := new(domain.Album)
-	if err := rowx.StructScan(createdAlbum); err != nil {
+	q := `
+  WITH created AS (
+    INSERT INTO albums (name, description, author_id, access_level, kind)
+    VALUES (
+      $1, $2, $3,
+      COALESCE(NULLIF($4, '')::access_level, 'public'::access_level),
+      COALESCE(NULLIF($5, '')::album_kind, 'regular'::album_kind)
+    )
+    RETURNING *
+  ), rule AS (
+    INSERT INTO album_rules (album_id, rule)
+    SELECT id, $6::jsonb FROM created WHERE $6::jsonb IS NOT NULL
+  )
+  SELECT created.*, $6::jsonb AS rule_json FROM created`
+	rowx := repo.db.QueryRowxContext(
+		ctx, q, album.Name, album.Description, album.AuthorID, album.AccessLevel, album.Kind, ruleJSON,
+	)
+
+	row := new(albumRow)
+	if err := rowx.StructScan(row); err != nil {
 		return nil, errors.Wrap(err, "AlbumRepository.Create.StructScan")
 	}
 
-	return createdAlbum, nil
+	createdAlbum, err := row.toAlbum()
+	return createdAlbum, errors.Wrap(err, "AlbumRepository.Create.toAlbum")
 }
 
 func (repo *albumRepository) GetByID(ctx context.Context, albumID domain.ID) (*domain.Album, error) {
-	q := `SELECT * FROM albums WHERE id = $1`
+	q := `
+  SELECT a.*, r.rule AS rule_json FROM albums a
+  LEFT JOIN album_rules r ON r.album_id = a.id
+  WHERE a.id = $1`
 
 	rowx := repo.db.QueryRowxContext(ctx, q, albumID)
 
-	album := new(domain.Album)
-	if err := rowx.StructScan(album); err != nil {
+	row := new(albumRow)
+	if err := rowx.StructScan(row); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repository.ErrNotFound
 		}
 		return nil, errors.Wrap(err, "AlbumRepository.GetByID.StructScan")
 	}
 
-	return album, nil
+	album, err := row.toAlbum()
+	return album, errors.Wrap(err, "AlbumRepository.GetByID.toAlbum")
 }
 
+// SetRule replaces the rule of the smart album
+func (repo *albumRepository) SetRule(ctx context.Context, albumID domain.ID, rule *domain.AlbumRule) error {
+	ruleJSON, err := marshalAlbumRule(rule)
+	if err != nil {
+		return errors.Wrap(err, "AlbumRepository.SetRule.marshalAlbumRule")
+	}
+
+	q := `
+  INSERT INTO album_rules (album_id, rule) VALUES ($1, $2)
+  ON CONFLICT (album_id) DO UPDATE SET rule = EXCLUDED.rule, updated_at = CURRENT_TIMESTAMP`
+
+	_, err = repo.db.ExecContext(ctx, q, albumID, ruleJSON)
+	return errors.Wrap(err, "AlbumRepository.SetRule.ExecContext")
+}
+
+// GetRuleImages evaluates the smart album rule, similarIDs are the images similar to the rule seed
+// ordered by the similarity and are used only when the rule has the seed
+func (repo *albumRepository) GetRuleImages(
+	ctx context.Context,
+	rule *domain.AlbumRule,
+	similarIDs []domain.ID,
+	viewerID *domain.ID,
+	pagInput *domain.PaginationInput,
+	sort domain.AlbumImageSortMethod,
+) (*domain.Pagination[domain.ImageWithMeta], error) {
+	pag := &domain.Pagination[domain.ImageWithMeta]{
+		PaginationInput: *pagInput,
+		Items:           []domain.ImageWithMeta{},
+	}
+
+	if rule.SimilarTo != nil && len(similarIDs) == 0 {
+		return pag, nil
+	}
+
+	var sortQuery string
+	var sortArgs []interface{}
+	switch {
+	case sort == domain.AlbumImageManualSort && rule.SimilarTo != nil:
+		sortQuery = "array_position(ARRAY[?]::BIGINT[], i.id)"
+		sortArgs = append(sortArgs, similarIDs)
+	case sort == domain.AlbumImageManualSort:
+		sortQuery, _ = ruleImagesSortQuery.SortQuery(string(domain.AlbumImageNewestSort))
+	default:
+		var ok bool
+		if sortQuery, ok = ruleImagesSortQuery.SortQuery(string(sort)); !ok {
+			return nil, repository.ErrIncorrectInput
+		}
+	}
+
+	where, whereArgs := albumRuleFilter(rule, similarIDs, viewerID)
+
+	q := fmt.Sprintf(`
+  SELECT
+    i.*,
+    MAX(ip.width) AS "properties.width",
+    MAX(ip.height) AS "properties.height",
+    MAX(ip.ext) AS "properties.ext",
+    MAX(ip.mime) AS "properties.mime",
+    u.id AS "author.id",
+    u.username AS "author.username",
+    u.avatar_url AS "author.avatar_url"
+  FROM images i
+  JOIN users u ON u.id = i.author_id
+  LEFT JOIN image_properties ip ON ip.image_id = i.id
+  LEFT JOIN images_analytics an ON an.image_id = i.id
+  WHERE %s
+  GROUP BY i.id, u.id, an.likes_count
+  ORDER BY %s, i.id
+  LIMIT ? OFFSET ?
+  `, where, sortQuery)
+
+	args := make([]interface{}, 0, len(whereArgs)+len(sortArgs)+2)
+	args = append(args, whereArgs...)
+	args = append(args, sortArgs...)
+	args = append(args, pagInput.PerPage, (pagInput.Page-1)*pagInput.PerPage)
+	query, args, err := sqlx.In(q, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "AlbumRepository.GetRuleImages.In")
+	}
+
+	rows, err := repo.db.QueryxContext(ctx, repo.db.Rebind(query), args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "AlbumRepository.GetRuleImages.QueryxContext")
+	}
+
+	images, err := pgutils.ScanToStructSliceOf[domain.ImageWithMeta](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "AlbumRepository.GetRuleImages.scanToStructSliceOf")
+	}
+	pag.Items = images
+
+	countQuery := fmt.Sprintf(`
+  SELECT COUNT(1) FROM images i
+  LEFT JOIN images_analytics an ON an.image_id = i.id
+  WHERE %s`, where)
+	if countQuery, countArgs, err := sqlx.In(countQuery, whereArgs...); err == nil {
+		_ = repo.db.QueryRowxContext(ctx, repo.db.Rebind(countQuery), countArgs...).Scan(&pag.Total)
+	}
+
+	return pag, nil
+}
+
+// albumRuleFilter builds the WHERE clause with ? placeholders to be expanded by sqlx.In
+func albumRuleFilter(
+	rule *domain.AlbumRule, similarIDs []domain.ID, viewerID *domain.ID,
+) (string, []interface{}) {
+	conds := []string{
+		"(i.access_level = 'public'::access_level OR i.author_id = ?)",
+		"i.author_id NOT IN (SELECT id FROM hidden_author_ids(?))",
+	}
+	args := []interface{}{viewerID, viewerID}
+
+	// Every rule tag is matched by the tag itself, its aliases or any of its descendants
+	for _, tag := range uniqueTags(rule.Tags) {
+		conds = append(conds, `EXISTS (
+    SELECT 1 FROM images_to_tags it
+    WHERE it.image_id = i.id AND it.tag_id IN (SELECT id FROM tag_subtree(?))
+  )`)
+		args = append(args, tag)
+	}
+
+	if rule.AuthorID != nil {
+		conds = append(conds, "i.author_id = ?")
+		args = append(args, *rule.AuthorID)
+	}
+
+	if rule.From != nil {
+		conds = append(conds, "i.uploaded_at >= ?")
+		args = append(args, *rule.From)
+	}
+
+	if rule.To != nil {
+		conds = append(conds, "i.uploaded_at < ?")
+		args = append(args, *rule.To)
+	}
+
+	if rule.MinLikes > 0 {
+		conds = append(conds, "COALESCE(an.likes_count, 0) >= ?")
+		args = append(args, rule.MinLikes)
+	}
+
+	if rule.SimilarTo != nil {
+		conds = append(conds, "i.id IN (?)")
+		args = append(args, similarIDs)
+	}
+
+	return strings.Join(conds, "\n    AND "), args
+}
+
+func uniqueTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	unique := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		unique = append(unique, tag)
+	}
+
+	return unique
+}
+
+func marshalAlbumRule(rule *domain.AlbumRule) ([]byte, error) {
+	if rule == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(rule)
+}
+
+// GetAlbumImages lists the public album images and the ones of the viewer, viewerID may be nil
 func (repo *albumRepository) GetAlbumImages(
-	ctx context.Context, albumID domain.ID, pagInput *domain.PaginationInput,
+	ctx context.Context,
+	albumID domain.ID,
+	viewerID *domain.ID,
+	pagInput *domain.PaginationInput,
+	sort domain.AlbumImageSortMethod,
 ) (*domain.Pagination[domain.ImageWithMeta], error) {
-	q := `
+	sortQuery, ok := albumImagesSortQuery.SortQuery(string(sort))
+	if !ok {
+		return nil, repository.ErrIncorrectInput
+	}
+
+	q := fmt.Sprintf(`
   SELECT
     i.*,
     MAX(ip.width) AS "properties.width",
@@ -62,14 +306,21 @@ func (repo *albumRepository) GetAlbumImages(
     u.username AS "author.username",
     u.avatar_url AS "author.avatar_url"
   FROM images_to_albums ia
-  JOIN images i ON i.id = ia.image_id AND i.access_level = 'public'::access_level
+  JOIN images i ON i.id = ia.image_id
+    AND (i.access_level = 'public'::access_level OR i.author_id = $2)
+    AND i.author_id NOT IN (SELECT id FROM hidden_author_ids($2))
   JOIN users u ON u.id = i.author_id
   JOIN image_properties ip ON i.id = ip.image_id
+  LEFT JOIN images_analytics an ON an.image_id = i.id
   WHERE ia.album_id = $1
-  LIMIT $2 OFFSET $3
-  `
-
-	rowx, err := repo.db.QueryxContext(ctx, q, albumID, pagInput.PerPage, (pagInput.Page-1)*pagInput.PerPage)
+  GROUP BY i.id, u.id, ia.position, ia.added_at, an.likes_count
+  ORDER BY %s, i.id
+  LIMIT $3 OFFSET $4
+  `, sortQuery)
+
+	rowx, err := repo.db.QueryxContext(
+		ctx, q, albumID, viewerID, pagInput.PerPage, (pagInput.Page-1)*pagInput.PerPage,
+	)
 	if err != nil {
 		return nil, errors.Wrap(err, "AlbumRepository.GetAlbumImages.QueryxContext")
 	}
@@ -84,14 +335,20 @@ func (repo *albumRepository) GetAlbumImages(
 		Items:           images,
 	}
 
-	countQuery := `SELECT COUNT(1) FROM images_to_albums WHERE album_id = $1`
-	_ = repo.db.QueryRowxContext(ctx, countQuery, albumID).Scan(&pag.Total)
+	countQuery := `
+  SELECT COUNT(1) FROM images_to_albums ia
+  JOIN images i ON i.id = ia.image_id
+    AND (i.access_level = 'public'::access_level OR i.author_id = $2)
+    AND i.author_id NOT IN (SELECT id FROM hidden_author_ids($2))
+  WHERE ia.album_id = $1`
+	_ = repo.db.QueryRowxContext(ctx, countQuery, albumID, viewerID).Scan(&pag.Total)
 
 	return pag, nil
 }
 
-func (repo *albumRepository) GetByAuthorID(ctx context.Context, authorID domain.ID) ([]domain.DetailedAlbum, error) {
-	q := `
+// detailedAlbumsQuery is formatted with the extra joins and the where clause,
+// the covers are built from the images visible to the viewer bound as $1
+const detailedAlbumsQuery = `
   SELECT
     a.*,
     u.id AS "author.id",
@@ -105,24 +362,61 @@ func (repo *albumRepository) GetByAuthorID(ctx context.Context, authorID domain.
         SELECT i.*
         FROM images i
         INNER JOIN images_to_albums ita ON i.id = ita.image_id
-        WHERE ita.album_id = a.id LIMIT 3
+        WHERE ita.album_id = a.id
+          AND (i.access_level = 'public'::access_level OR i.author_id = $1)
+          AND i.author_id NOT IN (SELECT id FROM hidden_author_ids($1))
+        ORDER BY (i.id IS NOT DISTINCT FROM a.cover_id) DESC, ita.position
+        LIMIT 3
       ) AS img
-    ) AS "cover"
+    ) AS "cover",
+    r.rule AS "rule"
   FROM albums a
   INNER JOIN users u ON a.author_id = u.id
-  WHERE a.author_id = $1 GROUP BY a.id, u.id
-  `
-
-	rows, err := repo.db.QueryxContext(ctx, q, authorID)
+  LEFT JOIN album_rules r ON r.album_id = a.id
+  %s
+  WHERE %s GROUP BY a.id, u.id, r.album_id
+  %s`
+
+// GetByAuthorID builds the album covers from the images visible to the viewer, viewerID may be nil
+func (repo *albumRepository) GetByAuthorID(
+	ctx context.Context, authorID domain.ID, viewerID *domain.ID,
+) ([]domain.DetailedAlbum, error) {
+	q := fmt.Sprintf(detailedAlbumsQuery, "", "a.author_id = $2", "")
+
+	rows, err := repo.db.QueryxContext(ctx, q, viewerID, authorID)
 	if err != nil {
 		return nil, errors.Wrap(err, "AlbumRepository.GetByAuthorID.QueryxContext")
 	}
 
+	albums, err := scanDetailedAlbums(rows)
+	return albums, errors.Wrap(err, "AlbumRepository.GetByAuthorID.scanDetailedAlbums")
+}
+
+// GetFollowed lists the albums followed by the user starting from the latest follows
+func (repo *albumRepository) GetFollowed(ctx context.Context, userID domain.ID) ([]domain.DetailedAlbum, error) {
+	q := fmt.Sprintf(
+		detailedAlbumsQuery,
+		"INNER JOIN album_followers f ON f.album_id = a.id",
+		"f.user_id = $1",
+		"ORDER BY MAX(f.created_at) DESC",
+	)
+
+	rows, err := repo.db.QueryxContext(ctx, q, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "AlbumRepository.GetFollowed.QueryxContext")
+	}
+
+	albums, err := scanDetailedAlbums(rows)
+	return albums, errors.Wrap(err, "AlbumRepository.GetFollowed.scanDetailedAlbums")
+}
+
+func scanDetailedAlbums(rows *sqlx.Rows) ([]domain.DetailedAlbum, error) {
 	defer rows.Close()
+
 	var albums []domain.DetailedAlbum
 	for rows.Next() {
 		var row domain.DetailedAlbum
-		var rowCoverJSON []byte
+		var rowCoverJSON, rowRuleJSON []byte
 
 		if err := rows.Scan(
 			&row.ID,
@@ -131,25 +425,73 @@ func (repo *albumRepository) GetByAuthorID(ctx context.Context, authorID domain.
 			&row.Description,
 			&row.CreatedAt,
 			&row.UpdatedAt,
+			&row.AccessLevel,
+			&row.ShareToken,
+			&row.CoverID,
+			&row.Kind,
 			&row.Author.ID,
 			&row.Author.Username,
 			&row.Author.AvatarURL,
 			&rowCoverJSON,
+			&rowRuleJSON,
 		); err != nil {
-			return nil, errors.Wrap(err, "AlbumRepository.GetByAuthorID.Scan")
+			return nil, errors.Wrap(err, "Scan")
 		}
 
 		row.Cover = []domain.Image{}
 		if len(rowCoverJSON) > 0 {
 			if err := json.Unmarshal(rowCoverJSON, &row.Cover); err != nil {
-				return nil, errors.Wrap(err, "imageRepository.GetDetailed.Unmarshal")
+				return nil, errors.Wrap(err, "UnmarshalCover")
+			}
+		}
+
+		if len(rowRuleJSON) > 0 {
+			row.Rule = new(domain.AlbumRule)
+			if err := json.Unmarshal(rowRuleJSON, row.Rule); err != nil {
+				return nil, errors.Wrap(err, "UnmarshalRule")
 			}
 		}
 
 		albums = append(albums, row)
 	}
 
-	return albums, nil
+	return albums, rows.Err()
+}
+
+func (repo *albumRepository) Follow(ctx context.Context, albumID domain.ID, userID domain.ID) error {
+	q := `INSERT INTO album_followers (album_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+	_, err := repo.db.ExecContext(ctx, q, albumID, userID)
+	return errors.Wrap(err, "AlbumRepository.Follow.ExecContext")
+}
+
+func (repo *albumRepository) Unfollow(ctx context.Context, albumID domain.ID, userID domain.ID) error {
+	q := `DELETE FROM album_followers WHERE album_id = $1 AND user_id = $2`
+
+	_, err := repo.db.ExecContext(ctx, q, albumID, userID)
+	return errors.Wrap(err, "AlbumRepository.Unfollow.ExecContext")
+}
+
+func (repo *albumRepository) IsFollowing(ctx context.Context, albumID domain.ID, userID domain.ID) (bool, error) {
+	q := `SELECT EXISTS(SELECT 1 FROM album_followers WHERE album_id = $1 AND user_id = $2)`
+
+	isFollowing := false
+	if err := repo.db.QueryRowxContext(ctx, q, albumID, userID).Scan(&isFollowing); err != nil {
+		return isFollowing, errors.Wrap(err, "AlbumRepository.IsFollowing")
+	}
+
+	return isFollowing, nil
+}
+
+func (repo *albumRepository) GetFollowers(ctx context.Context, albumID domain.ID) ([]domain.User, error) {
+	q := `SELECT u.* FROM album_followers af JOIN users u ON u.id = af.user_id WHERE af.album_id = $1`
+
+	var followers []domain.User
+	if err := repo.db.SelectContext(ctx, &followers, q, albumID); err != nil {
+		return nil, errors.Wrap(err, "AlbumRepository.GetFollowers.SelectContext")
+	}
+
+	return followers, nil
 }
 
 func (repo *albumRepository) Delete(ctx context.Context, albumID domain.ID) error {
@@ -167,10 +509,11 @@ func (repo *albumRepository) Update(
 	q := `
   UPDATE albums SET
     name = COALESCE(NULLIF($1, ''), name),
-    description = COALESCE(NULLIF($2, ''), description)
-  WHERE id = $3 RETURNING *`
+    description = COALESCE(NULLIF($2, ''), description),
+    access_level = COALESCE(NULLIF($3, '')::access_level, access_level)::access_level
+  WHERE id = $4 RETURNING *`
 
-	rowx := repo.db.QueryRowxContext(ctx, q, album.Name, album.Description, albumID)
+	rowx := repo.db.QueryRowxContext(ctx, q, album.Name, album.Description, album.AccessLevel, albumID)
 
 	updatedAlbum := new(domain.Album)
 	if err := rowx.StructScan(updatedAlbum); err != nil {
@@ -180,27 +523,70 @@ func (repo *albumRepository) Update(
 	return updatedAlbum, nil
 }
 
+func (repo *albumRepository) SetShareToken(ctx context.Context, albumID domain.ID, token *string) error {
+	q := `UPDATE albums SET share_token = $1 WHERE id = $2`
+
+	_, err := repo.db.ExecContext(ctx, q, token, albumID)
+	if err != nil {
+		return errors.Wrap(err, "AlbumRepository.SetShareToken.ExecContext")
+	}
+
+	return nil
+}
+
 func (repo *albumRepository) PutImage(
 	ctx context.Context,
 	albumID domain.ID,
 	imageID domain.ID,
+	addedBy domain.ID,
 ) error {
-	q := `INSERT INTO images_to_albums (album_id, image_id) VALUES ($1, $2)`
+	q := `
+  INSERT INTO images_to_albums (album_id, image_id, added_by, position)
+  SELECT $1, $2, $3, COALESCE(MAX(position) + 1, 0) FROM images_to_albums WHERE album_id = $1
+  ON CONFLICT DO NOTHING`
 
-	_, err := repo.db.ExecContext(ctx, q, albumID, imageID)
+	res, err := repo.db.ExecContext(ctx, q, albumID, imageID, addedBy)
 	if err != nil {
 		return errors.Wrap(err, "AlbumRepository.PutImage.ExecContext")
 	}
 
+	// A concurrent request has added the same image first
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return repository.ErrAlreadyExists
+	}
+
 	return nil
 }
 
+// ImageAddedBy returns nil adder for the images added before the album members were introduced
+func (repo *albumRepository) ImageAddedBy(
+	ctx context.Context, albumID domain.ID, imageID domain.ID,
+) (*domain.ID, error) {
+	q := `SELECT added_by FROM images_to_albums WHERE album_id = $1 AND image_id = $2 LIMIT 1`
+
+	var addedBy *domain.ID
+	if err := repo.db.QueryRowxContext(ctx, q, albumID, imageID).Scan(&addedBy); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, errors.Wrap(err, "AlbumRepository.ImageAddedBy.Scan")
+	}
+
+	return addedBy, nil
+}
+
 func (repo *albumRepository) DeleteImage(
 	ctx context.Context,
 	albumID domain.ID,
 	imageID domain.ID,
 ) error {
-	q := `DELETE FROM album_to_images WHERE album_id = $1 AND image_id = $2`
+	// The removed image can no longer be the album cover
+	q := `
+  WITH deleted AS (
+    DELETE FROM images_to_albums WHERE album_id = $1 AND image_id = $2 RETURNING image_id
+  )
+  UPDATE albums SET cover_id = NULL
+  WHERE id = $1 AND cover_id IN (SELECT image_id FROM deleted)`
 
 	_, err := repo.db.ExecContext(ctx, q, albumID, imageID)
 	if err != nil {
@@ -209,3 +595,226 @@ func (repo *albumRepository) DeleteImage(
 
 	return nil
 }
+
+// MoveImage places the image at the position and renumbers the rest of the album images,
+// positions out of range move the image to the end
+func (repo *albumRepository) MoveImage(
+	ctx context.Context, albumID domain.ID, imageID domain.ID, position int,
+) error {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "AlbumRepository.MoveImage.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	// Concurrent reorders of the same album are serialized by the album row lock
+	lockQuery := `SELECT 1 FROM albums WHERE id = $1 FOR UPDATE`
+	if _, err := tx.ExecContext(ctx, lockQuery, albumID); err != nil {
+		return errors.Wrap(err, "AlbumRepository.MoveImage.Lock")
+	}
+
+	var total int
+	var found bool
+	statQuery := `
+  SELECT COUNT(1), COALESCE(BOOL_OR(image_id = $2), FALSE)
+  FROM images_to_albums WHERE album_id = $1`
+	if err := tx.QueryRowxContext(ctx, statQuery, albumID, imageID).Scan(&total, &found); err != nil {
+		return errors.Wrap(err, "AlbumRepository.MoveImage.Scan")
+	}
+
+	if !found {
+		return repository.ErrNotFound
+	}
+
+	position = min(position, total-1)
+
+	shiftQuery := `
+  UPDATE images_to_albums ia
+  SET position = CASE WHEN o.position >= $3 THEN o.position + 1 ELSE o.position END
+  FROM (
+    SELECT image_id, ROW_NUMBER() OVER (ORDER BY position, added_at, image_id) - 1 AS position
+    FROM images_to_albums
+    WHERE album_id = $1 AND image_id <> $2
+  ) AS o
+  WHERE ia.album_id = $1 AND ia.image_id = o.image_id`
+	if _, err := tx.ExecContext(ctx, shiftQuery, albumID, imageID, position); err != nil {
+		return errors.Wrap(err, "AlbumRepository.MoveImage.Shift")
+	}
+
+	moveQuery := `UPDATE images_to_albums SET position = $3 WHERE album_id = $1 AND image_id = $2`
+	if _, err := tx.ExecContext(ctx, moveQuery, albumID, imageID, position); err != nil {
+		return errors.Wrap(err, "AlbumRepository.MoveImage.Move")
+	}
+
+	return errors.Wrap(tx.Commit(), "AlbumRepository.MoveImage.Commit")
+}
+
+// SetImagesOrder expects every album image exactly once, otherwise ErrIncorrectInput is returned
+func (repo *albumRepository) SetImagesOrder(ctx context.Context, albumID domain.ID, imageIDs []domain.ID) error {
+	if len(imageIDs) == 0 {
+		return repository.ErrIncorrectInput
+	}
+
+	positions := make([]albumImagePosition, len(imageIDs))
+	for i, id := range imageIDs {
+		positions[i] = albumImagePosition{ImageID: id, Position: i}
+	}
+
+	values, params, err := pgutils.BulkUpdateValues(positions, "image_id::bigint,position::int")
+	if err != nil {
+		return errors.Wrap(err, "AlbumRepository.SetImagesOrder.BulkUpdateValues")
+	}
+
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "AlbumRepository.SetImagesOrder.BeginTxx")
+	}
+	defer tx.Rollback()
+
+	lockQuery := `SELECT 1 FROM albums WHERE id = $1 FOR UPDATE`
+	if _, err := tx.ExecContext(ctx, lockQuery, albumID); err != nil {
+		return errors.Wrap(err, "AlbumRepository.SetImagesOrder.Lock")
+	}
+
+	var total int
+	countQuery := `SELECT COUNT(1) FROM images_to_albums WHERE album_id = $1`
+	if err := tx.QueryRowxContext(ctx, countQuery, albumID).Scan(&total); err != nil {
+		return errors.Wrap(err, "AlbumRepository.SetImagesOrder.Count")
+	}
+
+	if total != len(imageIDs) {
+		return repository.ErrIncorrectInput
+	}
+
+	q := fmt.Sprintf(`
+  UPDATE images_to_albums AS ia
+  SET position = p.position
+  FROM (%s) AS p(image_id, position)
+  WHERE ia.album_id = $%d AND ia.image_id = p.image_id
+  `, values, len(params)+1)
+
+	res, err := tx.ExecContext(ctx, q, append(params, albumID)...)
+	if err != nil {
+		return errors.Wrap(err, "AlbumRepository.SetImagesOrder.ExecContext")
+	}
+
+	// Duplicated or foreign ids leave some of the album images untouched
+	if affected, err := res.RowsAffected(); err != nil || int(affected) != total {
+		return repository.ErrIncorrectInput
+	}
+
+	return errors.Wrap(tx.Commit(), "AlbumRepository.SetImagesOrder.Commit")
+}
+
+func (repo *albumRepository) SetCover(ctx context.Context, albumID domain.ID, imageID *domain.ID) error {
+	q := `UPDATE albums SET cover_id = $1 WHERE id = $2`
+
+	_, err := repo.db.ExecContext(ctx, q, imageID, albumID)
+	return errors.Wrap(err, "AlbumRepository.SetCover.ExecContext")
+}
+
+const selectAlbumMembersQuery = `
+  SELECT
+    m.*,
+    u.id AS "user.id",
+    u.username AS "user.username",
+    u.avatar_url AS "user.avatar_url"
+  FROM album_members m
+  JOIN users u ON u.id = m.user_id
+`
+
+func (repo *albumRepository) GetMember(
+	ctx context.Context, albumID domain.ID, userID domain.ID,
+) (*domain.AlbumMember, error) {
+	q := selectAlbumMembersQuery + `WHERE m.album_id = $1 AND m.user_id = $2`
+
+	member := new(domain.AlbumMember)
+	if err := repo.db.QueryRowxContext(ctx, q, albumID, userID).StructScan(member); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, errors.Wrap(err, "AlbumRepository.GetMember.StructScan")
+	}
+
+	return member, nil
+}
+
+func (repo *albumRepository) GetMembers(ctx context.Context, albumID domain.ID) ([]domain.AlbumMember, error) {
+	q := selectAlbumMembersQuery + `WHERE m.album_id = $1 ORDER BY m.created_at`
+
+	rows, err := repo.db.QueryxContext(ctx, q, albumID)
+	if err != nil {
+		return nil, errors.Wrap(err, "AlbumRepository.GetMembers.QueryxContext")
+	}
+
+	members, err := pgutils.ScanToStructSliceOf[domain.AlbumMember](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "AlbumRepository.GetMembers.scanToStructSliceOf")
+	}
+
+	return members, nil
+}
+
+// GetMemberships returns the user memberships in the albums of the author
+func (repo *albumRepository) GetMemberships(
+	ctx context.Context, userID domain.ID, authorID domain.ID,
+) ([]domain.AlbumMember, error) {
+	q := selectAlbumMembersQuery + `
+  JOIN albums a ON a.id = m.album_id
+  WHERE m.user_id = $1 AND a.author_id = $2`
+
+	rows, err := repo.db.QueryxContext(ctx, q, userID, authorID)
+	if err != nil {
+		return nil, errors.Wrap(err, "AlbumRepository.GetMemberships.QueryxContext")
+	}
+
+	members, err := pgutils.ScanToStructSliceOf[domain.AlbumMember](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "AlbumRepository.GetMemberships.scanToStructSliceOf")
+	}
+
+	return members, nil
+}
+
+// AddMember returns ErrNotFound when the invited user doesn't exist
+func (repo *albumRepository) AddMember(ctx context.Context, member *domain.AlbumMember) error {
+	q := `
+  INSERT INTO album_members (album_id, user_id, role, accepted, invited_by)
+  SELECT $1, u.id, $3, $4, $5 FROM users u WHERE u.id = $2`
+
+	res, err := repo.db.ExecContext(
+		ctx, q, member.AlbumID, member.UserID, member.Role, member.Accepted, member.InvitedBy,
+	)
+	if err != nil {
+		return errors.Wrap(err, "AlbumRepository.AddMember.ExecContext")
+	}
+
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (repo *albumRepository) UpdateMemberRole(
+	ctx context.Context, albumID domain.ID, userID domain.ID, role domain.AlbumRole,
+) error {
+	q := `UPDATE album_members SET role = $1 WHERE album_id = $2 AND user_id = $3`
+
+	_, err := repo.db.ExecContext(ctx, q, role, albumID, userID)
+	return errors.Wrap(err, "AlbumRepository.UpdateMemberRole.ExecContext")
+}
+
+func (repo *albumRepository) AcceptMember(ctx context.Context, albumID domain.ID, userID domain.ID) error {
+	q := `UPDATE album_members SET accepted = TRUE WHERE album_id = $1 AND user_id = $2`
+
+	_, err := repo.db.ExecContext(ctx, q, albumID, userID)
+	return errors.Wrap(err, "AlbumRepository.AcceptMember.ExecContext")
+}
+
+func (repo *albumRepository) RemoveMember(ctx context.Context, albumID domain.ID, userID domain.ID) error {
+	q := `DELETE FROM album_members WHERE album_id = $1 AND user_id = $2`
+
+	_, err := repo.db.ExecContext(ctx, q, albumID, userID)
+	return errors.Wrap(err, "AlbumRepository.RemoveMember.ExecContext")
+}
@@ -16,6 +16,13 @@ var commentSortQuery = pgutils.NewSortQueryBuilder().
 	AddField(string(domain.CommentNewestSort), pgutils.SortField{Field: "created_at", Order: pgutils.SortOrderDESC}).
 	AddField(string(domain.CommentOldestSort), pgutils.SortField{Field: "created_at", Order: pgutils.SortOrderASC})
 
+const detailedCommentFields = `
+    c.*,
+    u.id AS "author.id",
+    u.username AS "author.username",
+    u.avatar_url AS "author.avatar_url",
+    (SELECT COUNT(*) FROM comments r WHERE r.parent_id = c.id) AS "stats.replies_count"`
+
 type commentRepository struct {
 	db *sqlx.DB
 }
@@ -28,9 +35,12 @@ func (repo *commentRepository) Create(
 	ctx context.Context,
 	comment *domain.Comment,
 ) (*domain.Comment, error) {
-	q := `INSERT INTO comments (image_id, author_id, comment) VALUES($1, $2, $3) RETURNING *`
+	q := `INSERT INTO comments (image_id, author_id, parent_id, depth, comment)
+  VALUES($1, $2, $3, $4, $5) RETURNING *`
 
-	rowx := repo.db.QueryRowxContext(ctx, q, comment.ImageID, comment.AuthorID, comment.Text)
+	rowx := repo.db.QueryRowxContext(
+		ctx, q, comment.ImageID, comment.AuthorID, comment.ParentID, comment.Depth, comment.Text,
+	)
 
 	cmt := new(domain.Comment)
 	if err := rowx.StructScan(cmt); err != nil {
@@ -45,25 +55,27 @@ func (repo *commentRepository) GetByImageID(
 	imageID domain.ID,
 	pagInput *domain.PaginationInput,
 	sort domain.CommentSortMethod,
+	vis *domain.CommentVisibility,
 ) (*domain.Pagination[domain.DetailedComment], error) {
 	sortQuery, ok := commentSortQuery.SortQuery(string(sort))
 	if !ok {
 		return nil, repository.ErrIncorrectInput
 	}
 
+	// The pinned comment always goes first
 	q := fmt.Sprintf(`
-  SELECT
-    c.*,
-    u.id AS "author.id",
-    u.username AS "author.username",
-    u.avatar_url AS "author.avatar_url"
+  SELECT %s
   FROM comments c
   JOIN users u ON c.author_id = u.id
-  WHERE image_id = $1 AND parent_id IS NULL ORDER BY %s LIMIT $2 OFFSET $3
-  `, sortQuery)
+  WHERE image_id = $1 AND parent_id IS NULL
+    AND (c.hidden_at IS NULL OR c.author_id = $4 OR $5::boolean)
+  ORDER BY c.pinned_at IS NULL, %s LIMIT $2 OFFSET $3
+  `, detailedCommentFields, sortQuery)
 
 	limit := pagInput.PerPage
-	rowx, err := repo.db.QueryxContext(ctx, q, imageID, limit, (pagInput.Page-1)*limit)
+	rowx, err := repo.db.QueryxContext(
+		ctx, q, imageID, limit, (pagInput.Page-1)*limit, vis.ViewerID, vis.ShowHidden,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("CommentRepository.GetByImageID.QueryContext: %v", err)
 	}
@@ -79,8 +91,10 @@ func (repo *commentRepository) GetByImageID(
 		Items:           cmts,
 	}
 
-	countQuery := `SELECT COUNT(1) FROM comments WHERE image_id = $1`
-	_ = repo.db.QueryRowxContext(ctx, countQuery, imageID).Scan(&pagination.Total)
+	countQuery := `SELECT COUNT(1) FROM comments c
+  WHERE image_id = $1 AND parent_id IS NULL
+    AND (c.hidden_at IS NULL OR c.author_id = $2 OR $3::boolean)`
+	_ = repo.db.QueryRowxContext(ctx, countQuery, imageID, vis.ViewerID, vis.ShowHidden).Scan(&pagination.Total)
 
 	return pagination, nil
 }
@@ -116,68 +130,271 @@ func (repo *commentRepository) Delete(ctx context.Context, commentID domain.ID)
 	return nil
 }
 
+// Update keeps the previous text as a revision when the text is changed
 func (repo *commentRepository) Update(
 	ctx context.Context,
-	commnetID domain.ID,
+	commentID domain.ID,
 	comment *domain.Comment,
+	editorID domain.ID,
 ) (*domain.Comment, error) {
-	q := `UPDATE comments SET comment = COALESCE(NULLIF($1, ''), comment) WHERE id = $2 RETURNING *`
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("CommentRepository.Update.BeginTxx: %v", err)
+	}
+	defer tx.Rollback()
+
+	revisionQuery := `INSERT INTO comment_revisions (comment_id, comment, edited_by)
+  SELECT id, comment, $2 FROM comments WHERE id = $1 AND comment <> COALESCE(NULLIF($3, ''), comment)`
 
-	rowx := repo.db.QueryRowxContext(ctx, q, comment.Text, commnetID)
+	res, err := tx.ExecContext(ctx, revisionQuery, commentID, editorID, comment.Text)
+	if err != nil {
+		return nil, fmt.Errorf("CommentRepository.Update.InsertRevision: %v", err)
+	}
+
+	q := `UPDATE comments SET comment = COALESCE(NULLIF($1, ''), comment) WHERE id = $2 RETURNING *`
+	if affected, _ := res.RowsAffected(); affected != 0 {
+		q = `UPDATE comments SET comment = $1, edited_at = now(), updated_at = now() WHERE id = $2 RETURNING *`
+	}
 
 	cmt := new(domain.Comment)
-	if err := rowx.StructScan(cmt); err != nil {
+	if err := tx.QueryRowxContext(ctx, q, comment.Text, commentID).StructScan(cmt); err != nil {
 		return nil, fmt.Errorf("CommentRepository.Update.StructScan: %v", err)
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("CommentRepository.Update.Commit: %v", err)
+	}
+
 	return cmt, nil
 }
 
-func (repo *commentRepository) HasUserCommented(
-	ctx context.Context,
-	commentID domain.ID,
-	userID domain.ID,
-) (bool, error) {
-	q := `SELECT EXISTS(SELECT * FROM comments WHERE image_id = $1 AND author_id = $2)`
+// SoftDelete replaces the comment with the tombstone, the deleted text is kept as a revision
+func (repo *commentRepository) SoftDelete(ctx context.Context, commentID domain.ID, executorID domain.ID) error {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("CommentRepository.SoftDelete.BeginTxx: %v", err)
+	}
+	defer tx.Rollback()
 
-	rowx := repo.db.QueryRowxContext(ctx, q, commentID, userID)
+	revisionQuery := `INSERT INTO comment_revisions (comment_id, comment, edited_by)
+  SELECT id, comment, $2 FROM comments WHERE id = $1 AND deleted_at IS NULL`
+	if _, err := tx.ExecContext(ctx, revisionQuery, commentID, executorID); err != nil {
+		return fmt.Errorf("CommentRepository.SoftDelete.InsertRevision: %v", err)
+	}
 
-	var exists bool
-	if err := rowx.Scan(&exists); err != nil {
-		return false, fmt.Errorf("CommentRepository.IsCommentedByUser.Scan: %v", err)
+	if _, err := tx.ExecContext(ctx, `DELETE FROM comment_mentions WHERE comment_id = $1`, commentID); err != nil {
+		return fmt.Errorf("CommentRepository.SoftDelete.DeleteMentions: %v", err)
 	}
 
-	return exists, nil
+	q := `UPDATE comments SET comment = '', deleted_at = now(), updated_at = now() WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, q, commentID); err != nil {
+		return fmt.Errorf("CommentRepository.SoftDelete.ExecContext: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("CommentRepository.SoftDelete.Commit: %v", err)
+	}
+
+	return nil
 }
 
-func (repo *commentRepository) GetReplies(ctx context.Context, commentID domain.ID, userID *domain.ID) ([]domain.DetailedComment, error) {
-	q := `
-  SELECT
-    c.*,
-    u.id AS "author.id",
-    u.username AS "author.username",
-    u.avatar_url AS "author.avatar_url",
+func (repo *commentRepository) CountReplies(ctx context.Context, commentID domain.ID) (int, error) {
+	q := `SELECT COUNT(1) FROM comments WHERE parent_id = $1`
+
+	var count int
+	if err := repo.db.QueryRowxContext(ctx, q, commentID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("CommentRepository.CountReplies.Scan: %v", err)
+	}
+
+	return count, nil
+}
+
+func (repo *commentRepository) GetRevisions(ctx context.Context, commentID domain.ID) ([]domain.CommentRevision, error) {
+	q := `SELECT * FROM comment_revisions WHERE comment_id = $1 ORDER BY created_at DESC, id DESC`
+
+	revisions := make([]domain.CommentRevision, 0)
+	if err := repo.db.SelectContext(ctx, &revisions, q, commentID); err != nil {
+		return nil, fmt.Errorf("CommentRepository.GetRevisions.SelectContext: %v", err)
+	}
+
+	return revisions, nil
+}
+
+func (repo *commentRepository) GetReplies(
+	ctx context.Context,
+	commentID domain.ID,
+	pagInput *domain.PaginationInput,
+	vis *domain.CommentVisibility,
+) (*domain.Pagination[domain.DetailedComment], error) {
+	q := fmt.Sprintf(`
+  SELECT %s,
     EXISTS(SELECT * FROM comments_to_likes WHERE comment_id = c.id AND user_id = $2) AS "stats.liked",
     COUNT(DISTINCT cl.user_id) AS "stats.likes"
   FROM comments c
   JOIN users u ON c.author_id = u.id
   LEFT JOIN comments_to_likes cl ON c.id = cl.comment_id
-  WHERE parent_id = $1 GROUP BY c.id, u.id
-  `
+  WHERE parent_id = $1 AND (c.hidden_at IS NULL OR c.author_id = $2 OR $5::boolean)
+  GROUP BY c.id, u.id
+  ORDER BY c.created_at ASC, c.id ASC LIMIT $3 OFFSET $4
+  `, detailedCommentFields)
 
-	rows, err := repo.db.QueryxContext(ctx, q, commentID, userID)
+	limit := pagInput.PerPage
+	rows, err := repo.db.QueryxContext(
+		ctx, q, commentID, vis.ViewerID, limit, (pagInput.Page-1)*limit, vis.ShowHidden,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("CommentRepository.GetReplies.QueryxContext: %v", err)
 	}
+	defer rows.Close()
 
 	cmts, err := pgutils.ScanToStructSliceOf[domain.DetailedComment](rows)
 	if err != nil {
 		return nil, fmt.Errorf("CommentRepository.GetReplies.scanToStructSliceOf: %v", err)
 	}
 
+	pagination := &domain.Pagination[domain.DetailedComment]{
+		PaginationInput: *pagInput,
+		Items:           cmts,
+	}
+
+	countQuery := `SELECT COUNT(1) FROM comments c
+  WHERE parent_id = $1 AND (c.hidden_at IS NULL OR c.author_id = $2 OR $3::boolean)`
+	_ = repo.db.QueryRowxContext(ctx, countQuery, commentID, vis.ViewerID, vis.ShowHidden).Scan(&pagination.Total)
+
+	return pagination, nil
+}
+
+// GetThreads returns all the descendants of the given comments not deeper than maxDepth,
+// the replies of the same parent are ordered from the oldest.
+// The replies to the hidden comments are left out along with them
+func (repo *commentRepository) GetThreads(
+	ctx context.Context,
+	rootIDs []domain.ID,
+	maxDepth int,
+	vis *domain.CommentVisibility,
+) ([]domain.DetailedComment, error) {
+	if len(rootIDs) == 0 {
+		return []domain.DetailedComment{}, nil
+	}
+
+	q, args, err := sqlx.In(fmt.Sprintf(`
+  WITH RECURSIVE thread AS (
+    SELECT id FROM comments c
+    WHERE parent_id IN (?) AND depth <= ?
+      AND (c.hidden_at IS NULL OR c.author_id = ? OR ?::boolean)
+    UNION ALL
+    SELECT r.id FROM comments r JOIN thread t ON r.parent_id = t.id
+    WHERE r.depth <= ? AND (r.hidden_at IS NULL OR r.author_id = ? OR ?::boolean)
+  )
+  SELECT %s
+  FROM thread t
+  JOIN comments c ON c.id = t.id
+  JOIN users u ON c.author_id = u.id
+  ORDER BY c.depth, c.created_at ASC, c.id ASC
+  `, detailedCommentFields),
+		rootIDs, maxDepth, vis.ViewerID, vis.ShowHidden,
+		maxDepth, vis.ViewerID, vis.ShowHidden,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("CommentRepository.GetThreads.In: %v", err)
+	}
+
+	rows, err := repo.db.QueryxContext(ctx, repo.db.Rebind(q), args...)
+	if err != nil {
+		return nil, fmt.Errorf("CommentRepository.GetThreads.QueryxContext: %v", err)
+	}
+	defer rows.Close()
+
+	cmts, err := pgutils.ScanToStructSliceOf[domain.DetailedComment](rows)
+	if err != nil {
+		return nil, fmt.Errorf("CommentRepository.GetThreads.scanToStructSliceOf: %v", err)
+	}
+
 	return cmts, nil
 }
 
+// ReplaceMentions replaces the mentions of the comment with the users of the given usernames,
+// unknown usernames and the users blocked in any direction with the comment author are skipped
+func (repo *commentRepository) ReplaceMentions(
+	ctx context.Context,
+	commentID domain.ID,
+	usernames []string,
+) ([]domain.CommentMention, error) {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("CommentRepository.ReplaceMentions.BeginTxx: %v", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM comment_mentions WHERE comment_id = $1`, commentID); err != nil {
+		return nil, fmt.Errorf("CommentRepository.ReplaceMentions.Delete: %v", err)
+	}
+
+	mentions := make([]domain.CommentMention, 0, len(usernames))
+	if len(usernames) != 0 {
+		q, args, err := sqlx.In(`
+    WITH inserted AS (
+      INSERT INTO comment_mentions (comment_id, user_id)
+      SELECT c.id, u.id FROM comments c
+      JOIN users u ON u.username IN (?)
+      WHERE c.id = ? AND u.id NOT IN (SELECT id FROM blocked_user_ids(c.author_id))
+      RETURNING user_id
+    )
+    SELECT i.user_id, u.username FROM inserted i
+    JOIN users u ON u.id = i.user_id
+    ORDER BY u.username`, usernames, commentID)
+		if err != nil {
+			return nil, fmt.Errorf("CommentRepository.ReplaceMentions.In: %v", err)
+		}
+
+		if err := tx.SelectContext(ctx, &mentions, tx.Rebind(q), args...); err != nil {
+			return nil, fmt.Errorf("CommentRepository.ReplaceMentions.SelectContext: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("CommentRepository.ReplaceMentions.Commit: %v", err)
+	}
+
+	return mentions, nil
+}
+
+func (repo *commentRepository) GetMentions(
+	ctx context.Context,
+	commentIDs []domain.ID,
+) (map[domain.ID][]domain.CommentMention, error) {
+	mentions := make(map[domain.ID][]domain.CommentMention, len(commentIDs))
+	if len(commentIDs) == 0 {
+		return mentions, nil
+	}
+
+	q, args, err := sqlx.In(`
+  SELECT cm.comment_id, cm.user_id, u.username FROM comment_mentions cm
+  JOIN users u ON u.id = cm.user_id
+  WHERE cm.comment_id IN (?)
+  ORDER BY cm.created_at, u.username`, commentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("CommentRepository.GetMentions.In: %v", err)
+	}
+
+	rows, err := repo.db.QueryxContext(ctx, repo.db.Rebind(q), args...)
+	if err != nil {
+		return nil, fmt.Errorf("CommentRepository.GetMentions.QueryxContext: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var commentID domain.ID
+		var mention domain.CommentMention
+		if err := rows.Scan(&commentID, &mention.UserID, &mention.Username); err != nil {
+			return nil, fmt.Errorf("CommentRepository.GetMentions.Scan: %v", err)
+		}
+		mentions[commentID] = append(mentions[commentID], mention)
+	}
+
+	return mentions, rows.Err()
+}
+
 func (repo *commentRepository) LikeComment(ctx context.Context, commentID domain.ID, userID domain.ID) error {
 	q := `INSERT INTO comments_to_likes (comment_id, user_id) VALUES ($1, $2)`
 
@@ -208,3 +425,59 @@ func (repo *commentRepository) HasUserLikedComment(
 
 	return exists, nil
 }
+
+// Pin replaces the pinned comment of the image with the given one
+func (repo *commentRepository) Pin(ctx context.Context, imageID domain.ID, commentID domain.ID) error {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("CommentRepository.Pin.BeginTxx: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Concurrent pins on the same image are serialized by the image row lock,
+	// otherwise both could unpin and then conflict on the single pinned comment index
+	lockQuery := `SELECT 1 FROM images WHERE id = $1 FOR UPDATE`
+	if _, err := tx.ExecContext(ctx, lockQuery, imageID); err != nil {
+		return fmt.Errorf("CommentRepository.Pin.Lock: %v", err)
+	}
+
+	unpinQuery := `UPDATE comments SET pinned_at = NULL WHERE image_id = $1 AND pinned_at IS NOT NULL`
+	if _, err := tx.ExecContext(ctx, unpinQuery, imageID); err != nil {
+		return fmt.Errorf("CommentRepository.Pin.Unpin: %v", err)
+	}
+
+	pinQuery := `UPDATE comments SET pinned_at = CURRENT_TIMESTAMP WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, pinQuery, commentID); err != nil {
+		return fmt.Errorf("CommentRepository.Pin.ExecContext: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("CommentRepository.Pin.Commit: %v", err)
+	}
+
+	return nil
+}
+
+func (repo *commentRepository) Unpin(ctx context.Context, commentID domain.ID) error {
+	q := `UPDATE comments SET pinned_at = NULL WHERE id = $1`
+
+	if _, err := repo.db.ExecContext(ctx, q, commentID); err != nil {
+		return fmt.Errorf("CommentRepository.Unpin.ExecContext: %v", err)
+	}
+
+	return nil
+}
+
+// SetHidden also unpins the hidden comment
+func (repo *commentRepository) SetHidden(ctx context.Context, commentID domain.ID, hidden bool) error {
+	q := `UPDATE comments SET
+    hidden_at = CASE WHEN $2 THEN COALESCE(hidden_at, CURRENT_TIMESTAMP) END,
+    pinned_at = CASE WHEN $2 THEN NULL ELSE pinned_at END
+  WHERE id = $1`
+
+	if _, err := repo.db.ExecContext(ctx, q, commentID, hidden); err != nil {
+		return fmt.Errorf("CommentRepository.SetHidden.ExecContext: %v", err)
+	}
+
+	return nil
+}
@@ -2,9 +2,13 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
+	"github.com/pillowskiy/gopix/internal/repository/postgres/pgutils"
 	"github.com/pkg/errors"
 )
 
@@ -48,6 +52,9 @@ func (repo *followingRepository) Stats(
 	if executorID != nil {
 		followingQuery := `SELECT EXISTS(SELECT 1 FROM following WHERE follower_id = $1 AND followed_id = $2)`
 		_ = repo.db.QueryRowxContext(ctx, followingQuery, executorID, userID).Scan(&stats.IsFollowing)
+
+		requestedQuery := `SELECT EXISTS(SELECT 1 FROM follow_requests WHERE requester_id = $1 AND target_id = $2)`
+		_ = repo.db.QueryRowxContext(ctx, requestedQuery, executorID, userID).Scan(&stats.IsRequested)
 	}
 
 	countQuery := `
@@ -64,3 +71,331 @@ func (repo *followingRepository) Stats(
 
 	return stats, nil
 }
+
+func (repo *followingRepository) IsPrivate(ctx context.Context, userID domain.ID) (bool, error) {
+	q := `SELECT private FROM users WHERE id = $1`
+
+	var private bool
+	if err := repo.db.QueryRowxContext(ctx, q, userID).Scan(&private); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, repository.ErrNotFound
+		}
+		return false, errors.Wrap(err, "FollowingRepository.IsPrivate")
+	}
+
+	return private, nil
+}
+
+// CanViewAuthor reports whether the user is neither private for the viewer nor blocked with them
+func (repo *followingRepository) CanViewAuthor(
+	ctx context.Context, authorID domain.ID, viewerID *domain.ID,
+) (bool, error) {
+	q := `SELECT can_view_author($1, $2)`
+
+	var visible bool
+	if err := repo.db.QueryRowxContext(ctx, q, authorID, viewerID).Scan(&visible); err != nil {
+		return false, errors.Wrap(err, "FollowingRepository.CanViewAuthor")
+	}
+
+	return visible, nil
+}
+
+func (repo *followingRepository) HasRequested(ctx context.Context, requesterID, targetID domain.ID) (bool, error) {
+	q := `SELECT EXISTS(SELECT 1 FROM follow_requests WHERE requester_id = $1 AND target_id = $2)`
+
+	var requested bool
+	if err := repo.db.QueryRowxContext(ctx, q, requesterID, targetID).Scan(&requested); err != nil {
+		return false, errors.Wrap(err, "FollowingRepository.HasRequested")
+	}
+
+	return requested, nil
+}
+
+func (repo *followingRepository) RequestFollow(ctx context.Context, userID domain.ID, executorID domain.ID) error {
+	q := `INSERT INTO follow_requests (requester_id, target_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+	res, err := repo.db.ExecContext(ctx, q, executorID, userID)
+	if err != nil {
+		return errors.Wrap(err, "FollowingRepository.RequestFollow.ExecContext")
+	}
+
+	// A concurrent request has been stored first
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return repository.ErrAlreadyExists
+	}
+
+	return nil
+}
+
+func (repo *followingRepository) CancelRequest(ctx context.Context, userID domain.ID, executorID domain.ID) error {
+	q := `DELETE FROM follow_requests WHERE requester_id = $1 AND target_id = $2`
+
+	_, err := repo.db.ExecContext(ctx, q, executorID, userID)
+	return err
+}
+
+// ApproveRequest turns the pending request into the follow,
+// repository.ErrAlreadyExists is returned when there is no request, but the requester already follows the target
+func (repo *followingRepository) ApproveRequest(ctx context.Context, requesterID, targetID domain.ID) error {
+	q := `
+    WITH approved AS (
+      DELETE FROM follow_requests WHERE requester_id = $1 AND target_id = $2
+      RETURNING requester_id, target_id
+    ), followed AS (
+      INSERT INTO following (follower_id, followed_id)
+      SELECT requester_id, target_id FROM approved
+      ON CONFLICT DO NOTHING
+    )
+    SELECT
+      EXISTS(SELECT 1 FROM approved) AS approved,
+      EXISTS(SELECT 1 FROM following WHERE follower_id = $1 AND followed_id = $2) AS following`
+
+	var approved, following bool
+	if err := repo.db.QueryRowxContext(ctx, q, requesterID, targetID).Scan(&approved, &following); err != nil {
+		return errors.Wrap(err, "FollowingRepository.ApproveRequest.Scan")
+	}
+
+	switch {
+	case approved:
+		return nil
+	case following:
+		return repository.ErrAlreadyExists
+	default:
+		return repository.ErrNotFound
+	}
+}
+
+func (repo *followingRepository) DenyRequest(ctx context.Context, requesterID, targetID domain.ID) error {
+	q := `DELETE FROM follow_requests WHERE requester_id = $1 AND target_id = $2`
+
+	res, err := repo.db.ExecContext(ctx, q, requesterID, targetID)
+	if err != nil {
+		return errors.Wrap(err, "FollowingRepository.DenyRequest.ExecContext")
+	}
+
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (repo *followingRepository) FollowRequests(
+	ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput,
+) (*domain.Pagination[domain.FollowRequest], error) {
+	q := `
+    SELECT u.id, u.username, u.avatar_url, r.created_at AS requested_at
+    FROM follow_requests r
+    JOIN users u ON u.id = r.requester_id
+    WHERE r.target_id = $1
+    ORDER BY r.created_at DESC, u.id DESC
+    LIMIT $2 OFFSET $3`
+
+	limit := pagInput.PerPage
+	rows, err := repo.db.QueryxContext(ctx, q, userID, limit, (pagInput.Page-1)*limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "FollowingRepository.FollowRequests.QueryxContext")
+	}
+
+	requests, err := pgutils.ScanToStructSliceOf[domain.FollowRequest](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "FollowingRepository.FollowRequests.scanToStructSliceOf")
+	}
+
+	pagination := &domain.Pagination[domain.FollowRequest]{
+		PaginationInput: *pagInput,
+		Items:           requests,
+	}
+
+	countQuery := `SELECT COUNT(1) FROM follow_requests WHERE target_id = $1`
+	_ = repo.db.QueryRowxContext(ctx, countQuery, userID).Scan(&pagination.Total)
+
+	return pagination, nil
+}
+
+func (repo *followingRepository) Followers(
+	ctx context.Context, userID domain.ID, viewerID *domain.ID, input *domain.FollowListInput,
+) ([]domain.Follow, error) {
+	follows, err := repo.followList(ctx, "follower_id", "followed_id", userID, viewerID, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "FollowingRepository.Followers")
+	}
+
+	return follows, nil
+}
+
+func (repo *followingRepository) Following(
+	ctx context.Context, userID domain.ID, viewerID *domain.ID, input *domain.FollowListInput,
+) ([]domain.Follow, error) {
+	follows, err := repo.followList(ctx, "followed_id", "follower_id", userID, viewerID, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "FollowingRepository.Following")
+	}
+
+	return follows, nil
+}
+
+// followList lists the users joined by the userColumn of the follows where the ownerColumn is the userID,
+// newest follows go first
+func (repo *followingRepository) followList(
+	ctx context.Context,
+	userColumn, ownerColumn string,
+	userID domain.ID,
+	viewerID *domain.ID,
+	input *domain.FollowListInput,
+) ([]domain.Follow, error) {
+	q := fmt.Sprintf(`
+    SELECT
+      u.id, u.username, u.avatar_url, f.created_at AS followed_at,
+      EXISTS(SELECT 1 FROM following WHERE follower_id = $2 AND followed_id = u.id) AS is_following,
+      EXISTS(SELECT 1 FROM following WHERE follower_id = u.id AND followed_id = $2) AS follows_you
+    FROM following f
+    JOIN users u ON u.id = f.%s
+    WHERE f.%s = $1`, userColumn, ownerColumn)
+	args := []any{userID, viewerID}
+
+	if input.Query != "" {
+		// The query is matched literally, so % and _ don't act as wildcards
+		args = append(args, input.Query)
+		q += fmt.Sprintf(" AND strpos(lower(u.username), lower($%d)) > 0", len(args))
+	}
+
+	if input.Cursor != nil {
+		args = append(args, input.Cursor.FollowedAt, input.Cursor.UserID)
+		q += fmt.Sprintf(" AND (f.created_at, u.id) < ($%d, $%d)", len(args)-1, len(args))
+	}
+
+	args = append(args, input.Limit)
+	q += fmt.Sprintf(" ORDER BY f.created_at DESC, u.id DESC LIMIT $%d", len(args))
+
+	rows, err := repo.db.QueryxContext(ctx, q, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "QueryxContext")
+	}
+
+	follows, err := pgutils.ScanToStructSliceOf[domain.Follow](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "ScanToStructSliceOf")
+	}
+
+	return follows, nil
+}
+
+// MutualSuggestions ranks the accounts followed by the accounts the user follows,
+// the score is the share of the followings in common relative to the best candidate
+func (repo *followingRepository) MutualSuggestions(
+	ctx context.Context, userID domain.ID, limit int,
+) ([]domain.FollowSuggestion, error) {
+	suggestions, err := repo.suggestions(ctx, mutualSuggestionsQuery, userID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "FollowingRepository.MutualSuggestions")
+	}
+
+	return suggestions, nil
+}
+
+// LikedAuthorsSuggestions ranks the authors of the images the user liked,
+// the score is the number of likes relative to the best candidate
+func (repo *followingRepository) LikedAuthorsSuggestions(
+	ctx context.Context, userID domain.ID, limit int,
+) ([]domain.FollowSuggestion, error) {
+	suggestions, err := repo.suggestions(ctx, likedAuthorsSuggestionsQuery, userID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "FollowingRepository.LikedAuthorsSuggestions")
+	}
+
+	return suggestions, nil
+}
+
+// NewestLikedImages returns the newest images the user liked,
+// likes don't store the time they were made, so the image id is the closest ordering we have
+func (repo *followingRepository) NewestLikedImages(
+	ctx context.Context, userID domain.ID, limit int,
+) ([]domain.ID, error) {
+	q := `SELECT image_id FROM images_to_likes WHERE user_id = $1 ORDER BY image_id DESC LIMIT $2`
+
+	var imageIDs []domain.ID
+	if err := repo.db.SelectContext(ctx, &imageIDs, q, userID, limit); err != nil {
+		return nil, errors.Wrap(err, "FollowingRepository.NewestLikedImages")
+	}
+
+	return imageIDs, nil
+}
+
+// SuggestableAuthors maps the public images to their authors the user can be suggested to follow
+func (repo *followingRepository) SuggestableAuthors(
+	ctx context.Context, userID domain.ID, imageIDs []domain.ID,
+) (map[domain.ID]domain.ImageAuthor, error) {
+	authors := make(map[domain.ID]domain.ImageAuthor, len(imageIDs))
+	if len(imageIDs) == 0 {
+		return authors, nil
+	}
+
+	q, args, err := sqlx.In(`
+  SELECT i.id AS image_id, u.id, u.username, u.avatar_url FROM images i
+  JOIN users u ON u.id = i.author_id
+  WHERE i.id IN (?) AND i.access_level = 'public'::access_level
+    AND u.id NOT IN (SELECT id FROM unsuggestable_user_ids(?))`, imageIDs, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "FollowingRepository.SuggestableAuthors.In")
+	}
+
+	rows, err := repo.db.QueryxContext(ctx, repo.db.Rebind(q), args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "FollowingRepository.SuggestableAuthors.QueryxContext")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row struct {
+			ImageID domain.ID `db:"image_id"`
+			domain.ImageAuthor
+		}
+		if err := rows.StructScan(&row); err != nil {
+			return nil, errors.Wrap(err, "FollowingRepository.SuggestableAuthors.StructScan")
+		}
+		authors[row.ImageID] = row.ImageAuthor
+	}
+
+	return authors, errors.Wrap(rows.Err(), "FollowingRepository.SuggestableAuthors.Rows")
+}
+
+func (repo *followingRepository) suggestions(
+	ctx context.Context, q string, userID domain.ID, limit int,
+) ([]domain.FollowSuggestion, error) {
+	rows, err := repo.db.QueryxContext(ctx, q, userID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "QueryxContext")
+	}
+
+	suggestions, err := pgutils.ScanToStructSliceOf[domain.FollowSuggestion](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "ScanToStructSliceOf")
+	}
+
+	return suggestions, nil
+}
+
+const mutualSuggestionsQuery = `
+SELECT u.id, u.username, u.avatar_url, COUNT(1)::float / MAX(COUNT(1)) OVER () AS score
+FROM following f
+JOIN following ff ON ff.follower_id = f.followed_id
+JOIN users u ON u.id = ff.followed_id
+WHERE f.follower_id = $1
+  AND u.id NOT IN (SELECT id FROM unsuggestable_user_ids($1))
+GROUP BY u.id
+ORDER BY score DESC, u.id DESC
+LIMIT $2
+`
+
+const likedAuthorsSuggestionsQuery = `
+SELECT u.id, u.username, u.avatar_url, COUNT(1)::float / MAX(COUNT(1)) OVER () AS score
+FROM images_to_likes l
+JOIN images i ON i.id = l.image_id AND i.access_level = 'public'::access_level
+JOIN users u ON u.id = i.author_id
+WHERE l.user_id = $1
+  AND u.id NOT IN (SELECT id FROM unsuggestable_user_ids($1))
+GROUP BY u.id
+ORDER BY score DESC, u.id DESC
+LIMIT $2
+`
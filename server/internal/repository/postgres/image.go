@@ -99,6 +99,99 @@ func (r *imageRepository) FindMany(
 	return images, nil
 }
 
+// FindManyVisible keeps the order of the provided ids
+func (r *imageRepository) FindManyVisible(
+	ctx context.Context, ids []domain.ID, viewerID *domain.ID, pagInput *domain.PaginationInput,
+) (*domain.Pagination[domain.ImageWithMeta], error) {
+	limit := pagInput.PerPage
+	query, args, err := sqlx.In(
+		findManyVisibleImagesQuery, ids, viewerID, viewerID, ids, limit, (pagInput.Page-1)*limit,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "ImageRepository.FindManyVisible.In")
+	}
+	query = r.db.Rebind(query)
+
+	rows, err := r.ext(ctx).QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "ImageRepository.FindManyVisible.QueryxContext")
+	}
+	defer rows.Close()
+
+	images, err := pgutils.ScanToStructSliceOf[domain.ImageWithMeta](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "ImageRepository.FindManyVisible.scanToStructSliceOf")
+	}
+
+	pagination := &domain.Pagination[domain.ImageWithMeta]{
+		PaginationInput: *pagInput,
+		Items:           images,
+	}
+
+	countQuery, countArgs, err := sqlx.In(countManyVisibleImagesQuery, ids, viewerID, viewerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "ImageRepository.FindManyVisible.CountIn")
+	}
+	countQuery = r.db.Rebind(countQuery)
+	_ = r.ext(ctx).QueryRowxContext(ctx, countQuery, countArgs...).Scan(&pagination.Total)
+
+	return pagination, nil
+}
+
+func (r *imageRepository) Search(
+	ctx context.Context, query string, viewerID *domain.ID, pagInput *domain.PaginationInput,
+) (*domain.Pagination[domain.ImageWithMeta], error) {
+	limit := pagInput.PerPage
+	rows, err := r.ext(ctx).QueryxContext(
+		ctx, searchImagesQuery, query, viewerID, limit, (pagInput.Page-1)*limit,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "ImageRepository.Search.QueryxContext")
+	}
+	defer rows.Close()
+
+	images, err := pgutils.ScanToStructSliceOf[domain.ImageWithMeta](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "ImageRepository.Search.scanToStructSliceOf")
+	}
+
+	pagination := &domain.Pagination[domain.ImageWithMeta]{
+		PaginationInput: *pagInput,
+		Items:           images,
+	}
+
+	_ = r.ext(ctx).QueryRowxContext(ctx, countSearchImagesQuery, query, viewerID).Scan(&pagination.Total)
+
+	return pagination, nil
+}
+
+func (r *imageRepository) ListAfter(
+	ctx context.Context, afterID domain.ID, limit int, onlyMissing bool,
+) ([]domain.Image, error) {
+	rows, err := r.ext(ctx).QueryxContext(ctx, listReindexImagesQuery, afterID, limit, onlyMissing)
+	if err != nil {
+		return nil, errors.Wrap(err, "ImageRepository.ListAfter.QueryxContext")
+	}
+	defer rows.Close()
+
+	images, err := pgutils.ScanToStructSliceOf[domain.Image](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "ImageRepository.ListAfter.scanToStructSliceOf")
+	}
+
+	return images, nil
+}
+
+func (r *imageRepository) CountReindex(ctx context.Context, afterID domain.ID, onlyMissing bool) (int, error) {
+	var total int
+	err := r.ext(ctx).QueryRowxContext(ctx, countReindexImagesQuery, afterID, onlyMissing).Scan(&total)
+	if err != nil {
+		return 0, errors.Wrap(err, "ImageRepository.CountReindex.Scan")
+	}
+
+	return total, nil
+}
+
 func (r *imageRepository) Delete(ctx context.Context, id domain.ID) error {
 	if _, err := r.ext(ctx).ExecContext(ctx, deleteImageQuery, id); err != nil {
 		return err
@@ -159,10 +252,24 @@ func (r *imageRepository) Update(ctx context.Context, id domain.ID, image *domai
 	return img, nil
 }
 
+func (r *imageRepository) SetCommentsLocked(ctx context.Context, id domain.ID, locked bool) error {
+	res, err := r.ext(ctx).ExecContext(ctx, setCommentsLockedImageQuery, locked, id)
+	if err != nil {
+		return errors.Wrap(err, "imageRepository.SetCommentsLocked.ExecContext")
+	}
+
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
 func (r *imageRepository) Discover(
 	ctx context.Context,
 	pagInput *domain.PaginationInput,
 	sort domain.ImageSortMethod,
+	viewerID *domain.ID,
 ) (*domain.Pagination[domain.ImageWithMeta], error) {
 	sortQuery, ok := imagesSortQuery.SortQuery(string(sort))
 	if !ok {
@@ -184,12 +291,13 @@ func (r *imageRepository) Discover(
   JOIN images_analytics a ON a.image_id = i.id
   LEFT JOIN image_properties ip ON ip.image_id = i.id
   WHERE access_level = 'public'::access_level
+    AND i.author_id NOT IN (SELECT id FROM hidden_author_ids($3))
   GROUP BY i.id, u.id
   ORDER BY %s LIMIT $1 OFFSET $2
   `, sortQuery)
 
 	limit := pagInput.PerPage
-	rowx, err := r.ext(ctx).QueryxContext(ctx, q, limit, (pagInput.Page-1)*limit)
+	rowx, err := r.ext(ctx).QueryxContext(ctx, q, limit, (pagInput.Page-1)*limit, viewerID)
 	if err != nil {
 		return nil, errors.Wrap(err, "imageRepository.Discover.Queryx")
 	}
@@ -212,7 +320,7 @@ func (r *imageRepository) Discover(
 }
 
 func (r *imageRepository) Favorites(
-	ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput,
+	ctx context.Context, userID domain.ID, viewerID *domain.ID, pagInput *domain.PaginationInput,
 ) (*domain.Pagination[domain.ImageWithMeta], error) {
 	q := `
   SELECT
@@ -231,11 +339,12 @@ func (r *imageRepository) Favorites(
   JOIN images_analytics a ON a.image_id = i.id
   LEFT JOIN image_properties ip ON ip.image_id = i.id
   WHERE user_id = $1 AND access_level = 'public'::access_level
+    AND i.author_id NOT IN (SELECT id FROM hidden_author_ids($4))
   LIMIT $2 OFFSET $3
   `
 
 	limit := pagInput.PerPage
-	rowx, err := r.ext(ctx).QueryxContext(ctx, q, userID, limit, (pagInput.Page-1)*limit)
+	rowx, err := r.ext(ctx).QueryxContext(ctx, q, userID, limit, (pagInput.Page-1)*limit, viewerID)
 	if err != nil {
 		return nil, errors.Wrap(err, "imageRepository.Favorites.Queryx")
 	}
@@ -257,6 +366,20 @@ func (r *imageRepository) Favorites(
 	return pagination, nil
 }
 
+// CanViewAuthor reports whether the author is neither private for the viewer nor blocked with them
+func (r *imageRepository) CanViewAuthor(
+	ctx context.Context, authorID domain.ID, viewerID *domain.ID,
+) (bool, error) {
+	q := `SELECT can_view_author($1, $2)`
+
+	var visible bool
+	if err := r.ext(ctx).QueryRowxContext(ctx, q, authorID, viewerID).Scan(&visible); err != nil {
+		return false, errors.Wrap(err, "imageRepository.CanViewAuthor")
+	}
+
+	return visible, nil
+}
+
 func (r *imageRepository) States(ctx context.Context, imageID domain.ID, userID domain.ID) (*domain.ImageStates, error) {
 	states := new(domain.ImageStates)
 	rowx := r.ext(ctx).QueryRowxContext(ctx, statesImageQuery, imageID, userID)
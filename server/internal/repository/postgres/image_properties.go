@@ -31,6 +31,25 @@ func (repo *imagePropsRepository) Create(
 	return nil
 }
 
+func (repo *imagePropsRepository) Upsert(
+	ctx context.Context, imageID domain.ID, props *domain.ImageProperties,
+) error {
+	const q = `
+INSERT INTO image_properties (image_id, mime, ext, height, width) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (image_id) DO UPDATE SET
+  mime = EXCLUDED.mime,
+  ext = EXCLUDED.ext,
+  height = EXCLUDED.height,
+  width = EXCLUDED.width`
+
+	_, err := repo.ext(ctx).ExecContext(ctx, q, imageID, props.Mime, props.Ext, props.Height, props.Width)
+	if err != nil {
+		return errors.Wrap(err, "ImagePropertiesRepository.Upsert.ExecContext")
+	}
+
+	return nil
+}
+
 func (repo *imagePropsRepository) Delete(ctx context.Context, imageID domain.ID) error {
 	const q = `DELETE FROM image_properties WHERE image_id = $1`
 
@@ -26,7 +26,7 @@ SELECT
   COALESCE(a.views_count, 0) AS views,
   TO_JSON(COALESCE(
     ARRAY_AGG(
-      json_build_object('id', t.id, 'name', t.name)
+      json_build_object('id', t.id, 'name', t.name, 'pending', t.status = 'pending')
     ) FILTER (WHERE t.id IS NOT NULL),
     '{}'
   )) AS tags
@@ -62,7 +62,74 @@ FROM images i
 INNER JOIN users u ON i.author_id = u.id
 LEFT JOIN
   image_properties ip ON ip.image_id = i.id
-WHERE i.id IN(?);
+WHERE i.id IN(?)
+GROUP BY i.id, u.id;
+`
+
+const findManyVisibleImagesQuery = `
+SELECT
+  u.id AS "author.id",
+  u.username AS "author.username",
+  u.avatar_url AS "author.avatar_url",
+  MAX(ip.width) AS "properties.width",
+  MAX(ip.height) AS "properties.height",
+  MAX(ip.ext) AS "properties.ext",
+  MAX(ip.mime) AS "properties.mime",
+  i.*
+FROM images i
+INNER JOIN users u ON i.author_id = u.id
+LEFT JOIN
+  image_properties ip ON ip.image_id = i.id
+WHERE i.id IN(?) AND (i.access_level = 'public'::access_level OR i.author_id = ?)
+  AND i.author_id NOT IN (SELECT id FROM hidden_author_ids(?))
+GROUP BY i.id, u.id
+ORDER BY array_position(ARRAY[?]::BIGINT[], i.id)
+LIMIT ? OFFSET ?
+`
+
+const countManyVisibleImagesQuery = `
+SELECT COUNT(1) FROM images i
+WHERE i.id IN(?) AND (i.access_level = 'public'::access_level OR i.author_id = ?)
+  AND i.author_id NOT IN (SELECT id FROM hidden_author_ids(?))
+`
+
+// NOTE: The expression must match idx_images_search to use the index
+const searchImagesQuery = `
+SELECT
+  u.id AS "author.id",
+  u.username AS "author.username",
+  u.avatar_url AS "author.avatar_url",
+  MAX(ip.width) AS "properties.width",
+  MAX(ip.height) AS "properties.height",
+  MAX(ip.ext) AS "properties.ext",
+  MAX(ip.mime) AS "properties.mime",
+  i.*
+FROM images i
+INNER JOIN users u ON i.author_id = u.id
+LEFT JOIN
+  image_properties ip ON ip.image_id = i.id
+WHERE
+  to_tsvector('simple', COALESCE(i.title, '') || ' ' || COALESCE(i.description, ''))
+    @@ plainto_tsquery('simple', $1)
+  AND (i.access_level = 'public'::access_level OR i.author_id = $2)
+  AND i.author_id NOT IN (SELECT id FROM hidden_author_ids($2))
+GROUP BY i.id, u.id
+ORDER BY
+  ts_rank(
+    to_tsvector('simple', COALESCE(i.title, '') || ' ' || COALESCE(i.description, '')),
+    plainto_tsquery('simple', $1)
+  ) DESC,
+  i.uploaded_at DESC
+LIMIT $3 OFFSET $4
+`
+
+const countSearchImagesQuery = `
+SELECT COUNT(1) FROM images i
+WHERE
+  to_tsvector('simple', COALESCE(i.title, '') || ' ' || COALESCE(i.description, ''))
+    @@ plainto_tsquery('simple', $1)
+  AND (i.access_level = 'public'::access_level OR i.author_id = $2)
+  AND i.author_id NOT IN (SELECT id FROM hidden_author_ids($2))
 `
 
 const updateImageQuery = `
@@ -73,6 +140,8 @@ UPDATE images SET
   expires_at = COALESCE($4, expires_at)
 WHERE id = $5 RETURNING *`
 
+const setCommentsLockedImageQuery = `UPDATE images SET comments_locked = $1 WHERE id = $2`
+
 const statesImageQuery = `
 WITH params AS (SELECT $1::int AS image_id, $2::int AS user_id)
 SELECT 
@@ -90,3 +159,19 @@ SELECT
 `
 
 const hasLikeImageQuery = `SELECT EXISTS (SELECT 1 FROM images_to_likes WHERE image_id = $1 AND user_id = $2)`
+
+const listReindexImagesQuery = `
+SELECT i.* FROM images i
+LEFT JOIN image_properties ip ON ip.image_id = i.id
+WHERE i.id > $1
+  AND (NOT $3::boolean OR ip.image_id IS NULL OR ip.width = 0 OR ip.height = 0)
+ORDER BY i.id
+LIMIT $2
+`
+
+const countReindexImagesQuery = `
+SELECT COUNT(1) FROM images i
+LEFT JOIN image_properties ip ON ip.image_id = i.id
+WHERE i.id > $1
+  AND (NOT $2::boolean OR ip.image_id IS NULL OR ip.width = 0 OR ip.height = 0)
+`
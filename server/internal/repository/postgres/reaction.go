@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
+	"github.com/pillowskiy/gopix/internal/repository/postgres/pgutils"
+	"github.com/pillowskiy/gopix/pkg/batch"
+)
+
+type reactionCountRow struct {
+	TargetID domain.ID `db:"target_id"`
+	Emoji    string    `db:"emoji"`
+	Count    int       `db:"count"`
+}
+
+type reactionRepository struct {
+	db            *sqlx.DB
+	countsBatcher batch.Batcher[reactionBatchItem]
+}
+
+func NewReactionRepository(db *sqlx.DB) *reactionRepository {
+	repo := &reactionRepository{db: db}
+
+	repo.countsBatcher = batch.NewWithConfig(
+		batch.NewKGAggregator[reactionBatchItem](), repo.processCountsBatch, &reactionBatchConfig,
+	)
+	go repo.countsBatcher.Ticker(reactionBatchTickDuration)
+
+	return repo
+}
+
+// Add is idempotent, the counter is changed only when the reaction is new
+func (repo *reactionRepository) Add(ctx context.Context, reaction *domain.Reaction) error {
+	q := `INSERT INTO reactions (target_type, target_id, user_id, emoji)
+  VALUES($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+
+	res, err := repo.db.ExecContext(ctx, q, reaction.Target, reaction.TargetID, reaction.UserID, reaction.Emoji)
+	if err != nil {
+		return fmt.Errorf("ReactionRepository.Add.ExecContext: %v", err)
+	}
+
+	if affected, _ := res.RowsAffected(); affected > 0 {
+		repo.countsBatcher.Add(newReactionBatchItem(reaction.Target, reaction.TargetID, reaction.Emoji, 1))
+	}
+
+	return nil
+}
+
+// Replace leaves the reaction as the only one of the user on the target
+func (repo *reactionRepository) Replace(ctx context.Context, reaction *domain.Reaction) error {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("ReactionRepository.Replace.BeginTxx: %v", err)
+	}
+	defer tx.Rollback()
+
+	deleteQuery := `DELETE FROM reactions
+  WHERE target_type = $1 AND target_id = $2 AND user_id = $3 AND emoji != $4
+  RETURNING emoji`
+
+	var removed []string
+	err = tx.SelectContext(
+		ctx, &removed, deleteQuery, reaction.Target, reaction.TargetID, reaction.UserID, reaction.Emoji,
+	)
+	if err != nil {
+		return fmt.Errorf("ReactionRepository.Replace.Delete: %v", err)
+	}
+
+	insertQuery := `INSERT INTO reactions (target_type, target_id, user_id, emoji)
+  VALUES($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+
+	res, err := tx.ExecContext(
+		ctx, insertQuery, reaction.Target, reaction.TargetID, reaction.UserID, reaction.Emoji,
+	)
+	if err != nil {
+		return fmt.Errorf("ReactionRepository.Replace.Insert: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("ReactionRepository.Replace.Commit: %v", err)
+	}
+
+	for _, emoji := range removed {
+		repo.countsBatcher.Add(newReactionBatchItem(reaction.Target, reaction.TargetID, emoji, -1))
+	}
+
+	if affected, _ := res.RowsAffected(); affected > 0 {
+		repo.countsBatcher.Add(newReactionBatchItem(reaction.Target, reaction.TargetID, reaction.Emoji, 1))
+	}
+
+	return nil
+}
+
+func (repo *reactionRepository) Remove(ctx context.Context, reaction *domain.Reaction) error {
+	q := `DELETE FROM reactions
+  WHERE target_type = $1 AND target_id = $2 AND user_id = $3 AND emoji = $4`
+
+	res, err := repo.db.ExecContext(ctx, q, reaction.Target, reaction.TargetID, reaction.UserID, reaction.Emoji)
+	if err != nil {
+		return fmt.Errorf("ReactionRepository.Remove.ExecContext: %v", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("ReactionRepository.Remove.RowsAffected: %v", err)
+	}
+
+	if affected == 0 {
+		return repository.ErrNotFound
+	}
+
+	repo.countsBatcher.Add(newReactionBatchItem(reaction.Target, reaction.TargetID, reaction.Emoji, -1))
+	return nil
+}
+
+// Counts returns the counters of the emojis with the pending changes applied,
+// the targets and emojis without reactions are omitted
+func (repo *reactionRepository) Counts(
+	ctx context.Context,
+	target domain.ReactionTarget,
+	targetIDs []domain.ID,
+	emojis []string,
+	viewerID *domain.ID,
+) (map[domain.ID][]domain.ReactionCount, error) {
+	if len(targetIDs) == 0 || len(emojis) == 0 {
+		return make(map[domain.ID][]domain.ReactionCount), nil
+	}
+
+	q, args, err := sqlx.In(`SELECT target_id, emoji, count FROM reaction_counts
+  WHERE target_type = ? AND target_id IN (?) AND emoji IN (?)`, target, targetIDs, emojis)
+	if err != nil {
+		return nil, fmt.Errorf("ReactionRepository.Counts.In: %v", err)
+	}
+
+	rowx, err := repo.db.QueryxContext(ctx, repo.db.Rebind(q), args...)
+	if err != nil {
+		return nil, fmt.Errorf("ReactionRepository.Counts.QueryxContext: %v", err)
+	}
+	defer rowx.Close()
+
+	stored, err := pgutils.ScanToStructSliceOf[reactionCountRow](rowx)
+	if err != nil {
+		return nil, fmt.Errorf("ReactionRepository.Counts.ScanToStructSliceOf: %v", err)
+	}
+
+	counters := make(map[string]int, len(stored))
+	for _, s := range stored {
+		counters[reactionGroupKey(target, s.TargetID, s.Emoji)] = s.Count
+	}
+
+	reacted := make(map[string]bool)
+	if viewerID != nil {
+		q, args, err := sqlx.In(`SELECT target_id, emoji FROM reactions
+    WHERE target_type = ? AND target_id IN (?) AND user_id = ?`, target, targetIDs, *viewerID)
+		if err != nil {
+			return nil, fmt.Errorf("ReactionRepository.Counts.ReactedIn: %v", err)
+		}
+
+		rowx, err := repo.db.QueryxContext(ctx, repo.db.Rebind(q), args...)
+		if err != nil {
+			return nil, fmt.Errorf("ReactionRepository.Counts.ReactedQueryxContext: %v", err)
+		}
+		defer rowx.Close()
+
+		own, err := pgutils.ScanToStructSliceOf[reactionCountRow](rowx)
+		if err != nil {
+			return nil, fmt.Errorf("ReactionRepository.Counts.ReactedScan: %v", err)
+		}
+
+		for _, o := range own {
+			reacted[reactionGroupKey(target, o.TargetID, o.Emoji)] = true
+		}
+	}
+
+	counts := make(map[domain.ID][]domain.ReactionCount, len(targetIDs))
+	for _, id := range targetIDs {
+		for _, emoji := range emojis {
+			group := reactionGroupKey(target, id, emoji)
+			count := counters[group] + repo.countsBatcher.CountByGroup(group)
+			if count <= 0 {
+				continue
+			}
+
+			counts[id] = append(counts[id], domain.ReactionCount{
+				Emoji:   emoji,
+				Count:   count,
+				Reacted: reacted[group],
+			})
+		}
+	}
+
+	return counts, nil
+}
+
+func (repo *reactionRepository) GetUserReactions(
+	ctx context.Context,
+	target domain.ReactionTarget,
+	targetID domain.ID,
+	userID domain.ID,
+) ([]string, error) {
+	q := `SELECT emoji FROM reactions
+  WHERE target_type = $1 AND target_id = $2 AND user_id = $3
+  ORDER BY created_at`
+
+	emojis := make([]string, 0)
+	if err := repo.db.SelectContext(ctx, &emojis, q, target, targetID, userID); err != nil {
+		return nil, fmt.Errorf("ReactionRepository.GetUserReactions.SelectContext: %v", err)
+	}
+
+	return emojis, nil
+}
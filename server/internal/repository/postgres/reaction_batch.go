@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/pkg/batch"
+	"github.com/pkg/errors"
+
+	nanoid "github.com/matoous/go-nanoid/v2"
+)
+
+var reactionBatchConfig = batch.BatchConfig{Retries: 3, MaxSize: 1000}
+var reactionBatchTickDuration = time.Minute
+
+// reactionBatchItem is a single change of the reaction counter,
+// every change has its own key, so none of them are overwritten by the aggregator
+type reactionBatchItem struct {
+	Target   domain.ReactionTarget `db:"target_type"`
+	TargetID domain.ID             `db:"target_id"`
+	Emoji    string                `db:"emoji"`
+	Delta    int                   `db:"count"`
+	key      string
+}
+
+func newReactionBatchItem(target domain.ReactionTarget, targetID domain.ID, emoji string, delta int) reactionBatchItem {
+	return reactionBatchItem{
+		Target:   target,
+		TargetID: targetID,
+		Emoji:    emoji,
+		Delta:    delta,
+		key:      nanoid.Must(12),
+	}
+}
+
+func reactionGroupKey(target domain.ReactionTarget, targetID domain.ID, emoji string) string {
+	return fmt.Sprintf("%s:%v:%s", target, targetID, emoji)
+}
+
+func (i reactionBatchItem) Group() string {
+	return reactionGroupKey(i.Target, i.TargetID, i.Emoji)
+}
+
+func (i reactionBatchItem) Key() string {
+	return i.key
+}
+
+func (i reactionBatchItem) Count() int {
+	return i.Delta
+}
+
+func (r *reactionRepository) processCountsBatch(items []reactionBatchItem) error {
+	ctx, close := context.WithTimeout(context.Background(), batchingCtxTimeout)
+	defer close()
+
+	deltas := make(map[string]*reactionBatchItem, len(items))
+	counts := make([]*reactionBatchItem, 0, len(items))
+	for _, item := range items {
+		if agg, ok := deltas[item.Group()]; ok {
+			agg.Delta += item.Delta
+			continue
+		}
+
+		agg := item
+		deltas[item.Group()] = &agg
+		counts = append(counts, &agg)
+	}
+
+	changed := make([]reactionBatchItem, 0, len(counts))
+	for _, agg := range counts {
+		if agg.Delta != 0 {
+			changed = append(changed, *agg)
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	query := `
+    INSERT INTO reaction_counts (target_type, target_id, emoji, count)
+    VALUES(:target_type, :target_id, :emoji, :count)
+    ON CONFLICT (target_type, target_id, emoji)
+    DO UPDATE SET count = GREATEST(reaction_counts.count + EXCLUDED.count, 0);
+  `
+
+	query, params, err := r.db.BindNamed(query, changed)
+	if err != nil {
+		return errors.Wrap(err, "reactionRepository.processCountsBatch.Named")
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, params...); err != nil {
+		return errors.Wrap(err, "reactionRepository.processCountsBatch.ExecContext")
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository/postgres/pgutils"
+	"github.com/pkg/errors"
+)
+
+type restrictionRepository struct {
+	PostgresRepository
+}
+
+func NewRestrictionRepository(db *sqlx.DB) *restrictionRepository {
+	return &restrictionRepository{
+		PostgresRepository: PostgresRepository{db},
+	}
+}
+
+// Block also breaks the follows and the follow requests between the users in both directions
+func (repo *restrictionRepository) Block(ctx context.Context, blockerID, blockedID domain.ID) error {
+	return repo.DoInTransaction(ctx, func(ctx context.Context) error {
+		q := `INSERT INTO user_blocks (blocker_id, blocked_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+		if _, err := repo.ext(ctx).ExecContext(ctx, q, blockerID, blockedID); err != nil {
+			return errors.Wrap(err, "RestrictionRepository.Block.Insert")
+		}
+
+		unfollowQuery := `
+    DELETE FROM following
+    WHERE (follower_id = $1 AND followed_id = $2) OR (follower_id = $2 AND followed_id = $1)`
+		if _, err := repo.ext(ctx).ExecContext(ctx, unfollowQuery, blockerID, blockedID); err != nil {
+			return errors.Wrap(err, "RestrictionRepository.Block.Unfollow")
+		}
+
+		requestsQuery := `
+    DELETE FROM follow_requests
+    WHERE (requester_id = $1 AND target_id = $2) OR (requester_id = $2 AND target_id = $1)`
+		if _, err := repo.ext(ctx).ExecContext(ctx, requestsQuery, blockerID, blockedID); err != nil {
+			return errors.Wrap(err, "RestrictionRepository.Block.DeleteRequests")
+		}
+
+		return nil
+	})
+}
+
+func (repo *restrictionRepository) Unblock(ctx context.Context, blockerID, blockedID domain.ID) error {
+	q := `DELETE FROM user_blocks WHERE blocker_id = $1 AND blocked_id = $2`
+	if _, err := repo.ext(ctx).ExecContext(ctx, q, blockerID, blockedID); err != nil {
+		return errors.Wrap(err, "RestrictionRepository.Unblock")
+	}
+
+	return nil
+}
+
+func (repo *restrictionRepository) Mute(ctx context.Context, muterID, mutedID domain.ID) error {
+	q := `INSERT INTO user_mutes (muter_id, muted_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	if _, err := repo.ext(ctx).ExecContext(ctx, q, muterID, mutedID); err != nil {
+		return errors.Wrap(err, "RestrictionRepository.Mute")
+	}
+
+	return nil
+}
+
+func (repo *restrictionRepository) Unmute(ctx context.Context, muterID, mutedID domain.ID) error {
+	q := `DELETE FROM user_mutes WHERE muter_id = $1 AND muted_id = $2`
+	if _, err := repo.ext(ctx).ExecContext(ctx, q, muterID, mutedID); err != nil {
+		return errors.Wrap(err, "RestrictionRepository.Unmute")
+	}
+
+	return nil
+}
+
+func (repo *restrictionRepository) HasBlocked(ctx context.Context, blockerID, blockedID domain.ID) (bool, error) {
+	q := `SELECT EXISTS(SELECT 1 FROM user_blocks WHERE blocker_id = $1 AND blocked_id = $2)`
+
+	var blocked bool
+	if err := repo.ext(ctx).QueryRowxContext(ctx, q, blockerID, blockedID).Scan(&blocked); err != nil {
+		return false, errors.Wrap(err, "RestrictionRepository.HasBlocked")
+	}
+
+	return blocked, nil
+}
+
+// IsBlocked reports whether any of the users blocked the other one
+func (repo *restrictionRepository) IsBlocked(ctx context.Context, userID, otherID domain.ID) (bool, error) {
+	q := `SELECT EXISTS(SELECT 1 FROM blocked_user_ids($1) WHERE id = $2)`
+
+	var blocked bool
+	if err := repo.ext(ctx).QueryRowxContext(ctx, q, userID, otherID).Scan(&blocked); err != nil {
+		return false, errors.Wrap(err, "RestrictionRepository.IsBlocked")
+	}
+
+	return blocked, nil
+}
+
+// IsSilenced reports whether the actor is blocked by or for the user, or muted by the user
+func (repo *restrictionRepository) IsSilenced(ctx context.Context, userID, actorID domain.ID) (bool, error) {
+	q := `SELECT EXISTS(SELECT 1 FROM hidden_author_ids($1) WHERE id = $2)`
+
+	var silenced bool
+	if err := repo.ext(ctx).QueryRowxContext(ctx, q, userID, actorID).Scan(&silenced); err != nil {
+		return false, errors.Wrap(err, "RestrictionRepository.IsSilenced")
+	}
+
+	return silenced, nil
+}
+
+func (repo *restrictionRepository) Blocked(
+	ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput,
+) (*domain.Pagination[domain.RestrictedUser], error) {
+	pag, err := repo.restrictedUsers(ctx, "user_blocks", "blocker_id", "blocked_id", userID, pagInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "RestrictionRepository.Blocked")
+	}
+
+	return pag, nil
+}
+
+func (repo *restrictionRepository) Muted(
+	ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput,
+) (*domain.Pagination[domain.RestrictedUser], error) {
+	pag, err := repo.restrictedUsers(ctx, "user_mutes", "muter_id", "muted_id", userID, pagInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "RestrictionRepository.Muted")
+	}
+
+	return pag, nil
+}
+
+func (repo *restrictionRepository) restrictedUsers(
+	ctx context.Context,
+	table, ownerColumn, targetColumn string,
+	userID domain.ID,
+	pagInput *domain.PaginationInput,
+) (*domain.Pagination[domain.RestrictedUser], error) {
+	q := fmt.Sprintf(`
+  SELECT u.id, u.username, u.avatar_url, r.created_at AS restricted_at
+  FROM %[1]s r
+  JOIN users u ON u.id = r.%[3]s
+  WHERE r.%[2]s = $1
+  ORDER BY r.created_at DESC, u.id DESC
+  LIMIT $2 OFFSET $3`, table, ownerColumn, targetColumn)
+
+	limit := pagInput.PerPage
+	rows, err := repo.ext(ctx).QueryxContext(ctx, q, userID, limit, (pagInput.Page-1)*limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "QueryxContext")
+	}
+
+	users, err := pgutils.ScanToStructSliceOf[domain.RestrictedUser](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "scanToStructSliceOf")
+	}
+
+	pagination := &domain.Pagination[domain.RestrictedUser]{
+		PaginationInput: *pagInput,
+		Items:           users,
+	}
+
+	countQuery := fmt.Sprintf(`SELECT COUNT(1) FROM %s WHERE %s = $1`, table, ownerColumn)
+	_ = repo.ext(ctx).QueryRowxContext(ctx, countQuery, userID).Scan(&pagination.Total)
+
+	return pagination, nil
+}
@@ -3,6 +3,8 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pillowskiy/gopix/internal/domain"
@@ -11,6 +13,10 @@ import (
 	"github.com/pkg/errors"
 )
 
+var tagUsageSortQuery = pgutils.NewSortQueryBuilder().
+	AddField(string(domain.TagUsageTotalSort), pgutils.SortField{Field: "images_count", Order: pgutils.SortOrderDESC}).
+	AddField(string(domain.TagUsageGrowthSort), pgutils.SortField{Field: "recent_count", Order: pgutils.SortOrderDESC})
+
 type tagRepository struct {
 	PostgresRepository
 }
@@ -22,9 +28,12 @@ func NewTagRepository(db *sqlx.DB) *tagRepository {
 }
 
 func (repo *tagRepository) Create(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
-	q := `INSERT INTO tags (name) VALUES($1) ON CONFLICT (name) DO NOTHING RETURNING *`
+	q := `
+  INSERT INTO tags (name, status, proposer_id)
+  VALUES($1, COALESCE(NULLIF($2, '')::tag_status, 'approved'::tag_status), $3)
+  ON CONFLICT (name) DO NOTHING RETURNING *`
 
-	rowx := repo.ext(ctx).QueryRowxContext(ctx, q, tag.Name)
+	rowx := repo.ext(ctx).QueryRowxContext(ctx, q, tag.Name, tag.Status, tag.ProposerID)
 
 	createdTag := new(domain.Tag)
 	if err := rowx.StructScan(createdTag); err != nil {
@@ -70,8 +79,15 @@ func (repo *tagRepository) DeleteImageTag(ctx context.Context, tagID, imageID do
 	return err
 }
 
+// GetByName resolves the aliases to their canonical tags
 func (repo *tagRepository) GetByName(ctx context.Context, name string) (*domain.Tag, error) {
-	q := `SELECT * FROM tags WHERE name = $1`
+	q := `
+  SELECT t.* FROM tags t WHERE t.name = $1
+  UNION ALL
+  SELECT t.* FROM tag_aliases ta
+  JOIN tags t ON t.id = ta.tag_id
+  WHERE ta.alias = $1
+  LIMIT 1`
 
 	rowx := repo.ext(ctx).QueryRowxContext(ctx, q, name)
 
@@ -86,10 +102,17 @@ func (repo *tagRepository) GetByName(ctx context.Context, name string) (*domain.
 	return tag, nil
 }
 
-func (repo *tagRepository) Search(ctx context.Context, name string) ([]domain.Tag, error) {
-	q := `SELECT * FROM tags WHERE name LIKE $1 LIMIT 10`
+// Search matches both tag names and aliases, an alias match returns its canonical tag.
+// The pending tags are matched only for their proposer
+func (repo *tagRepository) Search(ctx context.Context, name string, viewerID *domain.ID) ([]domain.Tag, error) {
+	q := `
+  SELECT DISTINCT t.* FROM tags t
+  LEFT JOIN tag_aliases ta ON ta.tag_id = t.id
+  WHERE (t.name LIKE $1 OR ta.alias LIKE $1)
+    AND (t.status = 'approved'::tag_status OR t.proposer_id = $2)
+  LIMIT 10`
 
-	rows, err := repo.ext(ctx).QueryxContext(ctx, q, name)
+	rows, err := repo.ext(ctx).QueryxContext(ctx, q, name, viewerID)
 	if err != nil {
 		return nil, errors.Wrap(err, "TagRepository.Search.QueryxContext")
 	}
@@ -128,3 +151,482 @@ func (repo *tagRepository) Delete(ctx context.Context, id domain.ID) error {
 
 	return nil
 }
+
+func (repo *tagRepository) SetParent(ctx context.Context, id domain.ID, parentID *domain.ID) error {
+	q := `UPDATE tags SET parent_id = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`
+
+	res, err := repo.ext(ctx).ExecContext(ctx, q, parentID, id)
+	if err != nil {
+		return errors.Wrap(err, "TagRepository.SetParent.ExecContext")
+	}
+
+	if rowsAffected, err := res.RowsAffected(); err != nil || rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetAncestorIDs returns the ids of every tag above the given one in the hierarchy
+func (repo *tagRepository) GetAncestorIDs(ctx context.Context, id domain.ID) ([]domain.ID, error) {
+	q := `
+  WITH RECURSIVE ancestors AS (
+    SELECT parent_id AS id FROM tags WHERE id = $1 AND parent_id IS NOT NULL
+    UNION
+    SELECT t.parent_id FROM tags t
+    JOIN ancestors a ON t.id = a.id
+    WHERE t.parent_id IS NOT NULL
+  )
+  SELECT id FROM ancestors`
+
+	ids := make([]domain.ID, 0)
+	if err := sqlx.SelectContext(ctx, repo.ext(ctx), &ids, q, id); err != nil {
+		return nil, errors.Wrap(err, "TagRepository.GetAncestorIDs.SelectContext")
+	}
+
+	return ids, nil
+}
+
+func (repo *tagRepository) GetChildren(ctx context.Context, id domain.ID) ([]domain.Tag, error) {
+	q := `SELECT * FROM tags WHERE parent_id = $1 ORDER BY name`
+
+	rows, err := repo.ext(ctx).QueryxContext(ctx, q, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.GetChildren.QueryxContext")
+	}
+
+	tags, err := pgutils.ScanToStructSliceOf[domain.Tag](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.GetChildren.scanToStructSliceOf")
+	}
+
+	return tags, nil
+}
+
+func (repo *tagRepository) GetAliases(ctx context.Context, id domain.ID) ([]string, error) {
+	q := `SELECT alias FROM tag_aliases WHERE tag_id = $1 ORDER BY alias`
+
+	aliases := make([]string, 0)
+	if err := sqlx.SelectContext(ctx, repo.ext(ctx), &aliases, q, id); err != nil {
+		return nil, errors.Wrap(err, "TagRepository.GetAliases.SelectContext")
+	}
+
+	return aliases, nil
+}
+
+func (repo *tagRepository) AddAlias(ctx context.Context, id domain.ID, alias string) error {
+	q := `INSERT INTO tag_aliases (alias, tag_id) VALUES ($1, $2)`
+
+	if _, err := repo.ext(ctx).ExecContext(ctx, q, alias, id); err != nil {
+		return errors.Wrap(err, "TagRepository.AddAlias.ExecContext")
+	}
+
+	return nil
+}
+
+func (repo *tagRepository) RemoveAlias(ctx context.Context, id domain.ID, alias string) error {
+	q := `DELETE FROM tag_aliases WHERE alias = $1 AND tag_id = $2`
+
+	res, err := repo.ext(ctx).ExecContext(ctx, q, alias, id)
+	if err != nil {
+		return errors.Wrap(err, "TagRepository.RemoveAlias.ExecContext")
+	}
+
+	if rowsAffected, err := res.RowsAffected(); err != nil || rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetImages returns the images tagged with the tag or any of its descendants
+func (repo *tagRepository) GetImages(
+	ctx context.Context, id domain.ID, viewerID *domain.ID, pagInput *domain.PaginationInput,
+) (*domain.Pagination[domain.ImageWithMeta], error) {
+	limit := pagInput.PerPage
+	rows, err := repo.ext(ctx).QueryxContext(
+		ctx, tagImagesQuery, id, viewerID, limit, (pagInput.Page-1)*limit,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.GetImages.QueryxContext")
+	}
+	defer rows.Close()
+
+	images, err := pgutils.ScanToStructSliceOf[domain.ImageWithMeta](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.GetImages.scanToStructSliceOf")
+	}
+
+	pagination := &domain.Pagination[domain.ImageWithMeta]{
+		PaginationInput: *pagInput,
+		Items:           images,
+	}
+
+	_ = repo.ext(ctx).QueryRowxContext(ctx, countTagImagesQuery, id, viewerID).Scan(&pagination.Total)
+
+	return pagination, nil
+}
+
+func (repo *tagRepository) GetProposals(
+	ctx context.Context, pagInput *domain.PaginationInput,
+) (*domain.Pagination[domain.TagProposal], error) {
+	q := `
+  SELECT
+    t.*,
+    COALESCE(u.id, 0) AS "proposer.id",
+    COALESCE(u.username, '') AS "proposer.username",
+    COALESCE(u.avatar_url, '') AS "proposer.avatar_url",
+    (SELECT COUNT(1) FROM images_to_tags it WHERE it.tag_id = t.id) AS images_count
+  FROM tags t
+  LEFT JOIN users u ON u.id = t.proposer_id
+  WHERE t.status = 'pending'::tag_status
+  ORDER BY t.created_at, t.id
+  LIMIT $1 OFFSET $2`
+
+	limit := pagInput.PerPage
+	rows, err := repo.ext(ctx).QueryxContext(ctx, q, limit, (pagInput.Page-1)*limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.GetProposals.QueryxContext")
+	}
+
+	proposals, err := pgutils.ScanToStructSliceOf[domain.TagProposal](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.GetProposals.scanToStructSliceOf")
+	}
+
+	pagination := &domain.Pagination[domain.TagProposal]{
+		PaginationInput: *pagInput,
+		Items:           proposals,
+	}
+
+	countQuery := `SELECT COUNT(1) FROM tags WHERE status = 'pending'::tag_status`
+	_ = repo.ext(ctx).QueryRowxContext(ctx, countQuery).Scan(&pagination.Total)
+
+	return pagination, nil
+}
+
+func (repo *tagRepository) Approve(ctx context.Context, id domain.ID) error {
+	q := `
+  UPDATE tags SET status = 'approved'::tag_status, updated_at = CURRENT_TIMESTAMP
+  WHERE id = $1 AND status = 'pending'::tag_status`
+
+	res, err := repo.ext(ctx).ExecContext(ctx, q, id)
+	if err != nil {
+		return errors.Wrap(err, "TagRepository.Approve.ExecContext")
+	}
+
+	if rowsAffected, err := res.RowsAffected(); err != nil || rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// Reject deletes the pending tag along with its image relations
+func (repo *tagRepository) Reject(ctx context.Context, id domain.ID) error {
+	q := `DELETE FROM tags WHERE id = $1 AND status = 'pending'::tag_status`
+
+	res, err := repo.ext(ctx).ExecContext(ctx, q, id)
+	if err != nil {
+		return errors.Wrap(err, "TagRepository.Reject.ExecContext")
+	}
+
+	if rowsAffected, err := res.RowsAffected(); err != nil || rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// Merge moves the images, aliases and children of the tag to the target one and removes the tag.
+// The tag name is kept as an alias of the target, so it keeps resolving to the target
+func (repo *tagRepository) Merge(ctx context.Context, id domain.ID, targetID domain.ID) error {
+	// The target may already be on some of the images, these relations are skipped
+	// and removed along with the merged tag
+	moveImagesQuery := `
+  INSERT INTO images_to_tags (image_id, tag_id, created_at)
+  SELECT image_id, $2, created_at FROM images_to_tags WHERE tag_id = $1
+  ON CONFLICT (tag_id, image_id) DO NOTHING`
+	moveAliasesQuery := `UPDATE tag_aliases SET tag_id = $2 WHERE tag_id = $1`
+	moveChildrenQuery := `UPDATE tags SET parent_id = $2 WHERE parent_id = $1 AND id <> $2`
+	deleteQuery := `DELETE FROM tags WHERE id = $1 RETURNING name`
+	aliasQuery := `INSERT INTO tag_aliases (alias, tag_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+	return repo.DoInTransaction(ctx, func(ctx context.Context) error {
+		for _, q := range []string{moveImagesQuery, moveAliasesQuery, moveChildrenQuery} {
+			if _, err := repo.ext(ctx).ExecContext(ctx, q, id, targetID); err != nil {
+				return errors.Wrap(err, "TagRepository.Merge.ExecContext")
+			}
+		}
+
+		var name string
+		if err := repo.ext(ctx).QueryRowxContext(ctx, deleteQuery, id).Scan(&name); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return repository.ErrNotFound
+			}
+			return errors.Wrap(err, "TagRepository.Merge.Delete")
+		}
+
+		if _, err := repo.ext(ctx).ExecContext(ctx, aliasQuery, name, targetID); err != nil {
+			return errors.Wrap(err, "TagRepository.Merge.Alias")
+		}
+
+		return nil
+	})
+}
+
+// Rename changes the tag name, keepAlias leaves the previous name resolving to the tag
+func (repo *tagRepository) Rename(ctx context.Context, id domain.ID, name string, keepAlias bool) error {
+	renameQuery := `
+  UPDATE tags t SET name = $2, updated_at = CURRENT_TIMESTAMP
+  FROM (SELECT name FROM tags WHERE id = $1 FOR UPDATE) prev
+  WHERE t.id = $1
+  RETURNING prev.name`
+	// The tag may be renamed to one of its own aliases
+	dropAliasQuery := `DELETE FROM tag_aliases WHERE alias = $1 AND tag_id = $2`
+	aliasQuery := `INSERT INTO tag_aliases (alias, tag_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+	return repo.DoInTransaction(ctx, func(ctx context.Context) error {
+		if _, err := repo.ext(ctx).ExecContext(ctx, dropAliasQuery, name, id); err != nil {
+			return errors.Wrap(err, "TagRepository.Rename.DropAlias")
+		}
+
+		var prevName string
+		if err := repo.ext(ctx).QueryRowxContext(ctx, renameQuery, id, name).Scan(&prevName); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return repository.ErrNotFound
+			}
+			return errors.Wrap(err, "TagRepository.Rename.Update")
+		}
+
+		if keepAlias && prevName != name {
+			if _, err := repo.ext(ctx).ExecContext(ctx, aliasQuery, prevName, id); err != nil {
+				return errors.Wrap(err, "TagRepository.Rename.Alias")
+			}
+		}
+
+		return nil
+	})
+}
+
+func (repo *tagRepository) CountImages(ctx context.Context, id domain.ID) (int, error) {
+	q := `SELECT COUNT(1) FROM images_to_tags WHERE tag_id = $1`
+
+	var count int
+	if err := repo.ext(ctx).QueryRowxContext(ctx, q, id).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, "TagRepository.CountImages.Scan")
+	}
+
+	return count, nil
+}
+
+// GetUsage lists the approved tags with the number of their images in total and since the given time
+func (repo *tagRepository) GetUsage(
+	ctx context.Context,
+	since time.Time,
+	sort domain.TagUsageSortMethod,
+	pagInput *domain.PaginationInput,
+) (*domain.Pagination[domain.TagUsage], error) {
+	sortQuery, ok := tagUsageSortQuery.SortQuery(string(sort))
+	if !ok {
+		return nil, repository.ErrIncorrectInput
+	}
+
+	q := fmt.Sprintf(`
+  SELECT
+    t.*,
+    COUNT(it.image_id) AS images_count,
+    COUNT(it.image_id) FILTER (WHERE it.created_at >= $1) AS recent_count
+  FROM tags t
+  LEFT JOIN images_to_tags it ON it.tag_id = t.id
+  WHERE t.status = 'approved'::tag_status
+  GROUP BY t.id
+  ORDER BY %s, t.name
+  LIMIT $2 OFFSET $3`, sortQuery)
+
+	limit := pagInput.PerPage
+	rows, err := repo.ext(ctx).QueryxContext(ctx, q, since, limit, (pagInput.Page-1)*limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.GetUsage.QueryxContext")
+	}
+
+	usage, err := pgutils.ScanToStructSliceOf[domain.TagUsage](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.GetUsage.scanToStructSliceOf")
+	}
+
+	pagination := &domain.Pagination[domain.TagUsage]{
+		PaginationInput: *pagInput,
+		Items:           usage,
+	}
+
+	countQuery := `SELECT COUNT(1) FROM tags WHERE status = 'approved'::tag_status`
+	_ = repo.ext(ctx).QueryRowxContext(ctx, countQuery).Scan(&pagination.Total)
+
+	return pagination, nil
+}
+
+// GetUsageHistory returns the number of images tagged per day since the given time,
+// the days without new images are omitted
+func (repo *tagRepository) GetUsageHistory(
+	ctx context.Context, id domain.ID, since time.Time,
+) ([]domain.TagUsagePoint, error) {
+	q := `
+  SELECT DATE_TRUNC('day', created_at) AS date, COUNT(1) AS count
+  FROM images_to_tags
+  WHERE tag_id = $1 AND created_at >= $2
+  GROUP BY 1
+  ORDER BY 1`
+
+	rows, err := repo.ext(ctx).QueryxContext(ctx, q, id, since)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.GetUsageHistory.QueryxContext")
+	}
+
+	points, err := pgutils.ScanToStructSliceOf[domain.TagUsagePoint](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.GetUsageHistory.scanToStructSliceOf")
+	}
+
+	return points, nil
+}
+
+// ImagesTags returns the tag names of every image, images without tags are omitted
+func (repo *tagRepository) ImagesTags(ctx context.Context, imageIDs []domain.ID) (map[domain.ID][]string, error) {
+	return repo.imagesTags(ctx, imageIDs, false)
+}
+
+// PublicImagesTags is the same as ImagesTags, but skips the images that aren't public and the pending tags
+func (repo *tagRepository) PublicImagesTags(
+	ctx context.Context, imageIDs []domain.ID,
+) (map[domain.ID][]string, error) {
+	return repo.imagesTags(ctx, imageIDs, true)
+}
+
+// CooccurringTags ranks the approved tags used together with the image tags on the public images.
+// The score is the highest share of images with the image tag that also have the suggested one
+func (repo *tagRepository) CooccurringTags(
+	ctx context.Context, imageID domain.ID, limit int,
+) ([]domain.TagSuggestion, error) {
+	rows, err := repo.ext(ctx).QueryxContext(ctx, cooccurringTagsQuery, imageID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.CooccurringTags.QueryxContext")
+	}
+
+	suggestions, err := pgutils.ScanToStructSliceOf[domain.TagSuggestion](rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.CooccurringTags.scanToStructSliceOf")
+	}
+
+	return suggestions, nil
+}
+
+func (repo *tagRepository) imagesTags(
+	ctx context.Context, imageIDs []domain.ID, onlyPublic bool,
+) (map[domain.ID][]string, error) {
+	tags := make(map[domain.ID][]string, len(imageIDs))
+	if len(imageIDs) == 0 {
+		return tags, nil
+	}
+
+	q, args, err := sqlx.In(`
+  SELECT it.image_id, t.name FROM images_to_tags it
+  JOIN tags t ON t.id = it.tag_id
+  JOIN images i ON i.id = it.image_id
+  WHERE it.image_id IN (?)
+    AND (NOT ? OR (i.access_level = 'public'::access_level AND t.status = 'approved'::tag_status))
+  ORDER BY it.image_id, t.name`, imageIDs, onlyPublic)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.ImagesTags.In")
+	}
+
+	rows, err := repo.ext(ctx).QueryxContext(ctx, repo.db.Rebind(q), args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagRepository.ImagesTags.QueryxContext")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var imageID domain.ID
+		var name string
+		if err := rows.Scan(&imageID, &name); err != nil {
+			return nil, errors.Wrap(err, "TagRepository.ImagesTags.Scan")
+		}
+		tags[imageID] = append(tags[imageID], name)
+	}
+
+	return tags, errors.Wrap(rows.Err(), "TagRepository.ImagesTags.Rows")
+}
+
+const tagSubtreeCTE = `
+WITH RECURSIVE subtree AS (
+  SELECT t.id FROM tags t WHERE t.id = $1
+  UNION
+  SELECT c.id FROM tags c
+  JOIN subtree s ON c.parent_id = s.id
+)`
+
+const tagImagesQuery = tagSubtreeCTE + `
+SELECT
+  u.id AS "author.id",
+  u.username AS "author.username",
+  u.avatar_url AS "author.avatar_url",
+  MAX(ip.width) AS "properties.width",
+  MAX(ip.height) AS "properties.height",
+  MAX(ip.ext) AS "properties.ext",
+  MAX(ip.mime) AS "properties.mime",
+  i.*
+FROM images i
+INNER JOIN users u ON i.author_id = u.id
+LEFT JOIN image_properties ip ON ip.image_id = i.id
+WHERE
+  EXISTS (
+    SELECT 1 FROM images_to_tags it
+    JOIN subtree s ON s.id = it.tag_id
+    WHERE it.image_id = i.id
+  )
+  AND (i.access_level = 'public'::access_level OR i.author_id = $2)
+  AND i.author_id NOT IN (SELECT id FROM hidden_author_ids($2))
+GROUP BY i.id, u.id
+ORDER BY i.uploaded_at DESC, i.id
+LIMIT $3 OFFSET $4
+`
+
+const countTagImagesQuery = tagSubtreeCTE + `
+SELECT COUNT(1) FROM images i
+WHERE
+  EXISTS (
+    SELECT 1 FROM images_to_tags it
+    JOIN subtree s ON s.id = it.tag_id
+    WHERE it.image_id = i.id
+  )
+  AND (i.access_level = 'public'::access_level OR i.author_id = $2)
+  AND i.author_id NOT IN (SELECT id FROM hidden_author_ids($2))
+`
+
+// The single shared image doesn't say much, so the total is smoothed by one
+const cooccurringTagsQuery = `
+WITH source AS (
+  SELECT it.tag_id, COUNT(o.image_id) AS total
+  FROM images_to_tags it
+  JOIN images_to_tags o ON o.tag_id = it.tag_id
+  JOIN images i ON i.id = o.image_id AND i.access_level = 'public'::access_level
+  WHERE it.image_id = $1
+  GROUP BY it.tag_id
+), pairs AS (
+  SELECT a.tag_id AS source_id, b.tag_id, COUNT(1) AS together
+  FROM images_to_tags a
+  JOIN images_to_tags b ON b.image_id = a.image_id
+  JOIN images i ON i.id = a.image_id AND i.access_level = 'public'::access_level
+  WHERE a.tag_id IN (SELECT tag_id FROM source)
+    AND b.tag_id NOT IN (SELECT tag_id FROM source)
+  GROUP BY a.tag_id, b.tag_id
+)
+SELECT t.name, MAX(p.together::float / (s.total + 1)) AS score
+FROM pairs p
+JOIN source s ON s.tag_id = p.source_id
+JOIN tags t ON t.id = p.tag_id AND t.status = 'approved'::tag_status
+GROUP BY t.name
+ORDER BY score DESC, t.name
+LIMIT $2
+`
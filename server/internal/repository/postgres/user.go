@@ -83,6 +83,33 @@ func (r *userRepository) Update(ctx context.Context, id domain.ID, user *domain.
 	return u, nil
 }
 
+// SetPrivate approves all the pending follow requests when the user becomes public
+func (r *userRepository) SetPrivate(ctx context.Context, id domain.ID, private bool) error {
+	return r.DoInTransaction(ctx, func(ctx context.Context) error {
+		q := `UPDATE users SET private = $1 WHERE id = $2`
+		if _, err := r.ext(ctx).ExecContext(ctx, q, private, id); err != nil {
+			return fmt.Errorf("UserRepository.SetPrivate.Update: %v", err)
+		}
+
+		if private {
+			return nil
+		}
+
+		approveQuery := `
+    WITH approved AS (
+      DELETE FROM follow_requests WHERE target_id = $1 RETURNING requester_id, target_id
+    )
+    INSERT INTO following (follower_id, followed_id)
+    SELECT requester_id, target_id FROM approved
+    ON CONFLICT DO NOTHING`
+		if _, err := r.ext(ctx).ExecContext(ctx, approveQuery, id); err != nil {
+			return fmt.Errorf("UserRepository.SetPrivate.ApproveRequests: %v", err)
+		}
+
+		return nil
+	})
+}
+
 func (r *userRepository) SetPermissions(ctx context.Context, id domain.ID, permissions int) error {
 	q := `UPDATE users SET permissions = $1 WHERE id = $2`
 
@@ -8,6 +8,7 @@ import (
 var (
 	ErrNotFound       = errors.New("not found")
 	ErrIncorrectInput = errors.New("incorrect input")
+	ErrAlreadyExists  = errors.New("already exists")
 )
 
 type InTransactionalCall func(ctx context.Context) error
@@ -0,0 +1,90 @@
+package s3
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	manager "github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/pillowskiy/gopix/pkg/storage"
+)
+
+const (
+	archiveContentType = "application/zip"
+	defaultArchiveTTL  = 24 * time.Hour
+	// All the archives are stored under the prefix, the rest of the bucket is left alone
+	archivePrefix = "albums/"
+	// The limit of the keys in a single DeleteObjects request
+	maxDeleteBatch = 1000
+)
+
+type archiveStorage struct {
+	s3     *storage.S3
+	bucket string
+	urlTTL time.Duration
+}
+
+func NewArchiveStorage(s3 *storage.S3, bucket string, urlTTL time.Duration) *archiveStorage {
+	if urlTTL <= 0 {
+		urlTTL = defaultArchiveTTL
+	}
+	return &archiveStorage{s3: s3, bucket: bucket, urlTTL: urlTTL}
+}
+
+// PutArchive uploads the archive in parts, so its size doesn't have to be known in advance
+func (s *archiveStorage) PutArchive(ctx context.Context, key string, r io.Reader) error {
+	_, err := s.s3.Uploader.UploadWithContext(ctx, &manager.UploadInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        r,
+		ContentType: aws.String(archiveContentType),
+	})
+
+	return err
+}
+
+// ArchiveURL returns the presigned download URL which expires after the storage TTL
+func (s *archiveStorage) ArchiveURL(_ context.Context, key string) (string, error) {
+	req, _ := s.s3.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+
+	return req.Presign(s.urlTTL)
+}
+
+// DeleteExpiredArchives removes the archives whose download links have already expired
+func (s *archiveStorage) DeleteExpiredArchives(ctx context.Context) (int, error) {
+	expiredAt := time.Now().Add(-s.urlTTL)
+
+	var keys []*s3.ObjectIdentifier
+	err := s.s3.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(archivePrefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, obj := range page.Contents {
+			if obj.LastModified != nil && obj.LastModified.Before(expiredAt) {
+				keys = append(keys, &s3.ObjectIdentifier{Key: obj.Key})
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for start := 0; start < len(keys); start += maxDeleteBatch {
+		end := min(start+maxDeleteBatch, len(keys))
+		_, err := s.s3.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(s.bucket),
+			Delete: &s3.Delete{Objects: keys[start:end], Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return start, err
+		}
+	}
+
+	return len(keys), nil
+}
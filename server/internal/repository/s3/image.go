@@ -1,12 +1,17 @@
 package s3
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	manager "github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
 	"github.com/pillowskiy/gopix/pkg/storage"
 )
 
@@ -49,3 +54,43 @@ func (s *imageStorage) Delete(ctx context.Context, path string) error {
 	})
 	return err
 }
+
+// Get reads the whole object into memory, so the returned file can be read more than once
+func (s *imageStorage) Get(ctx context.Context, path string) (*domain.File, error) {
+	out, err := s.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(path),
+	})
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, repository.ErrNotFound
+		}
+		return nil, err
+	}
+	defer out.Body.Close()
+
+	data, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.File{Reader: bytes.NewReader(data), Size: int64(len(data))}, nil
+}
+
+// Stream returns the object body without buffering it, the caller must close it
+func (s *imageStorage) Stream(ctx context.Context, path string) (io.ReadCloser, error) {
+	out, err := s.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(path),
+	})
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return out.Body, nil
+}
@@ -2,55 +2,151 @@ package usecase
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	goErrors "errors"
+	"fmt"
 
 	"github.com/pillowskiy/gopix/internal/domain"
 	"github.com/pillowskiy/gopix/internal/repository"
+	"github.com/pillowskiy/gopix/pkg/batch"
+	"github.com/pillowskiy/gopix/pkg/logger"
 	"github.com/pkg/errors"
 )
 
 type AlbumRepository interface {
 	Create(ctx context.Context, album *domain.Album) (*domain.Album, error)
 	GetByID(ctx context.Context, albumID domain.ID) (*domain.Album, error)
-	GetByAuthorID(ctx context.Context, authorID domain.ID) ([]domain.DetailedAlbum, error)
+	GetByAuthorID(ctx context.Context, authorID domain.ID, viewerID *domain.ID) ([]domain.DetailedAlbum, error)
 	GetAlbumImages(
-		ctx context.Context, albumID domain.ID, pagInput *domain.PaginationInput,
+		ctx context.Context,
+		albumID domain.ID,
+		viewerID *domain.ID,
+		pagInput *domain.PaginationInput,
+		sort domain.AlbumImageSortMethod,
 	) (*domain.Pagination[domain.ImageWithMeta], error)
 	Delete(ctx context.Context, albumID domain.ID) error
 	Update(ctx context.Context, albumID domain.ID, album *domain.Album) (*domain.Album, error)
 
-	PutImage(ctx context.Context, albumID domain.ID, imageID domain.ID) error
+	SetShareToken(ctx context.Context, albumID domain.ID, token *string) error
+
+	PutImage(ctx context.Context, albumID domain.ID, imageID domain.ID, addedBy domain.ID) error
 	DeleteImage(ctx context.Context, albumID domain.ID, imageID domain.ID) error
+	ImageAddedBy(ctx context.Context, albumID domain.ID, imageID domain.ID) (*domain.ID, error)
+	MoveImage(ctx context.Context, albumID domain.ID, imageID domain.ID, position int) error
+	SetImagesOrder(ctx context.Context, albumID domain.ID, imageIDs []domain.ID) error
+	SetCover(ctx context.Context, albumID domain.ID, imageID *domain.ID) error
+
+	SetRule(ctx context.Context, albumID domain.ID, rule *domain.AlbumRule) error
+	GetRuleImages(
+		ctx context.Context,
+		rule *domain.AlbumRule,
+		similarIDs []domain.ID,
+		viewerID *domain.ID,
+		pagInput *domain.PaginationInput,
+		sort domain.AlbumImageSortMethod,
+	) (*domain.Pagination[domain.ImageWithMeta], error)
+
+	GetMember(ctx context.Context, albumID domain.ID, userID domain.ID) (*domain.AlbumMember, error)
+	GetMembers(ctx context.Context, albumID domain.ID) ([]domain.AlbumMember, error)
+	GetMemberships(ctx context.Context, userID domain.ID, authorID domain.ID) ([]domain.AlbumMember, error)
+	AddMember(ctx context.Context, member *domain.AlbumMember) error
+	UpdateMemberRole(ctx context.Context, albumID domain.ID, userID domain.ID, role domain.AlbumRole) error
+	AcceptMember(ctx context.Context, albumID domain.ID, userID domain.ID) error
+	RemoveMember(ctx context.Context, albumID domain.ID, userID domain.ID) error
+
+	Follow(ctx context.Context, albumID domain.ID, userID domain.ID) error
+	Unfollow(ctx context.Context, albumID domain.ID, userID domain.ID) error
+	IsFollowing(ctx context.Context, albumID domain.ID, userID domain.ID) (bool, error)
+	GetFollowed(ctx context.Context, userID domain.ID) ([]domain.DetailedAlbum, error)
+	GetFollowers(ctx context.Context, albumID domain.ID) ([]domain.User, error)
 }
 
 type AlbumAccessPolicy interface {
 	CanModify(user *domain.User, album *domain.Album) bool
+	CanView(user *domain.User, album *domain.Album, member *domain.AlbumMember, shareToken string) bool
+	CanPutImage(user *domain.User, album *domain.Album, member *domain.AlbumMember) bool
+	CanAttachImage(user *domain.User, image *domain.Image) bool
+	CanDeleteImage(user *domain.User, album *domain.Album, member *domain.AlbumMember, addedBy *domain.ID) bool
+	CanArrange(user *domain.User, album *domain.Album, member *domain.AlbumMember) bool
+	CanManageMember(user *domain.User, album *domain.Album, member *domain.AlbumMember, role domain.AlbumRole) bool
 }
 
 type AlbumImageUseCase interface {
 	GetByID(ctx context.Context, imageID domain.ID) (*domain.Image, error)
+	CanViewAuthor(ctx context.Context, authorID domain.ID, executor *domain.User) (bool, error)
+}
+
+type AlbumFeaturesUseCase interface {
+	Similar(ctx context.Context, imageID domain.ID) ([]domain.ID, error)
 }
 
+const smartAlbumCoverSize = 3
+
 type albumUseCase struct {
-	repo    AlbumRepository
-	acl     AlbumAccessPolicy
-	imageUC AlbumImageUseCase
+	repo       AlbumRepository
+	acl        AlbumAccessPolicy
+	imageUC    AlbumImageUseCase
+	featuresUC AlbumFeaturesUseCase
+	notifMng   NotificationManager
+	logger     logger.Logger
+	additions  batch.Batcher[albumAdditionItem]
 }
 
 func NewAlbumUseCase(
 	repo AlbumRepository,
 	acl AlbumAccessPolicy,
 	imageUC AlbumImageUseCase,
+	featuresUC AlbumFeaturesUseCase,
+	notifMng NotificationManager,
+	logger logger.Logger,
 ) *albumUseCase {
-	return &albumUseCase{repo: repo, acl: acl, imageUC: imageUC}
+	uc := &albumUseCase{
+		repo:       repo,
+		acl:        acl,
+		imageUC:    imageUC,
+		featuresUC: featuresUC,
+		notifMng:   notifMng,
+		logger:     logger,
+	}
+
+	uc.additions = batch.NewWithConfig(
+		batch.NewMapAggregator[albumAdditionItem](), uc.notifyFollowers, &albumAdditionsBatchConfig,
+	)
+	go uc.additions.Ticker(albumFollowersNotifyInterval)
+
+	return uc
 }
 
-func (uc *albumUseCase) Create(ctx context.Context, album *domain.Album) (*domain.Album, error) {
+// Create requires the rule for the smart albums only
+func (uc *albumUseCase) Create(
+	ctx context.Context, album *domain.Album, executor *domain.User,
+) (*domain.Album, error) {
+	if album.Kind == domain.AlbumKindSmart {
+		if err := uc.correctRule(ctx, album.Rule, executor); err != nil {
+			return nil, err
+		}
+	} else if album.Rule != nil {
+		return nil, ErrUnprocessable
+	}
+
 	return uc.repo.Create(ctx, album)
 }
 
-func (uc *albumUseCase) GetByAuthorID(ctx context.Context, authorID domain.ID) ([]domain.DetailedAlbum, error) {
-	album, err := uc.repo.GetByAuthorID(ctx, authorID)
+// GetByAuthorID lists only the albums the executor can see without a share token,
+// nothing is listed when the author is private for the executor or blocked with them
+func (uc *albumUseCase) GetByAuthorID(
+	ctx context.Context, authorID domain.ID, executor *domain.User,
+) ([]domain.DetailedAlbum, error) {
+	canView, err := uc.imageUC.CanViewAuthor(ctx, authorID, executor)
+	if err != nil {
+		return nil, errors.Wrap(err, "AlbumUseCase.GetByAuthorID.CanViewAuthor")
+	}
+	if !canView {
+		return nil, ErrForbidden
+	}
+
+	albums, err := uc.repo.GetByAuthorID(ctx, authorID, executorID(executor))
 	if err != nil {
 		if goErrors.Is(err, repository.ErrNotFound) {
 			return nil, ErrNotFound
@@ -59,7 +155,35 @@ func (uc *albumUseCase) GetByAuthorID(ctx context.Context, authorID domain.ID) (
 		return nil, errors.Wrap(err, "AlbumUseCase.GetByAuthorID")
 	}
 
-	return album, nil
+	memberships := make(map[domain.ID]*domain.AlbumMember)
+	if executor != nil && executor.ID != authorID {
+		members, err := uc.repo.GetMemberships(ctx, executor.ID, authorID)
+		if err != nil {
+			return nil, errors.Wrap(err, "AlbumUseCase.GetByAuthorID.GetMemberships")
+		}
+
+		for i := range members {
+			memberships[members[i].AlbumID] = &members[i]
+		}
+	}
+
+	visible := make([]domain.DetailedAlbum, 0, len(albums))
+	for _, album := range albums {
+		if !uc.acl.CanView(executor, &album.Album, memberships[album.ID], "") {
+			continue
+		}
+
+		if !uc.acl.CanModify(executor, &album.Album) {
+			album.ShareToken = nil
+		}
+
+		if album.Kind == domain.AlbumKindSmart {
+			album.Cover = uc.smartCover(ctx, album.Rule, executor)
+		}
+		visible = append(visible, album)
+	}
+
+	return visible, nil
 }
 
 func (uc *albumUseCase) GetByID(ctx context.Context, albumID domain.ID) (*domain.Album, error) {
@@ -76,13 +200,41 @@ func (uc *albumUseCase) GetByID(ctx context.Context, albumID domain.ID) (*domain
 }
 
 func (uc *albumUseCase) GetAlbumImages(
-	ctx context.Context, albumID domain.ID, pagInput *domain.PaginationInput,
+	ctx context.Context,
+	albumID domain.ID,
+	pagInput *domain.PaginationInput,
+	sort domain.AlbumImageSortMethod,
+	executor *domain.User,
+	shareToken string,
 ) (*domain.Pagination[domain.ImageWithMeta], error) {
-	if _, err := uc.GetByID(ctx, albumID); err != nil {
+	album, err := uc.GetByID(ctx, albumID)
+	if err != nil {
 		return nil, err
 	}
 
-	return uc.repo.GetAlbumImages(ctx, albumID, pagInput)
+	member, err := uc.executorMember(ctx, albumID, executor)
+	if err != nil {
+		return nil, err
+	}
+
+	if !uc.acl.CanView(executor, album, member, shareToken) {
+		return nil, ErrForbidden
+	}
+
+	if album.Kind == domain.AlbumKindSmart {
+		return uc.ruleImages(ctx, album.Rule, executor, pagInput, sort)
+	}
+
+	images, err := uc.repo.GetAlbumImages(ctx, albumID, executorID(executor), pagInput, sort)
+	if err != nil {
+		if goErrors.Is(err, repository.ErrIncorrectInput) {
+			return nil, ErrUnprocessable
+		}
+
+		return nil, errors.Wrap(err, "AlbumUseCase.GetAlbumImages")
+	}
+
+	return images, nil
 }
 
 func (uc *albumUseCase) Delete(ctx context.Context, albumID domain.ID, executor *domain.User) error {
@@ -99,41 +251,313 @@ func (uc *albumUseCase) Update(
 	album *domain.Album,
 	executor *domain.User,
 ) (*domain.Album, error) {
-	if err := uc.ExistsAndModifiable(ctx, executor, albumID); err != nil {
+	existing, err := uc.GetByID(ctx, albumID)
+	if err != nil {
 		return nil, err
 	}
 
-	return uc.repo.Update(ctx, albumID, album)
+	if !uc.acl.CanModify(executor, existing) {
+		return nil, ErrForbidden
+	}
+
+	if album.Rule != nil {
+		if existing.Kind != domain.AlbumKindSmart {
+			return nil, ErrUnprocessable
+		}
+
+		if err := uc.correctRule(ctx, album.Rule, executor); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := uc.repo.Update(ctx, albumID, album)
+	if err != nil {
+		return nil, err
+	}
+
+	updated.Rule = existing.Rule
+	if album.Rule != nil {
+		if err := uc.repo.SetRule(ctx, albumID, album.Rule); err != nil {
+			return nil, errors.Wrap(err, "AlbumUseCase.Update.SetRule")
+		}
+		updated.Rule = album.Rule
+	}
+
+	return updated, nil
+}
+
+// Share generates a new share token for the album, the previous one stops working
+func (uc *albumUseCase) Share(ctx context.Context, albumID domain.ID, executor *domain.User) (string, error) {
+	if err := uc.ExistsAndModifiable(ctx, executor, albumID); err != nil {
+		return "", err
+	}
+
+	token, err := generateShareToken()
+	if err != nil {
+		return "", errors.Wrap(err, "AlbumUseCase.Share.generateShareToken")
+	}
+
+	if err := uc.repo.SetShareToken(ctx, albumID, &token); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (uc *albumUseCase) RevokeShare(ctx context.Context, albumID domain.ID, executor *domain.User) error {
+	if err := uc.ExistsAndModifiable(ctx, executor, albumID); err != nil {
+		return err
+	}
+
+	return uc.repo.SetShareToken(ctx, albumID, nil)
 }
 
 func (uc *albumUseCase) PutImage(
 	ctx context.Context, albumID domain.ID, imageID domain.ID, executor *domain.User,
 ) error {
-	if err := uc.ExistsAndModifiable(ctx, executor, albumID); err != nil {
+	album, member, err := uc.getWithMember(ctx, albumID, executor)
+	if err != nil {
+		return err
+	}
+
+	if !uc.acl.CanPutImage(executor, album, member) {
+		return ErrForbidden
+	}
+
+	if album.Kind == domain.AlbumKindSmart {
+		return ErrUnprocessable
+	}
+
+	img, err := uc.correctImageRef(ctx, imageID, executor)
+	if err != nil {
 		return err
 	}
 
-	if err := uc.correctImageRef(ctx, imageID); err != nil {
+	if _, err := uc.repo.ImageAddedBy(ctx, albumID, imageID); err == nil {
+		return ErrAlreadyExists
+	} else if !goErrors.Is(err, repository.ErrNotFound) {
+		return errors.Wrap(err, "AlbumUseCase.PutImage.ImageAddedBy")
+	}
+
+	if err := uc.repo.PutImage(ctx, albumID, imageID, executor.ID); err != nil {
+		if goErrors.Is(err, repository.ErrAlreadyExists) {
+			return ErrAlreadyExists
+		}
 		return err
 	}
 
-	return uc.repo.PutImage(ctx, albumID, imageID)
+	uc.additions.Add(albumAdditionItem{
+		AlbumID: albumID,
+		AddedBy: executor.ID,
+		Public:  img.AccessLevel == domain.ImageAccessPublic,
+	})
+
+	return nil
 }
 
 func (uc *albumUseCase) DeleteImage(
 	ctx context.Context, albumID domain.ID, imageID domain.ID, executor *domain.User,
 ) error {
-	if err := uc.ExistsAndModifiable(ctx, executor, albumID); err != nil {
+	album, member, err := uc.getWithMember(ctx, albumID, executor)
+	if err != nil {
 		return err
 	}
 
-	if err := uc.correctImageRef(ctx, imageID); err != nil {
-		return err
+	if album.Kind == domain.AlbumKindSmart {
+		return ErrUnprocessable
+	}
+
+	addedBy, err := uc.repo.ImageAddedBy(ctx, albumID, imageID)
+	if err != nil {
+		if goErrors.Is(err, repository.ErrNotFound) {
+			return ErrIncorrectImageRef
+		}
+		return errors.Wrap(err, "AlbumUseCase.DeleteImage.ImageAddedBy")
+	}
+
+	if !uc.acl.CanDeleteImage(executor, album, member, addedBy) {
+		return ErrForbidden
 	}
 
 	return uc.repo.DeleteImage(ctx, albumID, imageID)
 }
 
+func (uc *albumUseCase) MoveImage(
+	ctx context.Context, albumID domain.ID, imageID domain.ID, position int, executor *domain.User,
+) error {
+	if err := uc.existsAndArrangeable(ctx, albumID, executor); err != nil {
+		return err
+	}
+
+	if err := uc.repo.MoveImage(ctx, albumID, imageID, position); err != nil {
+		if goErrors.Is(err, repository.ErrNotFound) {
+			return ErrIncorrectImageRef
+		}
+		return errors.Wrap(err, "AlbumUseCase.MoveImage")
+	}
+
+	return nil
+}
+
+// SetImagesOrder replaces the manual order, imageIDs must contain every album image exactly once
+func (uc *albumUseCase) SetImagesOrder(
+	ctx context.Context, albumID domain.ID, imageIDs []domain.ID, executor *domain.User,
+) error {
+	if err := uc.existsAndArrangeable(ctx, albumID, executor); err != nil {
+		return err
+	}
+
+	if err := uc.repo.SetImagesOrder(ctx, albumID, imageIDs); err != nil {
+		if goErrors.Is(err, repository.ErrIncorrectInput) {
+			return ErrUnprocessable
+		}
+		return errors.Wrap(err, "AlbumUseCase.SetImagesOrder")
+	}
+
+	return nil
+}
+
+// SetCover selects the album cover among its images, nil imageID resets it to the default one
+func (uc *albumUseCase) SetCover(
+	ctx context.Context, albumID domain.ID, imageID *domain.ID, executor *domain.User,
+) error {
+	if err := uc.existsAndArrangeable(ctx, albumID, executor); err != nil {
+		return err
+	}
+
+	if imageID != nil {
+		if _, err := uc.repo.ImageAddedBy(ctx, albumID, *imageID); err != nil {
+			if goErrors.Is(err, repository.ErrNotFound) {
+				return ErrIncorrectImageRef
+			}
+			return errors.Wrap(err, "AlbumUseCase.SetCover.ImageAddedBy")
+		}
+	}
+
+	return uc.repo.SetCover(ctx, albumID, imageID)
+}
+
+func (uc *albumUseCase) GetMembers(
+	ctx context.Context, albumID domain.ID, executor *domain.User,
+) ([]domain.AlbumMember, error) {
+	album, member, err := uc.getWithMember(ctx, albumID, executor)
+	if err != nil {
+		return nil, err
+	}
+
+	if !uc.acl.CanView(executor, album, member, "") {
+		return nil, ErrForbidden
+	}
+
+	return uc.repo.GetMembers(ctx, albumID)
+}
+
+// InviteMember adds a pending member, the membership takes effect once the invitation is accepted
+func (uc *albumUseCase) InviteMember(
+	ctx context.Context, albumID domain.ID, userID domain.ID, role domain.AlbumRole, executor *domain.User,
+) error {
+	album, member, err := uc.getWithMember(ctx, albumID, executor)
+	if err != nil {
+		return err
+	}
+
+	if !uc.acl.CanManageMember(executor, album, member, role) {
+		return ErrForbidden
+	}
+
+	if userID == album.AuthorID {
+		return ErrUnprocessable
+	}
+
+	if _, err := uc.repo.GetMember(ctx, albumID, userID); err == nil {
+		return ErrAlreadyExists
+	} else if !goErrors.Is(err, repository.ErrNotFound) {
+		return errors.Wrap(err, "AlbumUseCase.InviteMember.GetMember")
+	}
+
+	invitation := &domain.AlbumMember{
+		AlbumID:   albumID,
+		UserID:    userID,
+		Role:      role,
+		InvitedBy: &executor.ID,
+	}
+	if err := uc.repo.AddMember(ctx, invitation); err != nil {
+		if goErrors.Is(err, repository.ErrNotFound) {
+			return ErrIncorrectUserRef
+		}
+		return errors.Wrap(err, "AlbumUseCase.InviteMember.AddMember")
+	}
+
+	_ = uc.notifMng.Notify(ctx, userID, &domain.Notification{
+		Title: "Album invitation",
+		Message: fmt.Sprintf(
+			"%s invited you to the album \"%s\" as %s. Accept the invitation of album %s to join it",
+			executor.Username, album.Name, role, albumID.String(),
+		),
+		ActorID: &executor.ID,
+	})
+
+	return nil
+}
+
+func (uc *albumUseCase) UpdateMemberRole(
+	ctx context.Context, albumID domain.ID, userID domain.ID, role domain.AlbumRole, executor *domain.User,
+) error {
+	album, member, err := uc.getWithMember(ctx, albumID, executor)
+	if err != nil {
+		return err
+	}
+
+	target, err := uc.getMember(ctx, albumID, userID)
+	if err != nil {
+		return err
+	}
+
+	// Both the current and the new role must be manageable by the executor
+	canManage := uc.acl.CanManageMember(executor, album, member, target.Role) &&
+		uc.acl.CanManageMember(executor, album, member, role)
+	if !canManage {
+		return ErrForbidden
+	}
+
+	return uc.repo.UpdateMemberRole(ctx, albumID, userID, role)
+}
+
+// RemoveMember also lets members leave the album or decline the invitation
+func (uc *albumUseCase) RemoveMember(
+	ctx context.Context, albumID domain.ID, userID domain.ID, executor *domain.User,
+) error {
+	album, member, err := uc.getWithMember(ctx, albumID, executor)
+	if err != nil {
+		return err
+	}
+
+	target, err := uc.getMember(ctx, albumID, userID)
+	if err != nil {
+		return err
+	}
+
+	isSelf := executor.ID == userID
+	if !isSelf && !uc.acl.CanManageMember(executor, album, member, target.Role) {
+		return ErrForbidden
+	}
+
+	return uc.repo.RemoveMember(ctx, albumID, userID)
+}
+
+func (uc *albumUseCase) AcceptInvitation(ctx context.Context, albumID domain.ID, executor *domain.User) error {
+	member, err := uc.getMember(ctx, albumID, executor.ID)
+	if err != nil {
+		return err
+	}
+
+	if member.Accepted {
+		return ErrAlreadyExists
+	}
+
+	return uc.repo.AcceptMember(ctx, albumID, executor.ID)
+}
+
 func (uc *albumUseCase) ExistsAndModifiable(
 	ctx context.Context, user *domain.User, albumID domain.ID,
 ) error {
@@ -149,13 +573,172 @@ func (uc *albumUseCase) ExistsAndModifiable(
 	return nil
 }
 
-func (uc *albumUseCase) correctImageRef(ctx context.Context, imageID domain.ID) error {
+func (uc *albumUseCase) existsAndArrangeable(
+	ctx context.Context, albumID domain.ID, executor *domain.User,
+) error {
+	album, member, err := uc.getWithMember(ctx, albumID, executor)
+	if err != nil {
+		return err
+	}
+
+	if !uc.acl.CanArrange(executor, album, member) {
+		return ErrForbidden
+	}
+
+	// Smart albums have neither the manual order nor the explicit cover
+	if album.Kind == domain.AlbumKindSmart {
+		return ErrUnprocessable
+	}
+
+	return nil
+}
+
+func (uc *albumUseCase) ruleImages(
+	ctx context.Context,
+	rule *domain.AlbumRule,
+	executor *domain.User,
+	pagInput *domain.PaginationInput,
+	sort domain.AlbumImageSortMethod,
+) (*domain.Pagination[domain.ImageWithMeta], error) {
+	if rule == nil {
+		return &domain.Pagination[domain.ImageWithMeta]{
+			PaginationInput: *pagInput,
+			Items:           []domain.ImageWithMeta{},
+		}, nil
+	}
+
+	var similarIDs []domain.ID
+	if rule.SimilarTo != nil {
+		ids, err := uc.featuresUC.Similar(ctx, *rule.SimilarTo)
+		if err != nil {
+			return nil, errors.Wrap(err, "AlbumUseCase.ruleImages.Similar")
+		}
+		similarIDs = ids
+	}
+
+	images, err := uc.repo.GetRuleImages(ctx, rule, similarIDs, executorID(executor), pagInput, sort)
+	if err != nil {
+		if goErrors.Is(err, repository.ErrIncorrectInput) {
+			return nil, ErrUnprocessable
+		}
+
+		return nil, errors.Wrap(err, "AlbumUseCase.ruleImages")
+	}
+
+	return images, nil
+}
+
+// smartCover is best effort, the album is still listed when its rule can't be evaluated
+func (uc *albumUseCase) smartCover(
+	ctx context.Context, rule *domain.AlbumRule, executor *domain.User,
+) []domain.Image {
+	pagInput := &domain.PaginationInput{Page: 1, PerPage: smartAlbumCoverSize}
+
+	images, err := uc.ruleImages(ctx, rule, executor, pagInput, domain.AlbumImageManualSort)
+	if err != nil {
+		return []domain.Image{}
+	}
+
+	cover := make([]domain.Image, 0, len(images.Items))
+	for _, img := range images.Items {
+		cover = append(cover, img.Image)
+	}
+
+	return cover
+}
+
+func (uc *albumUseCase) correctRule(ctx context.Context, rule *domain.AlbumRule, executor *domain.User) error {
+	if rule == nil || rule.IsEmpty() || rule.MinLikes < 0 {
+		return ErrUnprocessable
+	}
+
+	if rule.From != nil && rule.To != nil && !rule.From.Before(*rule.To) {
+		return ErrUnprocessable
+	}
+
+	if rule.SimilarTo != nil {
+		_, err := uc.correctImageRef(ctx, *rule.SimilarTo, executor)
+		return err
+	}
+
+	return nil
+}
+
+func (uc *albumUseCase) getWithMember(
+	ctx context.Context, albumID domain.ID, executor *domain.User,
+) (*domain.Album, *domain.AlbumMember, error) {
+	album, err := uc.GetByID(ctx, albumID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member, err := uc.executorMember(ctx, albumID, executor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return album, member, nil
+}
+
+// executorMember returns nil when the executor is anonymous or not a member of the album
+func (uc *albumUseCase) executorMember(
+	ctx context.Context, albumID domain.ID, executor *domain.User,
+) (*domain.AlbumMember, error) {
+	if executor == nil {
+		return nil, nil
+	}
+
+	member, err := uc.repo.GetMember(ctx, albumID, executor.ID)
+	if err != nil {
+		if goErrors.Is(err, repository.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "AlbumUseCase.executorMember")
+	}
+
+	return member, nil
+}
+
+func (uc *albumUseCase) getMember(
+	ctx context.Context, albumID domain.ID, userID domain.ID,
+) (*domain.AlbumMember, error) {
+	member, err := uc.repo.GetMember(ctx, albumID, userID)
+	if err != nil {
+		if goErrors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrap(err, "AlbumUseCase.getMember")
+	}
+
+	return member, nil
+}
+
+func (uc *albumUseCase) correctImageRef(
+	ctx context.Context, imageID domain.ID, executor *domain.User,
+) (*domain.Image, error) {
 	img, err := uc.imageUC.GetByID(ctx, imageID)
 
-	isValidImage := img != nil && img.AccessLevel == domain.ImageAccessPublic
+	isValidImage := img != nil && uc.acl.CanAttachImage(executor, img)
 	if err != nil || !isValidImage {
-		return ErrIncorrectImageRef
+		return nil, ErrIncorrectImageRef
 	}
 
-	return nil
+	return img, nil
+}
+
+func executorID(executor *domain.User) *domain.ID {
+	if executor == nil {
+		return nil
+	}
+
+	return &executor.ID
+}
+
+func generateShareToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
 }
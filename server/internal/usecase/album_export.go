@@ -0,0 +1,311 @@
+package usecase
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
+	"github.com/pillowskiy/gopix/pkg/logger"
+	"github.com/pillowskiy/gopix/pkg/worker"
+	"github.com/pkg/errors"
+)
+
+const (
+	albumExportPageSize = 100
+	// Larger albums have to be exported asynchronously
+	syncAlbumExportLimit  = 500
+	asyncAlbumExportLimit = 10000
+
+	albumExportQueueSize = 16
+	albumExportTimeout   = 30 * time.Minute
+	albumExportManifest  = "manifest.json"
+	// The archives are removed once their download links have expired
+	albumArchiveCleanupInterval = time.Hour
+)
+
+type AlbumExportAlbumUseCase interface {
+	GetByID(ctx context.Context, albumID domain.ID) (*domain.Album, error)
+	GetAlbumImages(
+		ctx context.Context,
+		albumID domain.ID,
+		pagInput *domain.PaginationInput,
+		sort domain.AlbumImageSortMethod,
+		executor *domain.User,
+		shareToken string,
+	) (*domain.Pagination[domain.ImageWithMeta], error)
+}
+
+type AlbumExportTagRepository interface {
+	ImagesTags(ctx context.Context, imageIDs []domain.ID) (map[domain.ID][]string, error)
+}
+
+type AlbumExportImageStorage interface {
+	Stream(ctx context.Context, path string) (io.ReadCloser, error)
+}
+
+type AlbumArchiveStorage interface {
+	PutArchive(ctx context.Context, key string, r io.Reader) error
+	ArchiveURL(ctx context.Context, key string) (string, error)
+	DeleteExpiredArchives(ctx context.Context) (int, error)
+}
+
+type albumExportTask struct {
+	export   *domain.AlbumExport
+	executor *domain.User
+}
+
+type albumExportUseCase struct {
+	albumUC      AlbumExportAlbumUseCase
+	tagRepo      AlbumExportTagRepository
+	imageStorage AlbumExportImageStorage
+	archives     AlbumArchiveStorage
+	notifMng     NotificationManager
+	logger       logger.Logger
+	wrk          *worker.Worker[albumExportTask]
+}
+
+// NewAlbumExportUseCase creates the album export use case,
+// without the archive storage only the streamed export is available
+func NewAlbumExportUseCase(
+	albumUC AlbumExportAlbumUseCase,
+	tagRepo AlbumExportTagRepository,
+	imageStorage AlbumExportImageStorage,
+	archives AlbumArchiveStorage,
+	notifMng NotificationManager,
+	logger logger.Logger,
+) *albumExportUseCase {
+	uc := &albumExportUseCase{
+		albumUC:      albumUC,
+		tagRepo:      tagRepo,
+		imageStorage: imageStorage,
+		archives:     archives,
+		notifMng:     notifMng,
+		logger:       logger,
+		wrk:          worker.NewWorker[albumExportTask](albumExportQueueSize),
+	}
+	go uc.wrk.Handle(uc.handleExportTask)
+	if archives != nil {
+		go uc.cleanupArchives()
+	}
+
+	return uc
+}
+
+func (uc *albumExportUseCase) cleanupArchives() {
+	ticker := time.NewTicker(albumArchiveCleanupInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := uc.DeleteExpiredArchives(context.Background()); err != nil {
+			uc.logger.Errorf("AlbumExportUseCase.cleanupArchives: %v", err)
+		}
+	}
+}
+
+// DeleteExpiredArchives removes the stored archives nobody can download anymore
+func (uc *albumExportUseCase) DeleteExpiredArchives(ctx context.Context) error {
+	deleted, err := uc.archives.DeleteExpiredArchives(ctx)
+	if deleted > 0 {
+		uc.logger.Infof("AlbumExportUseCase.DeleteExpiredArchives: %d archives deleted", deleted)
+	}
+
+	return errors.Wrap(err, "AlbumExportUseCase.DeleteExpiredArchives")
+}
+
+// Export collects the manifest of the album small enough to be streamed right away
+func (uc *albumExportUseCase) Export(
+	ctx context.Context, albumID domain.ID, executor *domain.User, shareToken string,
+) (*domain.AlbumExport, error) {
+	return uc.collect(ctx, albumID, executor, shareToken, syncAlbumExportLimit)
+}
+
+// WriteArchive streams the images one by one into the zip, the manifest is written last,
+// so it can mark the images missing in the storage
+func (uc *albumExportUseCase) WriteArchive(ctx context.Context, export *domain.AlbumExport, w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	for i := range export.Images {
+		if err := uc.writeImage(ctx, zw, &export.Images[i]); err != nil {
+			return errors.Wrap(err, "AlbumExportUseCase.WriteArchive.writeImage")
+		}
+	}
+
+	manifest, err := zw.Create(albumExportManifest)
+	if err != nil {
+		return errors.Wrap(err, "AlbumExportUseCase.WriteArchive.CreateManifest")
+	}
+
+	enc := json.NewEncoder(manifest)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(export); err != nil {
+		return errors.Wrap(err, "AlbumExportUseCase.WriteArchive.EncodeManifest")
+	}
+
+	return errors.Wrap(zw.Close(), "AlbumExportUseCase.WriteArchive.Close")
+}
+
+// ExportAsync checks the access right away and archives the album in the background,
+// the executor is notified with the download link once the archive is stored
+func (uc *albumExportUseCase) ExportAsync(
+	ctx context.Context, albumID domain.ID, executor *domain.User, shareToken string,
+) error {
+	if uc.archives == nil {
+		return ErrUnavailable
+	}
+
+	export, err := uc.collect(ctx, albumID, executor, shareToken, asyncAlbumExportLimit)
+	if err != nil {
+		return err
+	}
+
+	if !uc.wrk.TryAddTask(albumExportTask{export: export, executor: executor}) {
+		return ErrUnavailable
+	}
+
+	return nil
+}
+
+func (uc *albumExportUseCase) handleExportTask(task albumExportTask) {
+	ctx, cancel := context.WithTimeout(context.Background(), albumExportTimeout)
+	defer cancel()
+
+	url, err := uc.storeArchive(ctx, task.export)
+	if err != nil {
+		uc.logger.Errorf("AlbumExportUseCase.handleExportTask: %v", err)
+		_ = uc.notifMng.Notify(ctx, task.executor.ID, &domain.Notification{
+			Title: "Album export failed",
+			Message: fmt.Sprintf(
+				"We're sorry, but we were unable to export the album %s. Please, try again later", task.export.Name,
+			),
+		})
+		return
+	}
+
+	_ = uc.notifMng.Notify(ctx, task.executor.ID, &domain.Notification{
+		Title:   "Album export is ready",
+		Message: fmt.Sprintf("The album %s is ready to be downloaded: %s", task.export.Name, url),
+	})
+}
+
+func (uc *albumExportUseCase) storeArchive(ctx context.Context, export *domain.AlbumExport) (string, error) {
+	// NOTE: The archive storage only cleans up the keys under the albums/ prefix
+	key := fmt.Sprintf("albums/%s/%d.zip", export.AlbumID, export.ExportedAt.UnixNano())
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(uc.WriteArchive(ctx, export, pw))
+	}()
+
+	if err := uc.archives.PutArchive(ctx, key, pr); err != nil {
+		pr.CloseWithError(err)
+		return "", errors.Wrap(err, "AlbumExportUseCase.storeArchive.PutArchive")
+	}
+
+	url, err := uc.archives.ArchiveURL(ctx, key)
+	return url, errors.Wrap(err, "AlbumExportUseCase.storeArchive.ArchiveURL")
+}
+
+func (uc *albumExportUseCase) collect(
+	ctx context.Context, albumID domain.ID, executor *domain.User, shareToken string, limit int,
+) (*domain.AlbumExport, error) {
+	album, err := uc.albumUC.GetByID(ctx, albumID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &domain.AlbumExport{
+		AlbumID:     album.ID,
+		Name:        album.Name,
+		Description: album.Description,
+		ExportedAt:  time.Now().UTC(),
+		Images:      []domain.AlbumExportImage{},
+	}
+
+	pagInput := &domain.PaginationInput{Page: 1, PerPage: albumExportPageSize}
+	for {
+		images, err := uc.albumUC.GetAlbumImages(
+			ctx, albumID, pagInput, domain.AlbumImageManualSort, executor, shareToken,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(export.Images)+len(images.Items) > limit {
+			return nil, ErrUnprocessable
+		}
+
+		for _, img := range images.Items {
+			export.Images = append(export.Images, domain.AlbumExportImage{
+				ID:          img.ID,
+				File:        fmt.Sprintf("%04d_%s%s", len(export.Images)+1, img.ID, path.Ext(img.Path)),
+				Path:        img.Path,
+				Title:       img.Title,
+				Description: img.Description,
+				Tags:        []string{},
+				UploadedAt:  img.CreatedAt,
+			})
+		}
+
+		if len(images.Items) < pagInput.PerPage {
+			break
+		}
+		pagInput.Page++
+	}
+
+	if err := uc.attachTags(ctx, export); err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+func (uc *albumExportUseCase) attachTags(ctx context.Context, export *domain.AlbumExport) error {
+	imageIDs := make([]domain.ID, len(export.Images))
+	for i, img := range export.Images {
+		imageIDs[i] = img.ID
+	}
+
+	tags, err := uc.tagRepo.ImagesTags(ctx, imageIDs)
+	if err != nil {
+		return errors.Wrap(err, "AlbumExportUseCase.attachTags")
+	}
+
+	for i := range export.Images {
+		if imgTags, ok := tags[export.Images[i].ID]; ok {
+			export.Images[i].Tags = imgTags
+		}
+	}
+
+	return nil
+}
+
+func (uc *albumExportUseCase) writeImage(ctx context.Context, zw *zip.Writer, img *domain.AlbumExportImage) error {
+	body, err := uc.imageStorage.Stream(ctx, img.Path)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			img.Missing = true
+			return nil
+		}
+		return err
+	}
+	defer body.Close()
+
+	// NOTE: Images are already compressed, so they are only stored
+	entry, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     img.File,
+		Method:   zip.Store,
+		Modified: img.UploadedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(entry, body)
+	return err
+}
@@ -0,0 +1,303 @@
+package usecase_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	usecaseMock "github.com/pillowskiy/gopix/internal/usecase/mock"
+	loggerMock "github.com/pillowskiy/gopix/pkg/logger/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAlbumExportUseCase_Export(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAlbumUC := usecaseMock.NewMockAlbumExportAlbumUseCase(ctrl)
+	mockTagRepo := usecaseMock.NewMockAlbumExportTagRepository(ctrl)
+	mockImageStorage := usecaseMock.NewMockAlbumExportImageStorage(ctrl)
+	mockArchives := usecaseMock.NewMockAlbumArchiveStorage(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
+
+	exportUC := usecase.NewAlbumExportUseCase(
+		mockAlbumUC, mockTagRepo, mockImageStorage, mockArchives, mockNotifMng, mockLogger,
+	)
+
+	albumID := domain.ID(1)
+	mockAlbum := &domain.Album{ID: albumID, Name: "test", Description: "test description"}
+	executor := &domain.User{ID: 2}
+
+	t.Run("SuccessExport", func(t *testing.T) {
+		images := &domain.Pagination[domain.ImageWithMeta]{
+			Items: []domain.ImageWithMeta{
+				{Image: domain.Image{ID: 3, Path: "a.png", Title: "first"}},
+				{Image: domain.Image{ID: 4, Path: "b.jpg"}},
+			},
+		}
+
+		mockAlbumUC.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockAlbumUC.EXPECT().
+			GetAlbumImages(gomock.Any(), albumID, gomock.Any(), domain.AlbumImageManualSort, executor, "token").
+			Return(images, nil)
+		mockTagRepo.EXPECT().
+			ImagesTags(gomock.Any(), []domain.ID{3, 4}).
+			Return(map[domain.ID][]string{3: {"cats"}}, nil)
+
+		export, err := exportUC.Export(context.Background(), albumID, executor, "token")
+
+		assert.NoError(t, err)
+		assert.Equal(t, mockAlbum.Name, export.Name)
+		assert.Len(t, export.Images, 2)
+		assert.Equal(t, "0001_3.png", export.Images[0].File)
+		assert.Equal(t, []string{"cats"}, export.Images[0].Tags)
+		assert.Equal(t, "0002_4.jpg", export.Images[1].File)
+		assert.Empty(t, export.Images[1].Tags)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		fullPage := &domain.Pagination[domain.ImageWithMeta]{
+			Items: make([]domain.ImageWithMeta, 100),
+		}
+
+		mockAlbumUC.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockAlbumUC.EXPECT().
+			GetAlbumImages(gomock.Any(), albumID, gomock.Any(), domain.AlbumImageManualSort, executor, "").
+			Return(fullPage, nil).
+			Times(6)
+		mockTagRepo.EXPECT().ImagesTags(gomock.Any(), gomock.Any()).Times(0)
+
+		export, err := exportUC.Export(context.Background(), albumID, executor, "")
+
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+		assert.Nil(t, export)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockAlbumUC.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockAlbumUC.EXPECT().
+			GetAlbumImages(gomock.Any(), albumID, gomock.Any(), domain.AlbumImageManualSort, nil, "").
+			Return(nil, usecase.ErrForbidden)
+
+		export, err := exportUC.Export(context.Background(), albumID, nil, "")
+
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+		assert.Nil(t, export)
+	})
+
+	t.Run("AlbumNotFound", func(t *testing.T) {
+		mockAlbumUC.EXPECT().GetByID(gomock.Any(), albumID).Return(nil, usecase.ErrNotFound)
+		mockAlbumUC.EXPECT().GetAlbumImages(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Times(0)
+
+		export, err := exportUC.Export(context.Background(), albumID, nil, "")
+
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+		assert.Nil(t, export)
+	})
+}
+
+func TestAlbumExportUseCase_WriteArchive(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAlbumUC := usecaseMock.NewMockAlbumExportAlbumUseCase(ctrl)
+	mockTagRepo := usecaseMock.NewMockAlbumExportTagRepository(ctrl)
+	mockImageStorage := usecaseMock.NewMockAlbumExportImageStorage(ctrl)
+	mockArchives := usecaseMock.NewMockAlbumArchiveStorage(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
+
+	exportUC := usecase.NewAlbumExportUseCase(
+		mockAlbumUC, mockTagRepo, mockImageStorage, mockArchives, mockNotifMng, mockLogger,
+	)
+
+	newExport := func() *domain.AlbumExport {
+		return &domain.AlbumExport{
+			AlbumID: 1,
+			Name:    "test",
+			Images: []domain.AlbumExportImage{
+				{ID: 2, File: "0001_2.png", Path: "a.png", Tags: []string{"cats"}, UploadedAt: time.Now()},
+				{ID: 3, File: "0002_3.png", Path: "b.png", Tags: []string{}, UploadedAt: time.Now()},
+			},
+		}
+	}
+
+	t.Run("SuccessWriteArchive", func(t *testing.T) {
+		export := newExport()
+
+		mockImageStorage.EXPECT().Stream(gomock.Any(), "a.png").Return(io.NopCloser(strings.NewReader("image")), nil)
+		mockImageStorage.EXPECT().Stream(gomock.Any(), "b.png").Return(nil, repository.ErrNotFound)
+
+		buf := new(bytes.Buffer)
+		err := exportUC.WriteArchive(context.Background(), export, buf)
+		assert.NoError(t, err)
+
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		assert.NoError(t, err)
+		assert.Len(t, zr.File, 2)
+		assert.Equal(t, "0001_2.png", zr.File[0].Name)
+		assert.Equal(t, "manifest.json", zr.File[1].Name)
+
+		manifestFile, err := zr.File[1].Open()
+		assert.NoError(t, err)
+		defer manifestFile.Close()
+
+		manifest := new(domain.AlbumExport)
+		assert.NoError(t, json.NewDecoder(manifestFile).Decode(manifest))
+		assert.Equal(t, "test", manifest.Name)
+		assert.Len(t, manifest.Images, 2)
+		assert.False(t, manifest.Images[0].Missing)
+		assert.True(t, manifest.Images[1].Missing)
+	})
+
+	t.Run("StorageError", func(t *testing.T) {
+		export := newExport()
+
+		mockImageStorage.EXPECT().Stream(gomock.Any(), "a.png").Return(nil, errors.New("storage error"))
+
+		err := exportUC.WriteArchive(context.Background(), export, io.Discard)
+		assert.Error(t, err)
+	})
+}
+
+func TestAlbumExportUseCase_ExportAsync(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAlbumUC := usecaseMock.NewMockAlbumExportAlbumUseCase(ctrl)
+	mockTagRepo := usecaseMock.NewMockAlbumExportTagRepository(ctrl)
+	mockImageStorage := usecaseMock.NewMockAlbumExportImageStorage(ctrl)
+	mockArchives := usecaseMock.NewMockAlbumArchiveStorage(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
+
+	exportUC := usecase.NewAlbumExportUseCase(
+		mockAlbumUC, mockTagRepo, mockImageStorage, mockArchives, mockNotifMng, mockLogger,
+	)
+
+	albumID := domain.ID(1)
+	mockAlbum := &domain.Album{ID: albumID, Name: "test"}
+	executor := &domain.User{ID: 2}
+	emptyPage := &domain.Pagination[domain.ImageWithMeta]{Items: []domain.ImageWithMeta{}}
+
+	t.Run("SuccessExportAsync", func(t *testing.T) {
+		notified := make(chan *domain.Notification, 1)
+
+		mockAlbumUC.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockAlbumUC.EXPECT().
+			GetAlbumImages(gomock.Any(), albumID, gomock.Any(), domain.AlbumImageManualSort, executor, "").
+			Return(emptyPage, nil)
+		mockTagRepo.EXPECT().ImagesTags(gomock.Any(), []domain.ID{}).Return(map[domain.ID][]string{}, nil)
+		mockArchives.EXPECT().PutArchive(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, r io.Reader) error {
+				_, err := io.Copy(io.Discard, r)
+				return err
+			})
+		mockArchives.EXPECT().ArchiveURL(gomock.Any(), gomock.Any()).Return("https://example.com/a.zip", nil)
+		mockNotifMng.EXPECT().Notify(gomock.Any(), executor.ID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ domain.ID, notif *domain.Notification) error {
+				notified <- notif
+				return nil
+			})
+
+		err := exportUC.ExportAsync(context.Background(), albumID, executor, "")
+		assert.NoError(t, err)
+
+		select {
+		case notif := <-notified:
+			assert.Contains(t, notif.Message, "https://example.com/a.zip")
+		case <-time.After(time.Second):
+			t.Fatal("export notification wasn't sent")
+		}
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockAlbumUC.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockAlbumUC.EXPECT().
+			GetAlbumImages(gomock.Any(), albumID, gomock.Any(), domain.AlbumImageManualSort, executor, "").
+			Return(nil, usecase.ErrForbidden)
+		mockArchives.EXPECT().PutArchive(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := exportUC.ExportAsync(context.Background(), albumID, executor, "")
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+	})
+}
+
+func TestAlbumExportUseCase_ExportAsyncWithoutArchives(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAlbumUC := usecaseMock.NewMockAlbumExportAlbumUseCase(ctrl)
+	mockTagRepo := usecaseMock.NewMockAlbumExportTagRepository(ctrl)
+	mockImageStorage := usecaseMock.NewMockAlbumExportImageStorage(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
+
+	exportUC := usecase.NewAlbumExportUseCase(
+		mockAlbumUC, mockTagRepo, mockImageStorage, nil, mockNotifMng, mockLogger,
+	)
+
+	mockAlbumUC.EXPECT().GetByID(gomock.Any(), gomock.Any()).Times(0)
+
+	err := exportUC.ExportAsync(context.Background(), domain.ID(1), &domain.User{ID: 2}, "")
+	assert.ErrorIs(t, err, usecase.ErrUnavailable)
+}
+
+func TestAlbumExportUseCase_DeleteExpiredArchives(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAlbumUC := usecaseMock.NewMockAlbumExportAlbumUseCase(ctrl)
+	mockTagRepo := usecaseMock.NewMockAlbumExportTagRepository(ctrl)
+	mockImageStorage := usecaseMock.NewMockAlbumExportImageStorage(ctrl)
+	mockArchives := usecaseMock.NewMockAlbumArchiveStorage(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
+
+	exportUC := usecase.NewAlbumExportUseCase(
+		mockAlbumUC, mockTagRepo, mockImageStorage, mockArchives, mockNotifMng, mockLogger,
+	)
+
+	t.Run("SuccessDeleteExpiredArchives", func(t *testing.T) {
+		mockArchives.EXPECT().DeleteExpiredArchives(gomock.Any()).Return(2, nil)
+		mockLogger.EXPECT().Infof(gomock.Any(), 2)
+
+		assert.NoError(t, exportUC.DeleteExpiredArchives(context.Background()))
+	})
+
+	t.Run("NothingExpired", func(t *testing.T) {
+		mockArchives.EXPECT().DeleteExpiredArchives(gomock.Any()).Return(0, nil)
+		mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, exportUC.DeleteExpiredArchives(context.Background()))
+	})
+
+	t.Run("StorageError", func(t *testing.T) {
+		mockArchives.EXPECT().DeleteExpiredArchives(gomock.Any()).Return(0, errors.New("s3 error"))
+
+		assert.Error(t, exportUC.DeleteExpiredArchives(context.Background()))
+	})
+}
@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/pkg/batch"
+	"github.com/pkg/errors"
+)
+
+// The followers get a single notification per album for all the images added during the interval
+const (
+	albumFollowersNotifyInterval = 10 * time.Minute
+	albumFollowersNotifyTimeout  = time.Minute
+)
+
+var albumAdditionsBatchConfig = batch.BatchConfig{Retries: 1, MaxSize: 1000}
+
+type albumAdditionItem struct {
+	AlbumID domain.ID
+	AddedBy domain.ID
+	// Only the public images are counted, the other ones are visible to their authors only
+	Public bool
+}
+
+func (i albumAdditionItem) Group() string {
+	return i.AlbumID.String()
+}
+
+// Follow is allowed only to the users who can see the album without a share token,
+// so the followers are never notified about the albums they can't open
+func (uc *albumUseCase) Follow(ctx context.Context, albumID domain.ID, executor *domain.User) error {
+	album, member, err := uc.getWithMember(ctx, albumID, executor)
+	if err != nil {
+		return err
+	}
+
+	if !uc.acl.CanView(executor, album, member, "") {
+		return ErrForbidden
+	}
+
+	isFollowing, err := uc.repo.IsFollowing(ctx, albumID, executor.ID)
+	if err != nil {
+		return errors.Wrap(err, "AlbumUseCase.Follow.IsFollowing")
+	}
+
+	if isFollowing {
+		return ErrAlreadyExists
+	}
+
+	return uc.repo.Follow(ctx, albumID, executor.ID)
+}
+
+func (uc *albumUseCase) Unfollow(ctx context.Context, albumID domain.ID, executor *domain.User) error {
+	isFollowing, err := uc.repo.IsFollowing(ctx, albumID, executor.ID)
+	if err != nil {
+		return errors.Wrap(err, "AlbumUseCase.Unfollow.IsFollowing")
+	}
+
+	if !isFollowing {
+		return ErrNotFound
+	}
+
+	return uc.repo.Unfollow(ctx, albumID, executor.ID)
+}
+
+// GetFollowed hides the followed albums the executor has lost access to
+func (uc *albumUseCase) GetFollowed(ctx context.Context, executor *domain.User) ([]domain.DetailedAlbum, error) {
+	albums, err := uc.repo.GetFollowed(ctx, executor.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "AlbumUseCase.GetFollowed")
+	}
+
+	visible := make([]domain.DetailedAlbum, 0, len(albums))
+	for _, album := range albums {
+		var member *domain.AlbumMember
+		if album.AccessLevel != domain.AlbumAccessPublic {
+			if member, err = uc.executorMember(ctx, album.ID, executor); err != nil {
+				return nil, err
+			}
+		}
+
+		if !uc.acl.CanView(executor, &album.Album, member, "") {
+			continue
+		}
+
+		if !uc.acl.CanModify(executor, &album.Album) {
+			album.ShareToken = nil
+		}
+
+		if album.Kind == domain.AlbumKindSmart {
+			album.Cover = uc.smartCover(ctx, album.Rule, executor)
+		}
+		visible = append(visible, album)
+	}
+
+	return visible, nil
+}
+
+// NotifyFollowers sends the pending additions right away instead of waiting for the interval
+func (uc *albumUseCase) NotifyFollowers() {
+	uc.additions.Tick()
+}
+
+func (uc *albumUseCase) notifyFollowers(items []albumAdditionItem) error {
+	ctx, cancel := context.WithTimeout(context.Background(), albumFollowersNotifyTimeout)
+	defer cancel()
+
+	additions := make(map[domain.ID][]albumAdditionItem)
+	for _, item := range items {
+		additions[item.AlbumID] = append(additions[item.AlbumID], item)
+	}
+
+	// NOTE: The batch is never retried, so the followers aren't notified twice about the same images
+	for albumID, albumAdditions := range additions {
+		if err := uc.notifyAlbumFollowers(ctx, albumID, albumAdditions); err != nil {
+			uc.logger.Errorf("AlbumUseCase.notifyFollowers: album %s: %v", albumID, err)
+		}
+	}
+
+	return nil
+}
+
+func (uc *albumUseCase) notifyAlbumFollowers(
+	ctx context.Context, albumID domain.ID, additions []albumAdditionItem,
+) error {
+	album, err := uc.GetByID(ctx, albumID)
+	if err != nil {
+		return err
+	}
+
+	// The followers are loaded with their permissions, the access is checked the same way as for any request
+	followers, err := uc.repo.GetFollowers(ctx, albumID)
+	if err != nil || len(followers) == 0 {
+		return errors.Wrap(err, "GetFollowers")
+	}
+
+	members := make(map[domain.ID]*domain.AlbumMember)
+	if album.AccessLevel != domain.AlbumAccessPublic {
+		albumMembers, err := uc.repo.GetMembers(ctx, albumID)
+		if err != nil {
+			return errors.Wrap(err, "GetMembers")
+		}
+
+		for i := range albumMembers {
+			members[albumMembers[i].UserID] = &albumMembers[i]
+		}
+	}
+
+	for i := range followers {
+		follower := &followers[i]
+		if !uc.acl.CanView(follower, album, members[follower.ID], "") {
+			continue
+		}
+
+		added := 0
+		for _, addition := range additions {
+			if addition.Public && addition.AddedBy != follower.ID {
+				added++
+			}
+		}
+
+		if added == 0 {
+			continue
+		}
+
+		_ = uc.notifMng.Notify(ctx, follower.ID, &domain.Notification{
+			Title:   "New images in the album",
+			Message: fmt.Sprintf("%d new image(s) were added to the album \"%s\"", added, album.Name),
+		})
+	}
+
+	return nil
+}
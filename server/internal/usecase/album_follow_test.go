@@ -0,0 +1,198 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	usecaseMock "github.com/pillowskiy/gopix/internal/usecase/mock"
+	loggerMock "github.com/pillowskiy/gopix/pkg/logger/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAlbumUseCase_Follow(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
+	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
+	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
+
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
+
+	albumID := domain.ID(1)
+	executor := &domain.User{ID: 2}
+	mockAlbum := &domain.Album{ID: albumID, AuthorID: 3, AccessLevel: domain.AlbumAccessPublic}
+
+	t.Run("SuccessFollow", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, executor.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanView(executor, mockAlbum, nil, "").Return(true)
+		mockRepo.EXPECT().IsFollowing(gomock.Any(), albumID, executor.ID).Return(false, nil)
+		mockRepo.EXPECT().Follow(gomock.Any(), albumID, executor.ID).Return(nil)
+
+		err := albumUC.Follow(context.Background(), albumID, executor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("AlreadyFollowing", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, executor.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanView(executor, mockAlbum, nil, "").Return(true)
+		mockRepo.EXPECT().IsFollowing(gomock.Any(), albumID, executor.ID).Return(true, nil)
+		mockRepo.EXPECT().Follow(gomock.Any(), albumID, executor.ID).Times(0)
+
+		err := albumUC.Follow(context.Background(), albumID, executor)
+		assert.ErrorIs(t, err, usecase.ErrAlreadyExists)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		privateAlbum := &domain.Album{ID: albumID, AuthorID: 3, AccessLevel: domain.AlbumAccessPrivate}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(privateAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, executor.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanView(executor, privateAlbum, nil, "").Return(false)
+		mockRepo.EXPECT().Follow(gomock.Any(), albumID, executor.ID).Times(0)
+
+		err := albumUC.Follow(context.Background(), albumID, executor)
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+	})
+
+	t.Run("AlbumNotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().Follow(gomock.Any(), albumID, executor.ID).Times(0)
+
+		err := albumUC.Follow(context.Background(), albumID, executor)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+
+	t.Run("SuccessUnfollow", func(t *testing.T) {
+		mockRepo.EXPECT().IsFollowing(gomock.Any(), albumID, executor.ID).Return(true, nil)
+		mockRepo.EXPECT().Unfollow(gomock.Any(), albumID, executor.ID).Return(nil)
+
+		err := albumUC.Unfollow(context.Background(), albumID, executor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("UnfollowNotFollowing", func(t *testing.T) {
+		mockRepo.EXPECT().IsFollowing(gomock.Any(), albumID, executor.ID).Return(false, nil)
+		mockRepo.EXPECT().Unfollow(gomock.Any(), albumID, executor.ID).Times(0)
+
+		err := albumUC.Unfollow(context.Background(), albumID, executor)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
+
+func TestAlbumUseCase_GetFollowed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
+	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
+	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
+
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
+
+	executor := &domain.User{ID: 2}
+
+	t.Run("HidesInaccessibleAlbums", func(t *testing.T) {
+		shareToken := "token"
+		followed := []domain.DetailedAlbum{
+			{Album: domain.Album{ID: 4, AuthorID: 3, AccessLevel: domain.AlbumAccessPublic, ShareToken: &shareToken}},
+			{Album: domain.Album{ID: 5, AuthorID: 3, AccessLevel: domain.AlbumAccessPrivate}},
+		}
+
+		mockRepo.EXPECT().GetFollowed(gomock.Any(), executor.ID).Return(followed, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), domain.ID(5), executor.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanView(executor, gomock.Any(), nil, "").Return(true)
+		mockACL.EXPECT().CanView(executor, gomock.Any(), nil, "").Return(false)
+		mockACL.EXPECT().CanModify(executor, gomock.Any()).Return(false)
+
+		albums, err := albumUC.GetFollowed(context.Background(), executor)
+
+		assert.NoError(t, err)
+		assert.Len(t, albums, 1)
+		assert.Equal(t, domain.ID(4), albums[0].ID)
+		assert.Nil(t, albums[0].ShareToken)
+	})
+}
+
+func TestAlbumUseCase_NotifyFollowers(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
+	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
+	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
+
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
+
+	albumID := domain.ID(1)
+	executor := &domain.User{ID: 2}
+	mockAlbum := &domain.Album{ID: albumID, Name: "test", AuthorID: executor.ID, AccessLevel: domain.AlbumAccessPublic}
+
+	putImage := func(image *domain.Image) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, executor.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanPutImage(executor, mockAlbum, nil).Return(true)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), image.ID).Return(image, nil)
+		mockACL.EXPECT().CanAttachImage(executor, image).Return(true)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, image.ID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, image.ID, executor.ID).Return(nil)
+
+		assert.NoError(t, albumUC.PutImage(context.Background(), albumID, image.ID, executor))
+	}
+
+	t.Run("BatchesAdditionsPerAlbum", func(t *testing.T) {
+		putImage(&domain.Image{ID: 10, AccessLevel: domain.ImageAccessPublic})
+		putImage(&domain.Image{ID: 11, AccessLevel: domain.ImageAccessPublic})
+		putImage(&domain.Image{ID: 12, AuthorID: executor.ID, AccessLevel: domain.ImageAccessPrivate})
+
+		followerID := domain.ID(5)
+		follower := domain.User{ID: followerID, Permissions: int(domain.PermissionsAdmin)}
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockRepo.EXPECT().GetFollowers(gomock.Any(), albumID).Return([]domain.User{*executor, follower}, nil)
+		mockACL.EXPECT().CanView(executor, mockAlbum, nil, "").Return(true)
+		// The access is checked against the follower loaded with the permissions
+		mockACL.EXPECT().CanView(&follower, mockAlbum, nil, "").Return(true)
+		mockNotifMng.EXPECT().
+			Notify(gomock.Any(), followerID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ domain.ID, notif *domain.Notification) error {
+				assert.Contains(t, notif.Message, "2 new image(s)")
+				return nil
+			})
+
+		albumUC.NotifyFollowers()
+	})
+
+	t.Run("NothingPending", func(t *testing.T) {
+		mockRepo.EXPECT().GetFollowers(gomock.Any(), gomock.Any()).Times(0)
+		mockNotifMng.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		albumUC.NotifyFollowers()
+	})
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/pillowskiy/gopix/internal/domain"
 	"github.com/pillowskiy/gopix/internal/repository"
 	"github.com/pillowskiy/gopix/internal/usecase"
 	usecaseMock "github.com/pillowskiy/gopix/internal/usecase/mock"
+	loggerMock "github.com/pillowskiy/gopix/pkg/logger/mock"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -22,26 +24,107 @@ func TestAlbumUseCase_Create(t *testing.T) {
 	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
 	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
 	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
 
-	albumUC := usecase.NewAlbumUseCase(mockRepo, mockACL, mockImageUC)
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
 
 	authorID := domain.ID(1)
 	albumID := domain.ID(2)
+	executor := &domain.User{ID: authorID}
 	albumInput := &domain.Album{Name: "test", AuthorID: authorID}
 	mockAlbum := &domain.Album{ID: albumID, Name: albumInput.Name}
 
 	t.Run("SuccessCreate", func(t *testing.T) {
 		mockRepo.EXPECT().Create(gomock.Any(), albumInput).Return(mockAlbum, nil)
 
-		createdAlbum, err := albumUC.Create(context.Background(), albumInput)
+		createdAlbum, err := albumUC.Create(context.Background(), albumInput, executor)
 		assert.NoError(t, err)
 		assert.Equal(t, mockAlbum, createdAlbum)
 	})
 
+	t.Run("SuccessCreateSmart", func(t *testing.T) {
+		seedID := domain.ID(5)
+		seed := &domain.Image{ID: seedID, AuthorID: authorID, AccessLevel: domain.ImageAccessPrivate}
+		smartInput := &domain.Album{
+			Name:     "smart",
+			AuthorID: authorID,
+			Kind:     domain.AlbumKindSmart,
+			Rule:     &domain.AlbumRule{Tags: []string{"cats"}, SimilarTo: &seedID},
+		}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), seedID).Return(seed, nil)
+		mockACL.EXPECT().CanAttachImage(executor, seed).Return(true)
+		mockRepo.EXPECT().Create(gomock.Any(), smartInput).Return(smartInput, nil)
+
+		createdAlbum, err := albumUC.Create(context.Background(), smartInput, executor)
+		assert.NoError(t, err)
+		assert.Equal(t, smartInput, createdAlbum)
+	})
+
+	t.Run("SmartWithoutRule", func(t *testing.T) {
+		smartInput := &domain.Album{Name: "smart", AuthorID: authorID, Kind: domain.AlbumKindSmart}
+
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		createdAlbum, err := albumUC.Create(context.Background(), smartInput, executor)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+		assert.Nil(t, createdAlbum)
+	})
+
+	t.Run("SmartWithInvalidRange", func(t *testing.T) {
+		from := time.Now()
+		to := from.Add(-time.Hour)
+		smartInput := &domain.Album{
+			Name:     "smart",
+			AuthorID: authorID,
+			Kind:     domain.AlbumKindSmart,
+			Rule:     &domain.AlbumRule{From: &from, To: &to},
+		}
+
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		createdAlbum, err := albumUC.Create(context.Background(), smartInput, executor)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+		assert.Nil(t, createdAlbum)
+	})
+
+	t.Run("SmartWithForeignPrivateSeed", func(t *testing.T) {
+		seedID := domain.ID(6)
+		seed := &domain.Image{ID: seedID, AuthorID: 99, AccessLevel: domain.ImageAccessPrivate}
+		smartInput := &domain.Album{
+			Name:     "smart",
+			AuthorID: authorID,
+			Kind:     domain.AlbumKindSmart,
+			Rule:     &domain.AlbumRule{SimilarTo: &seedID},
+		}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), seedID).Return(seed, nil)
+		mockACL.EXPECT().CanAttachImage(executor, seed).Return(false)
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		createdAlbum, err := albumUC.Create(context.Background(), smartInput, executor)
+		assert.ErrorIs(t, err, usecase.ErrIncorrectImageRef)
+		assert.Nil(t, createdAlbum)
+	})
+
+	t.Run("RegularWithRule", func(t *testing.T) {
+		regularInput := &domain.Album{Name: "test", AuthorID: authorID, Rule: &domain.AlbumRule{MinLikes: 1}}
+
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		createdAlbum, err := albumUC.Create(context.Background(), regularInput, executor)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+		assert.Nil(t, createdAlbum)
+	})
+
 	t.Run("RepoError", func(t *testing.T) {
 		mockRepo.EXPECT().Create(gomock.Any(), albumInput).Return(nil, errors.New("repo error"))
 
-		createdAlbum, err := albumUC.Create(context.Background(), albumInput)
+		createdAlbum, err := albumUC.Create(context.Background(), albumInput, executor)
 
 		assert.Error(t, err)
 		assert.Nil(t, createdAlbum)
@@ -57,8 +140,13 @@ func TestAlbumUseCase_GetByAuthorID(t *testing.T) {
 	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
 	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
 	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
 
-	albumUC := usecase.NewAlbumUseCase(mockRepo, mockACL, mockImageUC)
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
 
 	authorID := domain.ID(1)
 	albumID := domain.ID(2)
@@ -73,18 +161,77 @@ func TestAlbumUseCase_GetByAuthorID(t *testing.T) {
 	}
 
 	t.Run("SuccessGetByAuthorID", func(t *testing.T) {
-		mockRepo.EXPECT().GetByAuthorID(gomock.Any(), authorID).Return(mockAlbums, nil)
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), authorID, gomock.Nil()).Return(true, nil)
+		mockRepo.EXPECT().GetByAuthorID(gomock.Any(), authorID, gomock.Nil()).Return(mockAlbums, nil)
+		mockACL.EXPECT().CanView(nil, gomock.Any(), nil, "").Return(true)
+		mockACL.EXPECT().CanModify(nil, gomock.Any()).Return(false)
 
-		albums, err := albumUC.GetByAuthorID(context.Background(), authorID)
+		albums, err := albumUC.GetByAuthorID(context.Background(), authorID, nil)
 
 		assert.NoError(t, err)
 		assert.Equal(t, mockAlbums, albums)
 	})
 
+	t.Run("HidesInvisibleAlbums", func(t *testing.T) {
+		shareToken := "token"
+		executor := &domain.User{ID: 3}
+		albumsWithHidden := []domain.DetailedAlbum{
+			{Album: domain.Album{ID: 4, AuthorID: authorID, AccessLevel: domain.AlbumAccessPrivate}},
+			{Album: domain.Album{ID: 5, AuthorID: authorID, AccessLevel: domain.AlbumAccessLink, ShareToken: &shareToken}},
+		}
+
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), authorID, executor).Return(true, nil)
+		mockRepo.EXPECT().GetByAuthorID(gomock.Any(), authorID, &executor.ID).Return(albumsWithHidden, nil)
+		mockRepo.EXPECT().GetMemberships(gomock.Any(), executor.ID, authorID).Return(nil, nil)
+		mockACL.EXPECT().CanView(executor, gomock.Any(), nil, "").Return(false)
+		mockACL.EXPECT().CanView(executor, gomock.Any(), nil, "").Return(true)
+		mockACL.EXPECT().CanModify(executor, gomock.Any()).Return(false)
+
+		albums, err := albumUC.GetByAuthorID(context.Background(), authorID, executor)
+
+		assert.NoError(t, err)
+		assert.Len(t, albums, 1)
+		assert.Equal(t, domain.ID(5), albums[0].ID)
+		assert.Nil(t, albums[0].ShareToken)
+	})
+
+	t.Run("ShowsMemberAlbums", func(t *testing.T) {
+		executor := &domain.User{ID: 3}
+		privateAlbums := []domain.DetailedAlbum{
+			{Album: domain.Album{ID: 4, AuthorID: authorID, AccessLevel: domain.AlbumAccessPrivate}},
+		}
+		memberships := []domain.AlbumMember{
+			{AlbumID: 4, UserID: executor.ID, Role: domain.AlbumRoleViewer, Accepted: true},
+		}
+
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), authorID, executor).Return(true, nil)
+		mockRepo.EXPECT().GetByAuthorID(gomock.Any(), authorID, &executor.ID).Return(privateAlbums, nil)
+		mockRepo.EXPECT().GetMemberships(gomock.Any(), executor.ID, authorID).Return(memberships, nil)
+		mockACL.EXPECT().CanView(executor, gomock.Any(), &memberships[0], "").Return(true)
+		mockACL.EXPECT().CanModify(executor, gomock.Any()).Return(false)
+
+		albums, err := albumUC.GetByAuthorID(context.Background(), authorID, executor)
+
+		assert.NoError(t, err)
+		assert.Len(t, albums, 1)
+	})
+
+	t.Run("HiddenAuthor", func(t *testing.T) {
+		executor := &domain.User{ID: 3}
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), authorID, executor).Return(false, nil)
+		mockRepo.EXPECT().GetByAuthorID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		albums, err := albumUC.GetByAuthorID(context.Background(), authorID, executor)
+
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+		assert.Nil(t, albums)
+	})
+
 	t.Run("NotFound", func(t *testing.T) {
-		mockRepo.EXPECT().GetByAuthorID(gomock.Any(), authorID).Return(nil, repository.ErrNotFound)
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), authorID, gomock.Nil()).Return(true, nil)
+		mockRepo.EXPECT().GetByAuthorID(gomock.Any(), authorID, gomock.Nil()).Return(nil, repository.ErrNotFound)
 
-		albums, err := albumUC.GetByAuthorID(context.Background(), authorID)
+		albums, err := albumUC.GetByAuthorID(context.Background(), authorID, nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, usecase.ErrNotFound, err)
@@ -92,9 +239,10 @@ func TestAlbumUseCase_GetByAuthorID(t *testing.T) {
 	})
 
 	t.Run("RepoError", func(t *testing.T) {
-		mockRepo.EXPECT().GetByAuthorID(gomock.Any(), authorID).Return(nil, errors.New("repo error"))
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), authorID, gomock.Nil()).Return(true, nil)
+		mockRepo.EXPECT().GetByAuthorID(gomock.Any(), authorID, gomock.Nil()).Return(nil, errors.New("repo error"))
 
-		albums, err := albumUC.GetByAuthorID(context.Background(), authorID)
+		albums, err := albumUC.GetByAuthorID(context.Background(), authorID, nil)
 
 		assert.Error(t, err)
 		assert.Nil(t, albums)
@@ -110,8 +258,13 @@ func TestAlbumUseCase_GetByID(t *testing.T) {
 	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
 	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
 	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
 
-	albumUC := usecase.NewAlbumUseCase(mockRepo, mockACL, mockImageUC)
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
 
 	albumID := domain.ID(1)
 	mockAlbum := &domain.Album{
@@ -139,9 +292,10 @@ func TestAlbumUseCase_GetByID(t *testing.T) {
 	})
 
 	t.Run("RepoError", func(t *testing.T) {
-		mockRepo.EXPECT().GetByAuthorID(gomock.Any(), albumID).Return(nil, errors.New("repo error"))
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), albumID, gomock.Nil()).Return(true, nil)
+		mockRepo.EXPECT().GetByAuthorID(gomock.Any(), albumID, gomock.Nil()).Return(nil, errors.New("repo error"))
 
-		albums, err := albumUC.GetByAuthorID(context.Background(), albumID)
+		albums, err := albumUC.GetByAuthorID(context.Background(), albumID, nil)
 
 		assert.Error(t, err)
 		assert.Nil(t, albums)
@@ -157,8 +311,13 @@ func TestAlbumUseCase_GetAlbumImages(t *testing.T) {
 	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
 	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
 	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
 
-	albumUC := usecase.NewAlbumUseCase(mockRepo, mockACL, mockImageUC)
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
 
 	albumID := domain.ID(1)
 	mockAlbum := &domain.Album{
@@ -171,10 +330,10 @@ func TestAlbumUseCase_GetAlbumImages(t *testing.T) {
 		Page:    1,
 	}
 
-	mockPag := &domain.Pagination[domain.ImageWithAuthor]{
+	mockPag := &domain.Pagination[domain.ImageWithMeta]{
 		PaginationInput: *pagInput,
 		Total:           10,
-		Items: []domain.ImageWithAuthor{
+		Items: []domain.ImageWithMeta{
 			{
 				Image: domain.Image{
 					ID:   1,
@@ -186,9 +345,35 @@ func TestAlbumUseCase_GetAlbumImages(t *testing.T) {
 
 	t.Run("SuccessGetAlbumImages", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
-		mockRepo.EXPECT().GetAlbumImages(gomock.Any(), albumID, pagInput).Return(mockPag, nil)
+		mockACL.EXPECT().CanView(nil, mockAlbum, nil, "").Return(true)
+		mockRepo.EXPECT().
+			GetAlbumImages(gomock.Any(), albumID, gomock.Nil(), pagInput, domain.AlbumImageManualSort).
+			Return(mockPag, nil)
+
+		pag, err := albumUC.GetAlbumImages(context.Background(), albumID, pagInput, domain.AlbumImageManualSort, nil, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, mockPag, pag)
+	})
 
-		pag, err := albumUC.GetAlbumImages(context.Background(), albumID, pagInput)
+	t.Run("SuccessGetSmartAlbumImages", func(t *testing.T) {
+		seedID := domain.ID(7)
+		similarIDs := []domain.ID{8, 9}
+		smartAlbum := &domain.Album{
+			ID:   albumID,
+			Kind: domain.AlbumKindSmart,
+			Rule: &domain.AlbumRule{Tags: []string{"cats"}, SimilarTo: &seedID},
+		}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(smartAlbum, nil)
+		mockACL.EXPECT().CanView(nil, smartAlbum, nil, "").Return(true)
+		mockFeaturesUC.EXPECT().Similar(gomock.Any(), seedID).Return(similarIDs, nil)
+		mockRepo.EXPECT().
+			GetRuleImages(gomock.Any(), smartAlbum.Rule, similarIDs, gomock.Nil(), pagInput, domain.AlbumImageManualSort).
+			Return(mockPag, nil)
+		mockRepo.EXPECT().GetAlbumImages(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		pag, err := albumUC.GetAlbumImages(context.Background(), albumID, pagInput, domain.AlbumImageManualSort, nil, "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, mockPag, pag)
@@ -196,26 +381,110 @@ func TestAlbumUseCase_GetAlbumImages(t *testing.T) {
 
 	t.Run("AlbumNotFound", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(nil, repository.ErrNotFound)
-		mockRepo.EXPECT().GetAlbumImages(gomock.Any(), albumID, pagInput).Times(0)
+		mockRepo.EXPECT().GetAlbumImages(gomock.Any(), albumID, gomock.Nil(), pagInput, domain.AlbumImageManualSort).Times(0)
 
-		pag, err := albumUC.GetAlbumImages(context.Background(), albumID, pagInput)
+		pag, err := albumUC.GetAlbumImages(context.Background(), albumID, pagInput, domain.AlbumImageManualSort, nil, "")
 
 		assert.Error(t, err)
 		assert.Equal(t, usecase.ErrNotFound, err)
 		assert.Nil(t, pag)
 	})
 
+	t.Run("Forbidden", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockACL.EXPECT().CanView(nil, mockAlbum, nil, "wrong").Return(false)
+		mockRepo.EXPECT().GetAlbumImages(gomock.Any(), albumID, gomock.Nil(), pagInput, domain.AlbumImageManualSort).Times(0)
+
+		pag, err := albumUC.GetAlbumImages(context.Background(), albumID, pagInput, domain.AlbumImageManualSort, nil, "wrong")
+
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+		assert.Nil(t, pag)
+	})
+
+	t.Run("IncorrectSort", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockACL.EXPECT().CanView(nil, mockAlbum, nil, "").Return(true)
+		mockRepo.EXPECT().GetAlbumImages(gomock.Any(), albumID, gomock.Nil(), pagInput, domain.AlbumImageSortMethod("random")).
+			Return(nil, repository.ErrIncorrectInput)
+
+		pag, err := albumUC.GetAlbumImages(context.Background(), albumID, pagInput, "random", nil, "")
+
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+		assert.Nil(t, pag)
+	})
+
 	t.Run("RepoError", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
-		mockRepo.EXPECT().GetAlbumImages(gomock.Any(), albumID, pagInput).Return(nil, errors.New("repo error"))
+		mockACL.EXPECT().CanView(nil, mockAlbum, nil, "").Return(true)
+		mockRepo.EXPECT().
+			GetAlbumImages(gomock.Any(), albumID, gomock.Nil(), pagInput, domain.AlbumImageManualSort).
+			Return(nil, errors.New("repo error"))
 
-		pag, err := albumUC.GetAlbumImages(context.Background(), albumID, pagInput)
+		pag, err := albumUC.GetAlbumImages(context.Background(), albumID, pagInput, domain.AlbumImageManualSort, nil, "")
 
 		assert.Error(t, err)
 		assert.Nil(t, pag)
 	})
 }
 
+func TestAlbumUseCase_Share(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
+	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
+	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
+
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
+
+	albumID := domain.ID(1)
+	mockUser := &domain.User{ID: 2}
+	mockAlbum := &domain.Album{ID: albumID, AuthorID: mockUser.ID, AccessLevel: domain.AlbumAccessLink}
+
+	t.Run("SuccessShare", func(t *testing.T) {
+		var storedToken *string
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Return(true)
+		mockRepo.EXPECT().SetShareToken(gomock.Any(), albumID, gomock.Not(gomock.Nil())).
+			DoAndReturn(func(_ context.Context, _ domain.ID, token *string) error {
+				storedToken = token
+				return nil
+			})
+
+		token, err := albumUC.Share(context.Background(), albumID, mockUser)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, token)
+		assert.Equal(t, token, *storedToken)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Return(false)
+		mockRepo.EXPECT().SetShareToken(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		token, err := albumUC.Share(context.Background(), albumID, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+		assert.Empty(t, token)
+	})
+
+	t.Run("SuccessRevoke", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Return(true)
+		mockRepo.EXPECT().SetShareToken(gomock.Any(), albumID, nil).Return(nil)
+
+		assert.NoError(t, albumUC.RevokeShare(context.Background(), albumID, mockUser))
+	})
+}
+
 func TestAlbumUseCase_Delete(t *testing.T) {
 	t.Parallel()
 
@@ -225,8 +494,13 @@ func TestAlbumUseCase_Delete(t *testing.T) {
 	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
 	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
 	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
 
-	albumUC := usecase.NewAlbumUseCase(mockRepo, mockACL, mockImageUC)
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
 
 	albumID := domain.ID(1)
 	authorID := domain.ID(2)
@@ -290,8 +564,13 @@ func TestAlbumUseCase_Update(t *testing.T) {
 	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
 	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
 	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
 
-	albumUC := usecase.NewAlbumUseCase(mockRepo, mockACL, mockImageUC)
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
 
 	albumID := domain.ID(1)
 	authorID := domain.ID(2)
@@ -359,8 +638,13 @@ func TestAlbumUseCase_ExistsAndModifiable(t *testing.T) {
 	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
 	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
 	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
 
-	albumUC := usecase.NewAlbumUseCase(mockRepo, mockACL, mockImageUC)
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
 
 	albumID := domain.ID(1)
 	authorID := domain.ID(2)
@@ -411,8 +695,13 @@ func TestAlbumUseCase_PutImage(t *testing.T) {
 	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
 	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
 	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
 
-	albumUC := usecase.NewAlbumUseCase(mockRepo, mockACL, mockImageUC)
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
 
 	imageID := domain.ID(1)
 	albumID := domain.ID(2)
@@ -423,24 +712,58 @@ func TestAlbumUseCase_PutImage(t *testing.T) {
 
 	t.Run("SuccessPutImage", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
-		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Return(true)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanPutImage(mockUser, mockAlbum, nil).Return(true)
 
 		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanAttachImage(mockUser, mockImage).Return(true)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, imageID).Return(nil, repository.ErrNotFound)
 
-		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID).Return(nil)
+		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID, mockUser.ID).Return(nil)
 
 		err := albumUC.PutImage(context.Background(), albumID, imageID, mockUser)
 
 		assert.NoError(t, err)
 	})
 
+	t.Run("ConcurrentDuplicate", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanPutImage(mockUser, mockAlbum, nil).Return(true)
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanAttachImage(mockUser, mockImage).Return(true)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, imageID).Return(nil, repository.ErrNotFound)
+
+		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID, mockUser.ID).Return(repository.ErrAlreadyExists)
+
+		err := albumUC.PutImage(context.Background(), albumID, imageID, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrAlreadyExists)
+	})
+
+	t.Run("SmartAlbum", func(t *testing.T) {
+		smartAlbum := &domain.Album{ID: albumID, Kind: domain.AlbumKindSmart}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(smartAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanPutImage(mockUser, smartAlbum, nil).Return(true)
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Times(0)
+		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID, mockUser.ID).Times(0)
+
+		err := albumUC.PutImage(context.Background(), albumID, imageID, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+	})
+
 	t.Run("AlbumNotFound", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(nil, repository.ErrNotFound)
-		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Times(0)
+		mockACL.EXPECT().CanPutImage(mockUser, mockAlbum, nil).Times(0)
 
 		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Times(0)
 
-		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID).Times(0)
+		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID, mockUser.ID).Times(0)
 
 		err := albumUC.PutImage(context.Background(), albumID, imageID, mockUser)
 
@@ -450,11 +773,12 @@ func TestAlbumUseCase_PutImage(t *testing.T) {
 
 	t.Run("Forbidden", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
-		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Return(false)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanPutImage(mockUser, mockAlbum, nil).Return(false)
 
 		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Times(0)
 
-		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID).Times(0)
+		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID, mockUser.ID).Times(0)
 
 		err := albumUC.PutImage(context.Background(), albumID, imageID, mockUser)
 
@@ -464,11 +788,12 @@ func TestAlbumUseCase_PutImage(t *testing.T) {
 
 	t.Run("IncorrectImageRef", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
-		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Return(true)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanPutImage(mockUser, mockAlbum, nil).Return(true)
 
 		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(nil, usecase.ErrNotFound)
 
-		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID).Times(0)
+		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID, mockUser.ID).Times(0)
 
 		err := albumUC.PutImage(context.Background(), albumID, imageID, mockUser)
 
@@ -478,12 +803,14 @@ func TestAlbumUseCase_PutImage(t *testing.T) {
 
 	t.Run("InvalidImage", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
-		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Return(true)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanPutImage(mockUser, mockAlbum, nil).Return(true)
 
 		invalidImage := &domain.Image{ID: imageID, AccessLevel: domain.ImageAccessPrivate}
 		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(invalidImage, nil)
+		mockACL.EXPECT().CanAttachImage(mockUser, invalidImage).Return(false)
 
-		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID).Times(0)
+		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID, mockUser.ID).Times(0)
 
 		err := albumUC.PutImage(context.Background(), albumID, imageID, mockUser)
 
@@ -493,16 +820,73 @@ func TestAlbumUseCase_PutImage(t *testing.T) {
 
 	t.Run("RepoError", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
-		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Return(true)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanPutImage(mockUser, mockAlbum, nil).Return(true)
 
 		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanAttachImage(mockUser, mockImage).Return(true)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, imageID).Return(nil, repository.ErrNotFound)
 
-		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID).Return(errors.New("repo error"))
+		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID, mockUser.ID).Return(errors.New("repo error"))
 
 		err := albumUC.PutImage(context.Background(), albumID, imageID, mockUser)
 
 		assert.Error(t, err)
 	})
+
+	t.Run("SuccessPutOwnPrivateImage", func(t *testing.T) {
+		ownImage := &domain.Image{ID: imageID, AuthorID: mockUser.ID, AccessLevel: domain.ImageAccessPrivate}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanPutImage(mockUser, mockAlbum, nil).Return(true)
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(ownImage, nil)
+		mockACL.EXPECT().CanAttachImage(mockUser, ownImage).Return(true)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, imageID).Return(nil, repository.ErrNotFound)
+
+		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID, mockUser.ID).Return(nil)
+
+		err := albumUC.PutImage(context.Background(), albumID, imageID, mockUser)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("AlreadyInAlbum", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanPutImage(mockUser, mockAlbum, nil).Return(true)
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanAttachImage(mockUser, mockImage).Return(true)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, imageID).Return(&mockUser.ID, nil)
+
+		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID, mockUser.ID).Times(0)
+
+		err := albumUC.PutImage(context.Background(), albumID, imageID, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrAlreadyExists)
+	})
+
+	t.Run("SuccessPutImageAsContributor", func(t *testing.T) {
+		member := &domain.AlbumMember{
+			AlbumID: albumID, UserID: mockUser.ID, Role: domain.AlbumRoleContributor, Accepted: true,
+		}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(member, nil)
+		mockACL.EXPECT().CanPutImage(mockUser, mockAlbum, member).Return(true)
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanAttachImage(mockUser, mockImage).Return(true)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, imageID).Return(nil, repository.ErrNotFound)
+
+		mockRepo.EXPECT().PutImage(gomock.Any(), albumID, imageID, mockUser.ID).Return(nil)
+
+		err := albumUC.PutImage(context.Background(), albumID, imageID, mockUser)
+
+		assert.NoError(t, err)
+	})
 }
 
 func TestAlbumUseCase_DeleteImage(t *testing.T) {
@@ -514,21 +898,26 @@ func TestAlbumUseCase_DeleteImage(t *testing.T) {
 	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
 	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
 	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
 
-	albumUC := usecase.NewAlbumUseCase(mockRepo, mockACL, mockImageUC)
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
 
 	imageID := domain.ID(1)
 	albumID := domain.ID(2)
 
-	mockImage := &domain.Image{ID: imageID, AccessLevel: domain.ImageAccessPublic}
 	mockAlbum := &domain.Album{ID: albumID}
 	mockUser := &domain.User{ID: 1}
+	addedBy := &mockUser.ID
 
 	t.Run("SuccessDeleteImage", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
-		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Return(true)
-
-		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, imageID).Return(addedBy, nil)
+		mockACL.EXPECT().CanDeleteImage(mockUser, mockAlbum, nil, addedBy).Return(true)
 
 		mockRepo.EXPECT().DeleteImage(gomock.Any(), albumID, imageID).Return(nil)
 
@@ -539,9 +928,7 @@ func TestAlbumUseCase_DeleteImage(t *testing.T) {
 
 	t.Run("AlbumNotFound", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(nil, repository.ErrNotFound)
-		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Times(0)
-
-		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Times(0)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, imageID).Times(0)
 
 		mockRepo.EXPECT().DeleteImage(gomock.Any(), albumID, imageID).Times(0)
 
@@ -553,9 +940,9 @@ func TestAlbumUseCase_DeleteImage(t *testing.T) {
 
 	t.Run("Forbidden", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
-		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Return(false)
-
-		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Times(0)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, imageID).Return(nil, nil)
+		mockACL.EXPECT().CanDeleteImage(mockUser, mockAlbum, nil, nil).Return(false)
 
 		mockRepo.EXPECT().DeleteImage(gomock.Any(), albumID, imageID).Times(0)
 
@@ -567,9 +954,8 @@ func TestAlbumUseCase_DeleteImage(t *testing.T) {
 
 	t.Run("IncorrectImageRef", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
-		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Return(true)
-
-		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(nil, usecase.ErrNotFound)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, imageID).Return(nil, repository.ErrNotFound)
 
 		mockRepo.EXPECT().DeleteImage(gomock.Any(), albumID, imageID).Times(0)
 
@@ -579,31 +965,328 @@ func TestAlbumUseCase_DeleteImage(t *testing.T) {
 		assert.Equal(t, usecase.ErrIncorrectImageRef, err)
 	})
 
-	t.Run("InvalidImage", func(t *testing.T) {
+	t.Run("RepoError", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
-		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Return(true)
-
-		invalidImage := &domain.Image{ID: imageID, AccessLevel: domain.ImageAccessPrivate}
-		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(invalidImage, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, imageID).Return(addedBy, nil)
+		mockACL.EXPECT().CanDeleteImage(mockUser, mockAlbum, nil, addedBy).Return(true)
 
-		mockRepo.EXPECT().DeleteImage(gomock.Any(), albumID, imageID).Times(0)
+		mockRepo.EXPECT().DeleteImage(gomock.Any(), albumID, imageID).Return(errors.New("repo error"))
 
 		err := albumUC.DeleteImage(context.Background(), albumID, imageID, mockUser)
 
 		assert.Error(t, err)
-		assert.Equal(t, usecase.ErrIncorrectImageRef, err)
 	})
+}
 
-	t.Run("RepoError", func(t *testing.T) {
+func TestAlbumUseCase_Arrange(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
+	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
+	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
+
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
+
+	imageID := domain.ID(1)
+	albumID := domain.ID(2)
+
+	mockAlbum := &domain.Album{ID: albumID}
+	mockUser := &domain.User{ID: 3}
+
+	expectArranger := func(canArrange bool) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
-		mockACL.EXPECT().CanModify(mockUser, mockAlbum).Return(true)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanArrange(mockUser, mockAlbum, nil).Return(canArrange)
+	}
 
-		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+	t.Run("SuccessMoveImage", func(t *testing.T) {
+		expectArranger(true)
+		mockRepo.EXPECT().MoveImage(gomock.Any(), albumID, imageID, 2).Return(nil)
 
-		mockRepo.EXPECT().DeleteImage(gomock.Any(), albumID, imageID).Return(errors.New("repo error"))
+		err := albumUC.MoveImage(context.Background(), albumID, imageID, 2, mockUser)
 
-		err := albumUC.DeleteImage(context.Background(), albumID, imageID, mockUser)
+		assert.NoError(t, err)
+	})
 
-		assert.Error(t, err)
+	t.Run("MoveImageForbidden", func(t *testing.T) {
+		expectArranger(false)
+		mockRepo.EXPECT().MoveImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := albumUC.MoveImage(context.Background(), albumID, imageID, 2, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+	})
+
+	t.Run("MoveMissingImage", func(t *testing.T) {
+		expectArranger(true)
+		mockRepo.EXPECT().MoveImage(gomock.Any(), albumID, imageID, 0).Return(repository.ErrNotFound)
+
+		err := albumUC.MoveImage(context.Background(), albumID, imageID, 0, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrIncorrectImageRef)
+	})
+
+	t.Run("SuccessSetImagesOrder", func(t *testing.T) {
+		order := []domain.ID{imageID, 4}
+		expectArranger(true)
+		mockRepo.EXPECT().SetImagesOrder(gomock.Any(), albumID, order).Return(nil)
+
+		err := albumUC.SetImagesOrder(context.Background(), albumID, order, mockUser)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("IncompleteImagesOrder", func(t *testing.T) {
+		order := []domain.ID{imageID}
+		expectArranger(true)
+		mockRepo.EXPECT().SetImagesOrder(gomock.Any(), albumID, order).Return(repository.ErrIncorrectInput)
+
+		err := albumUC.SetImagesOrder(context.Background(), albumID, order, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+	})
+
+	t.Run("SuccessSetCover", func(t *testing.T) {
+		expectArranger(true)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, imageID).Return(nil, nil)
+		mockRepo.EXPECT().SetCover(gomock.Any(), albumID, &imageID).Return(nil)
+
+		err := albumUC.SetCover(context.Background(), albumID, &imageID, mockUser)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("SuccessResetCover", func(t *testing.T) {
+		expectArranger(true)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockRepo.EXPECT().SetCover(gomock.Any(), albumID, nil).Return(nil)
+
+		err := albumUC.SetCover(context.Background(), albumID, nil, mockUser)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("CoverNotInAlbum", func(t *testing.T) {
+		expectArranger(true)
+		mockRepo.EXPECT().ImageAddedBy(gomock.Any(), albumID, imageID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().SetCover(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := albumUC.SetCover(context.Background(), albumID, &imageID, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrIncorrectImageRef)
+	})
+}
+
+func TestAlbumUseCase_InviteMember(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
+	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
+	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
+
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
+
+	albumID := domain.ID(1)
+	userID := domain.ID(2)
+	role := domain.AlbumRoleContributor
+
+	mockUser := &domain.User{ID: 3, Username: "owner"}
+	mockAlbum := &domain.Album{ID: albumID, AuthorID: mockUser.ID}
+
+	expectManager := func(canManage bool) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanManageMember(mockUser, mockAlbum, nil, role).Return(canManage)
+	}
+
+	t.Run("SuccessInviteMember", func(t *testing.T) {
+		expectManager(true)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, userID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().AddMember(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, member *domain.AlbumMember) error {
+				assert.Equal(t, userID, member.UserID)
+				assert.Equal(t, role, member.Role)
+				assert.False(t, member.Accepted)
+				return nil
+			},
+		)
+		mockNotifMng.EXPECT().Notify(gomock.Any(), userID, gomock.Any()).Return(nil)
+
+		err := albumUC.InviteMember(context.Background(), albumID, userID, role, mockUser)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		expectManager(false)
+		mockRepo.EXPECT().AddMember(gomock.Any(), gomock.Any()).Times(0)
+
+		err := albumUC.InviteMember(context.Background(), albumID, userID, role, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+	})
+
+	t.Run("InviteAuthor", func(t *testing.T) {
+		expectManager(true)
+		mockRepo.EXPECT().AddMember(gomock.Any(), gomock.Any()).Times(0)
+
+		err := albumUC.InviteMember(context.Background(), albumID, mockUser.ID, role, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+	})
+
+	t.Run("AlreadyMember", func(t *testing.T) {
+		expectManager(true)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, userID).Return(&domain.AlbumMember{}, nil)
+		mockRepo.EXPECT().AddMember(gomock.Any(), gomock.Any()).Times(0)
+
+		err := albumUC.InviteMember(context.Background(), albumID, userID, role, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrAlreadyExists)
+	})
+
+	t.Run("IncorrectUserRef", func(t *testing.T) {
+		expectManager(true)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, userID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().AddMember(gomock.Any(), gomock.Any()).Return(repository.ErrNotFound)
+
+		err := albumUC.InviteMember(context.Background(), albumID, userID, role, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrIncorrectUserRef)
+	})
+}
+
+func TestAlbumUseCase_RemoveMember(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
+	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
+	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
+
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
+
+	albumID := domain.ID(1)
+	mockAlbum := &domain.Album{ID: albumID, AuthorID: 10}
+	mockUser := &domain.User{ID: 2}
+	target := &domain.AlbumMember{AlbumID: albumID, UserID: 3, Role: domain.AlbumRoleViewer, Accepted: true}
+
+	t.Run("SuccessRemoveMember", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, target.UserID).Return(target, nil)
+		mockACL.EXPECT().CanManageMember(mockUser, mockAlbum, nil, target.Role).Return(true)
+		mockRepo.EXPECT().RemoveMember(gomock.Any(), albumID, target.UserID).Return(nil)
+
+		err := albumUC.RemoveMember(context.Background(), albumID, target.UserID, mockUser)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("SuccessLeave", func(t *testing.T) {
+		self := &domain.AlbumMember{AlbumID: albumID, UserID: mockUser.ID, Role: domain.AlbumRoleViewer}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(self, nil).Times(2)
+		mockRepo.EXPECT().RemoveMember(gomock.Any(), albumID, mockUser.ID).Return(nil)
+
+		err := albumUC.RemoveMember(context.Background(), albumID, mockUser.ID, mockUser)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, target.UserID).Return(target, nil)
+		mockACL.EXPECT().CanManageMember(mockUser, mockAlbum, nil, target.Role).Return(false)
+		mockRepo.EXPECT().RemoveMember(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := albumUC.RemoveMember(context.Background(), albumID, target.UserID, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+	})
+
+	t.Run("MemberNotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), albumID).Return(mockAlbum, nil)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, target.UserID).Return(nil, repository.ErrNotFound)
+
+		err := albumUC.RemoveMember(context.Background(), albumID, target.UserID, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
+
+func TestAlbumUseCase_AcceptInvitation(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockAlbumRepository(ctrl)
+	mockACL := usecaseMock.NewMockAlbumAccessPolicy(ctrl)
+	mockImageUC := usecaseMock.NewMockAlbumImageUseCase(ctrl)
+	mockFeaturesUC := usecaseMock.NewMockAlbumFeaturesUseCase(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockLogger := loggerMock.NewMockLogger(ctrl)
+
+	albumUC := usecase.NewAlbumUseCase(
+		mockRepo, mockACL, mockImageUC, mockFeaturesUC, mockNotifMng, mockLogger,
+	)
+
+	albumID := domain.ID(1)
+	mockUser := &domain.User{ID: 2}
+
+	t.Run("SuccessAccept", func(t *testing.T) {
+		member := &domain.AlbumMember{AlbumID: albumID, UserID: mockUser.ID}
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(member, nil)
+		mockRepo.EXPECT().AcceptMember(gomock.Any(), albumID, mockUser.ID).Return(nil)
+
+		err := albumUC.AcceptInvitation(context.Background(), albumID, mockUser)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("AlreadyAccepted", func(t *testing.T) {
+		member := &domain.AlbumMember{AlbumID: albumID, UserID: mockUser.ID, Accepted: true}
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(member, nil)
+		mockRepo.EXPECT().AcceptMember(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := albumUC.AcceptInvitation(context.Background(), albumID, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrAlreadyExists)
+	})
+
+	t.Run("NotInvited", func(t *testing.T) {
+		mockRepo.EXPECT().GetMember(gomock.Any(), albumID, mockUser.ID).Return(nil, repository.ErrNotFound)
+
+		err := albumUC.AcceptInvitation(context.Background(), albumID, mockUser)
+
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
 	})
 }
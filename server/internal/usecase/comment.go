@@ -12,13 +12,31 @@ import (
 type CommentRepository interface {
 	Create(ctx context.Context, comment *domain.Comment) (*domain.Comment, error)
 	GetByImageID(
-		ctx context.Context, imageID domain.ID, pagInput *domain.PaginationInput, sort domain.CommentSortMethod,
+		ctx context.Context,
+		imageID domain.ID,
+		pagInput *domain.PaginationInput,
+		sort domain.CommentSortMethod,
+		vis *domain.CommentVisibility,
 	) (*domain.Pagination[domain.DetailedComment], error)
 	GetByID(ctx context.Context, imageID domain.ID) (*domain.Comment, error)
 	Delete(ctx context.Context, commentID domain.ID) error
-	Update(ctx context.Context, commentID domain.ID, comment *domain.Comment) (*domain.Comment, error)
-	HasUserCommented(ctx context.Context, commentID domain.ID, userID domain.ID) (bool, error)
-	GetReplies(ctx context.Context, commentID domain.ID, userID *domain.ID) ([]domain.DetailedComment, error)
+	SoftDelete(ctx context.Context, commentID domain.ID, executorID domain.ID) error
+	CountReplies(ctx context.Context, commentID domain.ID) (int, error)
+	Update(
+		ctx context.Context, commentID domain.ID, comment *domain.Comment, editorID domain.ID,
+	) (*domain.Comment, error)
+	GetRevisions(ctx context.Context, commentID domain.ID) ([]domain.CommentRevision, error)
+	GetReplies(
+		ctx context.Context, commentID domain.ID, pagInput *domain.PaginationInput, vis *domain.CommentVisibility,
+	) (*domain.Pagination[domain.DetailedComment], error)
+	GetThreads(
+		ctx context.Context, rootIDs []domain.ID, maxDepth int, vis *domain.CommentVisibility,
+	) ([]domain.DetailedComment, error)
+	ReplaceMentions(ctx context.Context, commentID domain.ID, usernames []string) ([]domain.CommentMention, error)
+	GetMentions(ctx context.Context, commentIDs []domain.ID) (map[domain.ID][]domain.CommentMention, error)
+	Pin(ctx context.Context, imageID domain.ID, commentID domain.ID) error
+	Unpin(ctx context.Context, commentID domain.ID) error
+	SetHidden(ctx context.Context, commentID domain.ID, hidden bool) error
 
 	LikeComment(ctx context.Context, commentID domain.ID, userID domain.ID) error
 	UnlikeComment(ctx context.Context, commentID domain.ID, userID domain.ID) error
@@ -27,46 +45,117 @@ type CommentRepository interface {
 
 type CommentAccessPolicy interface {
 	CanModify(user *domain.User, comment *domain.Comment) bool
+	CanModerate(user *domain.User) bool
+	CanModerateImage(user *domain.User, image *domain.Image) bool
 }
 
 type CommentImageUseCase interface {
 	GetByID(ctx context.Context, imageID domain.ID) (*domain.Image, error)
+	CanViewAuthor(ctx context.Context, authorID domain.ID, executor *domain.User) (bool, error)
+	SetCommentsLocked(ctx context.Context, imageID domain.ID, locked bool) error
+}
+
+type CommentRestrictions interface {
+	IsBlocked(ctx context.Context, userID, otherID domain.ID) (bool, error)
 }
 
+const defaultCommentMaxDepth = 8
+
 type commentUseCase struct {
-	repo    CommentRepository
-	acl     CommentAccessPolicy
-	imageUC CommentImageUseCase
-	logger  logger.Logger
+	repo         CommentRepository
+	acl          CommentAccessPolicy
+	imageUC      CommentImageUseCase
+	notifMng     NotificationManager
+	reactionUC   ReactionCounter
+	restrictions CommentRestrictions
+	maxDepth     int
+	logger       logger.Logger
 }
 
+// NewCommentUseCase limits the reply nesting with maxDepth, the default depth is used when it's not positive
 func NewCommentUseCase(
 	repo CommentRepository,
 	acl CommentAccessPolicy,
 	imageUC CommentImageUseCase,
+	notifMng NotificationManager,
+	reactionUC ReactionCounter,
+	restrictions CommentRestrictions,
+	maxDepth int,
 	logger logger.Logger,
 ) *commentUseCase {
-	return &commentUseCase{repo: repo, acl: acl, imageUC: imageUC, logger: logger}
+	if maxDepth <= 0 {
+		maxDepth = defaultCommentMaxDepth
+	}
+
+	return &commentUseCase{
+		repo:         repo,
+		acl:          acl,
+		imageUC:      imageUC,
+		notifMng:     notifMng,
+		reactionUC:   reactionUC,
+		restrictions: restrictions,
+		maxDepth:     maxDepth,
+		logger:       logger,
+	}
 }
 
 func (uc *commentUseCase) Create(
 	ctx context.Context,
 	comment *domain.Comment,
+	executor *domain.User,
 ) (*domain.Comment, error) {
-	if _, err := uc.imageUC.GetByID(ctx, comment.ImageID); err != nil {
+	img, err := uc.imageUC.GetByID(ctx, comment.ImageID)
+	if err != nil {
 		return nil, ErrIncorrectImageRef
 	}
 
-	commented, err := uc.repo.HasUserCommented(ctx, comment.ImageID, comment.AuthorID)
+	if img.CommentsLocked && !uc.acl.CanModerateImage(executor, img) {
+		return nil, ErrForbidden
+	}
+
+	if img.AuthorID != executor.ID {
+		blocked, err := uc.restrictions.IsBlocked(ctx, img.AuthorID, executor.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "CommentUseCase.Create.IsBlocked")
+		}
+
+		if blocked {
+			return nil, ErrForbidden
+		}
+	}
+
+	comment.Depth = 0
+	if comment.ParentID != nil {
+		parent, err := uc.GetByID(ctx, *comment.ParentID)
+		if err != nil {
+			return nil, err
+		}
+
+		if parent.IsDeleted() || (parent.IsHidden() && !uc.visibility(img, executor).CanSee(parent)) {
+			return nil, ErrNotFound
+		}
+
+		if parent.ImageID != comment.ImageID || parent.Depth >= uc.maxDepth {
+			return nil, ErrUnprocessable
+		}
+
+		comment.Depth = parent.Depth + 1
+	}
+
+	createdCmt, err := uc.repo.Create(ctx, comment)
 	if err != nil {
-		return nil, errors.Wrap(err, "commentUseCase.Create.HasUserCommented")
+		return nil, err
 	}
 
-	if commented {
-		return nil, ErrAlreadyExists
+	createdCmt.Mentions, err = uc.repo.ReplaceMentions(ctx, createdCmt.ID, parseMentions(createdCmt.Text))
+	if err != nil {
+		uc.logger.Errorf("CommentUseCase.Create.ReplaceMentions: %v", err)
 	}
 
-	return uc.repo.Create(ctx, comment)
+	uc.notifyMentioned(ctx, img, createdCmt.Mentions, executor)
+	uc.notifyImageAuthor(ctx, img, createdCmt, executor)
+
+	return createdCmt, nil
 }
 
 func (uc *commentUseCase) GetByImageID(
@@ -74,17 +163,52 @@ func (uc *commentUseCase) GetByImageID(
 	imageID domain.ID,
 	pagInput *domain.PaginationInput,
 	sort domain.CommentSortMethod,
+	mode domain.CommentViewMode,
+	executor *domain.User,
 ) (*domain.Pagination[domain.DetailedComment], error) {
-	if _, err := uc.imageUC.GetByID(ctx, imageID); err != nil {
+	img, err := uc.imageUC.GetByID(ctx, imageID)
+	if err != nil {
 		return nil, ErrIncorrectImageRef
 	}
 
-	pag, err := uc.repo.GetByImageID(ctx, imageID, pagInput, sort)
-	if err != nil && errors.Is(err, repository.ErrIncorrectInput) {
-		return nil, ErrUnprocessable
+	if err := uc.checkAuthorAccess(ctx, img, executor); err != nil {
+		return nil, err
+	}
+
+	vis := uc.visibility(img, executor)
+	pag, err := uc.repo.GetByImageID(ctx, imageID, pagInput, sort, vis)
+	if err != nil {
+		if errors.Is(err, repository.ErrIncorrectInput) {
+			return nil, ErrUnprocessable
+		}
+		return nil, err
+	}
+
+	rootIDs := make([]domain.ID, len(pag.Items))
+	for i, cmt := range pag.Items {
+		rootIDs[i] = cmt.ID
+	}
+
+	replies, err := uc.repo.GetThreads(ctx, rootIDs, uc.maxDepth, vis)
+	if err != nil {
+		return nil, errors.Wrap(err, "commentUseCase.GetByImageID.GetThreads")
+	}
+
+	if err := uc.attachMentions(ctx, pag.Items, replies); err != nil {
+		return nil, errors.Wrap(err, "commentUseCase.GetByImageID.attachMentions")
 	}
 
-	return pag, err
+	if err := uc.attachReactions(ctx, vis.ViewerID, pag.Items, replies); err != nil {
+		return nil, errors.Wrap(err, "commentUseCase.GetByImageID.attachReactions")
+	}
+
+	if mode == domain.CommentFlatView {
+		pag.Items = flattenCommentThreads(pag.Items, replies)
+	} else {
+		pag.Items = buildCommentThreads(pag.Items, replies)
+	}
+
+	return pag, nil
 }
 
 func (uc *commentUseCase) GetByID(ctx context.Context, commentID domain.ID) (*domain.Comment, error) {
@@ -99,17 +223,59 @@ func (uc *commentUseCase) GetByID(ctx context.Context, commentID domain.ID) (*do
 	return cmt, nil
 }
 
+// Delete leaves the tombstone in place of the comment with replies to keep the thread intact,
+// the tombstones left without replies are removed along the way
 func (uc *commentUseCase) Delete(ctx context.Context, commentID domain.ID, executor *domain.User) error {
 	cmt, err := uc.GetByID(ctx, commentID)
 	if err != nil {
 		return err
 	}
 
+	if cmt.IsDeleted() {
+		return ErrNotFound
+	}
+
 	if canModify := uc.acl.CanModify(executor, cmt); !canModify {
 		return ErrForbidden
 	}
 
-	return uc.repo.Delete(ctx, commentID)
+	replies, err := uc.repo.CountReplies(ctx, commentID)
+	if err != nil {
+		return errors.Wrap(err, "commentUseCase.Delete.CountReplies")
+	}
+
+	if replies != 0 {
+		return uc.repo.SoftDelete(ctx, commentID, executor.ID)
+	}
+
+	if err := uc.repo.Delete(ctx, commentID); err != nil {
+		return err
+	}
+
+	uc.pruneTombstones(ctx, cmt.ParentID)
+	return nil
+}
+
+// pruneTombstones removes the tombstone ancestors that have no replies anymore
+func (uc *commentUseCase) pruneTombstones(ctx context.Context, parentID *domain.ID) {
+	for parentID != nil {
+		parent, err := uc.repo.GetByID(ctx, *parentID)
+		if err != nil || !parent.IsDeleted() {
+			return
+		}
+
+		replies, err := uc.repo.CountReplies(ctx, parent.ID)
+		if err != nil || replies != 0 {
+			return
+		}
+
+		if err := uc.repo.Delete(ctx, parent.ID); err != nil {
+			uc.logger.Errorf("CommentUseCase.pruneTombstones.Delete: %v", err)
+			return
+		}
+
+		parentID = parent.ParentID
+	}
 }
 
 func (uc *commentUseCase) Update(
@@ -123,28 +289,120 @@ func (uc *commentUseCase) Update(
 		return nil, err
 	}
 
+	if cmt.IsDeleted() {
+		return nil, ErrNotFound
+	}
+
 	if canModify := uc.acl.CanModify(executor, cmt); !canModify {
 		return nil, ErrForbidden
 	}
 
-	return uc.repo.Update(ctx, commentID, comment)
+	prevMentions, err := uc.repo.GetMentions(ctx, []domain.ID{commentID})
+	if err != nil {
+		return nil, errors.Wrap(err, "commentUseCase.Update.GetMentions")
+	}
+
+	updatedCmt, err := uc.repo.Update(ctx, commentID, comment, executor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedCmt.Mentions, err = uc.repo.ReplaceMentions(ctx, commentID, parseMentions(updatedCmt.Text))
+	if err != nil {
+		uc.logger.Errorf("CommentUseCase.Update.ReplaceMentions: %v", err)
+		return updatedCmt, nil
+	}
+
+	// Only the users who weren't mentioned before the edit are notified
+	added := newMentions(prevMentions[commentID], updatedCmt.Mentions)
+	if len(added) != 0 {
+		if img, err := uc.imageUC.GetByID(ctx, updatedCmt.ImageID); err == nil {
+			uc.notifyMentioned(ctx, img, added, executor)
+		}
+	}
+
+	return updatedCmt, nil
+}
+
+func (uc *commentUseCase) GetReplies(
+	ctx context.Context,
+	commentID domain.ID,
+	pagInput *domain.PaginationInput,
+	executor *domain.User,
+) (*domain.Pagination[domain.DetailedComment], error) {
+	cmt, err := uc.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := uc.imageUC.GetByID(ctx, cmt.ImageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkAuthorAccess(ctx, img, executor); err != nil {
+		return nil, err
+	}
+
+	vis := uc.visibility(img, executor)
+	if !vis.CanSee(cmt) {
+		return nil, ErrNotFound
+	}
+
+	pag, err := uc.repo.GetReplies(ctx, commentID, pagInput, vis)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.attachMentions(ctx, pag.Items); err != nil {
+		return nil, errors.Wrap(err, "commentUseCase.GetReplies.attachMentions")
+	}
+
+	if err := uc.attachReactions(ctx, vis.ViewerID, pag.Items); err != nil {
+		return nil, errors.Wrap(err, "commentUseCase.GetReplies.attachReactions")
+	}
+
+	return pag, nil
+}
+
+// checkAuthorAccess hides the comments of the images whose author is private for the executor or blocked with them
+func (uc *commentUseCase) checkAuthorAccess(ctx context.Context, img *domain.Image, executor *domain.User) error {
+	canView, err := uc.imageUC.CanViewAuthor(ctx, img.AuthorID, executor)
+	if err != nil {
+		return errors.Wrap(err, "commentUseCase.checkAuthorAccess")
+	}
+	if !canView {
+		return ErrForbidden
+	}
+
+	return nil
 }
 
-func (uc *commentUseCase) GetReplies(ctx context.Context, commentID domain.ID, executorID *domain.ID) ([]domain.DetailedComment, error) {
-	// TODO: potentially omit comment existence check
+// GetRevisions returns the previous texts of the comment from the newest, only for moderators
+func (uc *commentUseCase) GetRevisions(
+	ctx context.Context, commentID domain.ID, executor *domain.User,
+) ([]domain.CommentRevision, error) {
 	if _, err := uc.GetByID(ctx, commentID); err != nil {
 		return nil, err
 	}
 
-	return uc.repo.GetReplies(ctx, commentID, executorID)
+	if !uc.acl.CanModerate(executor) {
+		return nil, ErrForbidden
+	}
+
+	return uc.repo.GetRevisions(ctx, commentID)
 }
 
 func (uc *commentUseCase) LikeComment(ctx context.Context, commentID domain.ID, executor *domain.User) error {
-	_, err := uc.GetByID(ctx, commentID)
+	cmt, err := uc.GetByID(ctx, commentID)
 	if err != nil {
 		return err
 	}
 
+	if cmt.IsDeleted() {
+		return ErrNotFound
+	}
+
 	liked, err := uc.repo.HasUserLikedComment(ctx, commentID, executor.ID)
 	if liked {
 		return ErrAlreadyExists
@@ -169,3 +427,108 @@ func (uc *commentUseCase) UnlikeComment(ctx context.Context, commentID domain.ID
 
 	return nil
 }
+
+// attachMentions fills the mentions of every comment of the given slices in place,
+// the tombstones are stripped of the author instead
+func (uc *commentUseCase) attachMentions(ctx context.Context, cmtSlices ...[]domain.DetailedComment) error {
+	ids := make([]domain.ID, 0)
+	for _, cmts := range cmtSlices {
+		for _, cmt := range cmts {
+			ids = append(ids, cmt.ID)
+		}
+	}
+
+	mentions, err := uc.repo.GetMentions(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, cmts := range cmtSlices {
+		for i := range cmts {
+			if cmts[i].IsDeleted() {
+				cmts[i].Author = domain.CommentAuthor{}
+				continue
+			}
+			cmts[i].Mentions = mentions[cmts[i].ID]
+		}
+	}
+
+	return nil
+}
+
+// attachReactions fills the reactions of every comment of the given slices in place,
+// the reactions of the viewer are marked when it's known
+func (uc *commentUseCase) attachReactions(
+	ctx context.Context, viewerID *domain.ID, cmtSlices ...[]domain.DetailedComment,
+) error {
+	ids := make([]domain.ID, 0)
+	for _, cmts := range cmtSlices {
+		for _, cmt := range cmts {
+			ids = append(ids, cmt.ID)
+		}
+	}
+
+	reactions, err := uc.reactionUC.Counts(ctx, domain.ReactionTargetComment, ids, viewerID)
+	if err != nil {
+		return err
+	}
+
+	for _, cmts := range cmtSlices {
+		for i := range cmts {
+			cmts[i].Reactions = orEmptyReactions(reactions[cmts[i].ID])
+		}
+	}
+
+	return nil
+}
+
+// buildCommentThreads nests the replies into their parents,
+// replies whose parent isn't among the roots or replies are dropped
+func buildCommentThreads(roots []domain.DetailedComment, replies []domain.DetailedComment) []domain.DetailedComment {
+	children := groupCommentReplies(replies)
+
+	var attach func(cmt domain.DetailedComment) domain.DetailedComment
+	attach = func(cmt domain.DetailedComment) domain.DetailedComment {
+		for _, reply := range children[cmt.ID] {
+			cmt.Replies = append(cmt.Replies, attach(reply))
+		}
+		return cmt
+	}
+
+	threads := make([]domain.DetailedComment, len(roots))
+	for i, root := range roots {
+		threads[i] = attach(root)
+	}
+
+	return threads
+}
+
+// flattenCommentThreads lists every root followed by its replies in depth-first order
+func flattenCommentThreads(roots []domain.DetailedComment, replies []domain.DetailedComment) []domain.DetailedComment {
+	children := groupCommentReplies(replies)
+	flat := make([]domain.DetailedComment, 0, len(roots)+len(replies))
+
+	var walk func(cmt domain.DetailedComment)
+	walk = func(cmt domain.DetailedComment) {
+		flat = append(flat, cmt)
+		for _, reply := range children[cmt.ID] {
+			walk(reply)
+		}
+	}
+
+	for _, root := range roots {
+		walk(root)
+	}
+
+	return flat
+}
+
+func groupCommentReplies(replies []domain.DetailedComment) map[domain.ID][]domain.DetailedComment {
+	children := make(map[domain.ID][]domain.DetailedComment)
+	for _, reply := range replies {
+		if reply.ParentID != nil {
+			children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+		}
+	}
+	return children
+}
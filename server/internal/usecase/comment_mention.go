@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+)
+
+// The mentions are limited to prevent notification spam from a single comment
+const maxCommentMentions = 10
+
+// mentionRegexp matches @username that isn't a part of another word, e.g. an email
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@])@([\p{L}\p{N}_.\-]+)`)
+
+// parseMentions returns the unique mentioned usernames in order of appearance
+func parseMentions(text string) []string {
+	matches := mentionRegexp.FindAllStringSubmatch(text, -1)
+
+	usernames := make([]string, 0, len(matches))
+	seen := make(map[string]struct{}, len(matches))
+	for _, match := range matches {
+		// Trailing punctuation belongs to the sentence, not to the username
+		username := strings.TrimRight(match[1], ".-")
+		if username == "" {
+			continue
+		}
+
+		if _, ok := seen[username]; ok {
+			continue
+		}
+		seen[username] = struct{}{}
+
+		usernames = append(usernames, username)
+		if len(usernames) == maxCommentMentions {
+			break
+		}
+	}
+
+	return usernames
+}
+
+// newMentions returns the mentions that are absent in prev
+func newMentions(prev []domain.CommentMention, curr []domain.CommentMention) []domain.CommentMention {
+	prevIDs := make(map[domain.ID]struct{}, len(prev))
+	for _, mention := range prev {
+		prevIDs[mention.UserID] = struct{}{}
+	}
+
+	added := make([]domain.CommentMention, 0, len(curr))
+	for _, mention := range curr {
+		if _, ok := prevIDs[mention.UserID]; !ok {
+			added = append(added, mention)
+		}
+	}
+
+	return added
+}
+
+// notifyMentioned notifies the mentioned users except the executor,
+// only the author is notified about the mentions under the private image
+func (uc *commentUseCase) notifyMentioned(
+	ctx context.Context, img *domain.Image, mentions []domain.CommentMention, executor *domain.User,
+) {
+	for _, mention := range mentions {
+		if mention.UserID == executor.ID {
+			continue
+		}
+
+		if img.AccessLevel == domain.ImageAccessPrivate && mention.UserID != img.AuthorID {
+			continue
+		}
+
+		_ = uc.notifMng.Notify(ctx, mention.UserID, &domain.Notification{
+			Title:   "New mention",
+			Message: fmt.Sprintf("%s mentioned you in a comment on the image %s", executor.Username, img.ID.String()),
+			ActorID: &executor.ID,
+		})
+	}
+}
+
+// notifyImageAuthor notifies the image author about the new comment,
+// the author who is mentioned in it is already notified about the mention
+func (uc *commentUseCase) notifyImageAuthor(
+	ctx context.Context, img *domain.Image, cmt *domain.Comment, executor *domain.User,
+) {
+	if img.AuthorID == executor.ID {
+		return
+	}
+
+	for _, mention := range cmt.Mentions {
+		if mention.UserID == img.AuthorID {
+			return
+		}
+	}
+
+	_ = uc.notifMng.Notify(ctx, img.AuthorID, &domain.Notification{
+		Title:   "New comment",
+		Message: fmt.Sprintf("%s commented on your image %s", executor.Username, img.ID.String()),
+		ActorID: &executor.ID,
+	})
+}
@@ -0,0 +1,152 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	usecaseMock "github.com/pillowskiy/gopix/internal/usecase/mock"
+	loggerMock "github.com/pillowskiy/gopix/pkg/logger/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCommentUseCase_CreateMentions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockCommentImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
+	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
+
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 0, mockLog,
+	)
+
+	imageID := domain.ID(1)
+	imageAuthorID := domain.ID(5)
+	executor := &domain.User{ID: 3, Username: "author"}
+
+	mockComment := &domain.Comment{
+		ID:       2,
+		ImageID:  imageID,
+		AuthorID: executor.ID,
+		Text:     "hi @alice and @bob, @alice again mail@example.com @author @carol.",
+	}
+
+	resolved := []domain.CommentMention{
+		{UserID: 6, Username: "alice"},
+		{UserID: imageAuthorID, Username: "bob"},
+		{UserID: executor.ID, Username: "author"},
+	}
+
+	t.Run("NotifiesMentionedUsers", func(t *testing.T) {
+		img := &domain.Image{ID: imageID, AuthorID: imageAuthorID, AccessLevel: domain.ImageAccessPublic}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(img, nil)
+		mockRestrictions.EXPECT().IsBlocked(gomock.Any(), imageAuthorID, executor.ID).Return(false, nil)
+		mockRepo.EXPECT().Create(gomock.Any(), mockComment).Return(mockComment, nil)
+		mockRepo.EXPECT().
+			ReplaceMentions(gomock.Any(), mockComment.ID, []string{"alice", "bob", "author", "carol"}).
+			Return(resolved, nil)
+		mockNotifMng.EXPECT().Notify(gomock.Any(), domain.ID(6), gomock.Any()).Return(nil)
+		mockNotifMng.EXPECT().
+			Notify(gomock.Any(), imageAuthorID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ domain.ID, notif *domain.Notification) error {
+				assert.Equal(t, "New mention", notif.Title)
+				return nil
+			})
+
+		createdComment, err := commentUC.Create(context.Background(), mockComment, executor)
+		if assert.NoError(t, err) {
+			assert.Equal(t, resolved, createdComment.Mentions)
+		}
+	})
+
+	t.Run("PrivateImage", func(t *testing.T) {
+		img := &domain.Image{ID: imageID, AuthorID: imageAuthorID, AccessLevel: domain.ImageAccessPrivate}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(img, nil)
+		mockRestrictions.EXPECT().IsBlocked(gomock.Any(), imageAuthorID, executor.ID).Return(false, nil)
+		mockRepo.EXPECT().Create(gomock.Any(), mockComment).Return(mockComment, nil)
+		mockRepo.EXPECT().ReplaceMentions(gomock.Any(), mockComment.ID, gomock.Any()).Return(resolved, nil)
+		mockNotifMng.EXPECT().Notify(gomock.Any(), imageAuthorID, gomock.Any()).Return(nil)
+
+		_, err := commentUC.Create(context.Background(), mockComment, executor)
+		assert.NoError(t, err)
+	})
+}
+
+func TestCommentUseCase_UpdateMentions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockCommentImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
+	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
+
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 0, mockLog,
+	)
+
+	commentID := domain.ID(1)
+	imageID := domain.ID(2)
+	executor := &domain.User{ID: 3, Username: "author"}
+	mockComment := &domain.Comment{ID: commentID, ImageID: imageID, AuthorID: executor.ID}
+
+	alice := domain.CommentMention{UserID: 6, Username: "alice"}
+	dave := domain.CommentMention{UserID: 7, Username: "dave"}
+
+	t.Run("NotifiesOnlyNewMentions", func(t *testing.T) {
+		updated := &domain.Comment{ID: commentID, ImageID: imageID, Text: "@alice @dave"}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
+		mockACL.EXPECT().CanModify(executor, mockComment).Return(true)
+		mockRepo.EXPECT().
+			GetMentions(gomock.Any(), []domain.ID{commentID}).
+			Return(map[domain.ID][]domain.CommentMention{commentID: {alice}}, nil)
+		mockRepo.EXPECT().Update(gomock.Any(), commentID, updated, executor.ID).Return(updated, nil)
+		mockRepo.EXPECT().
+			ReplaceMentions(gomock.Any(), commentID, []string{"alice", "dave"}).
+			Return([]domain.CommentMention{alice, dave}, nil)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(&domain.Image{ID: imageID}, nil)
+		mockNotifMng.EXPECT().Notify(gomock.Any(), dave.UserID, gomock.Any()).Return(nil)
+
+		updComment, err := commentUC.Update(context.Background(), commentID, updated, executor)
+		if assert.NoError(t, err) {
+			assert.Len(t, updComment.Mentions, 2)
+		}
+	})
+
+	t.Run("NoNewMentions", func(t *testing.T) {
+		updated := &domain.Comment{ID: commentID, ImageID: imageID, Text: "fixed typo @alice"}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
+		mockACL.EXPECT().CanModify(executor, mockComment).Return(true)
+		mockRepo.EXPECT().
+			GetMentions(gomock.Any(), []domain.ID{commentID}).
+			Return(map[domain.ID][]domain.CommentMention{commentID: {alice, dave}}, nil)
+		mockRepo.EXPECT().Update(gomock.Any(), commentID, updated, executor.ID).Return(updated, nil)
+		mockRepo.EXPECT().
+			ReplaceMentions(gomock.Any(), commentID, []string{"alice"}).
+			Return([]domain.CommentMention{alice}, nil)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), gomock.Any()).Times(0)
+		mockNotifMng.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := commentUC.Update(context.Background(), commentID, updated, executor)
+		assert.NoError(t, err)
+	})
+}
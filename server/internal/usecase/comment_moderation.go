@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+)
+
+// SetPinned pins the root comment on top of the image comments, replacing the previously pinned one
+func (uc *commentUseCase) SetPinned(
+	ctx context.Context, commentID domain.ID, pinned bool, executor *domain.User,
+) error {
+	cmt, err := uc.moderatedComment(ctx, commentID, executor)
+	if err != nil {
+		return err
+	}
+
+	if !pinned {
+		return uc.repo.Unpin(ctx, commentID)
+	}
+
+	if cmt.ParentID != nil || cmt.IsHidden() {
+		return ErrUnprocessable
+	}
+
+	return uc.repo.Pin(ctx, cmt.ImageID, commentID)
+}
+
+// SetHidden hides the comment from everyone except its author and the moderators
+func (uc *commentUseCase) SetHidden(
+	ctx context.Context, commentID domain.ID, hidden bool, executor *domain.User,
+) error {
+	if _, err := uc.moderatedComment(ctx, commentID, executor); err != nil {
+		return err
+	}
+
+	return uc.repo.SetHidden(ctx, commentID, hidden)
+}
+
+// SetLocked disallows new comments on the image, the existing ones are still visible
+func (uc *commentUseCase) SetLocked(
+	ctx context.Context, imageID domain.ID, locked bool, executor *domain.User,
+) error {
+	img, err := uc.imageUC.GetByID(ctx, imageID)
+	if err != nil {
+		return err
+	}
+
+	if !uc.acl.CanModerateImage(executor, img) {
+		return ErrForbidden
+	}
+
+	return uc.imageUC.SetCommentsLocked(ctx, imageID, locked)
+}
+
+func (uc *commentUseCase) moderatedComment(
+	ctx context.Context, commentID domain.ID, executor *domain.User,
+) (*domain.Comment, error) {
+	cmt, err := uc.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmt.IsDeleted() {
+		return nil, ErrNotFound
+	}
+
+	img, err := uc.imageUC.GetByID(ctx, cmt.ImageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !uc.acl.CanModerateImage(executor, img) {
+		return nil, ErrForbidden
+	}
+
+	return cmt, nil
+}
+
+// visibility lets the authors see their hidden comments and the moderators see all of them
+func (uc *commentUseCase) visibility(img *domain.Image, executor *domain.User) *domain.CommentVisibility {
+	if executor == nil {
+		return &domain.CommentVisibility{}
+	}
+
+	return &domain.CommentVisibility{
+		ViewerID:   &executor.ID,
+		ShowHidden: uc.acl.CanModerateImage(executor, img),
+	}
+}
@@ -0,0 +1,175 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	usecaseMock "github.com/pillowskiy/gopix/internal/usecase/mock"
+	loggerMock "github.com/pillowskiy/gopix/pkg/logger/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCommentUseCase_SetPinned(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockCommentImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
+	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
+
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 0, mockLog,
+	)
+
+	commentID := domain.ID(1)
+	imageID := domain.ID(2)
+	executor := &domain.User{ID: 3}
+	mockImage := &domain.Image{ID: imageID, AuthorID: executor.ID}
+	mockComment := &domain.Comment{ID: commentID, ImageID: imageID, AuthorID: 4}
+
+	t.Run("SuccessPin", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanModerateImage(executor, mockImage).Return(true)
+		mockRepo.EXPECT().Pin(gomock.Any(), imageID, commentID).Return(nil)
+
+		assert.NoError(t, commentUC.SetPinned(context.Background(), commentID, true, executor))
+	})
+
+	t.Run("SuccessUnpin", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanModerateImage(executor, mockImage).Return(true)
+		mockRepo.EXPECT().Unpin(gomock.Any(), commentID).Return(nil)
+
+		assert.NoError(t, commentUC.SetPinned(context.Background(), commentID, false, executor))
+	})
+
+	t.Run("ReplyCannotBePinned", func(t *testing.T) {
+		parentID := domain.ID(5)
+		reply := &domain.Comment{ID: commentID, ImageID: imageID, ParentID: &parentID}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(reply, nil)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanModerateImage(executor, mockImage).Return(true)
+		mockRepo.EXPECT().Pin(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := commentUC.SetPinned(context.Background(), commentID, true, executor)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanModerateImage(executor, mockImage).Return(false)
+		mockRepo.EXPECT().Pin(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := commentUC.SetPinned(context.Background(), commentID, true, executor)
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+	})
+
+	t.Run("Tombstone", func(t *testing.T) {
+		deletedAt := time.Now()
+		tombstone := &domain.Comment{ID: commentID, ImageID: imageID, DeletedAt: &deletedAt}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(tombstone, nil)
+		mockRepo.EXPECT().Pin(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := commentUC.SetPinned(context.Background(), commentID, true, executor)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
+
+func TestCommentUseCase_SetHidden(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockCommentImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
+	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
+
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 0, mockLog,
+	)
+
+	commentID := domain.ID(1)
+	imageID := domain.ID(2)
+	executor := &domain.User{ID: 3}
+	mockImage := &domain.Image{ID: imageID, AuthorID: executor.ID}
+	mockComment := &domain.Comment{ID: commentID, ImageID: imageID, AuthorID: 4}
+
+	t.Run("SuccessHide", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanModerateImage(executor, mockImage).Return(true)
+		mockRepo.EXPECT().SetHidden(gomock.Any(), commentID, true).Return(nil)
+
+		assert.NoError(t, commentUC.SetHidden(context.Background(), commentID, true, executor))
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanModerateImage(executor, mockImage).Return(false)
+		mockRepo.EXPECT().SetHidden(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := commentUC.SetHidden(context.Background(), commentID, true, executor)
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+	})
+}
+
+func TestCommentUseCase_SetLocked(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockCommentImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
+	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
+
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 0, mockLog,
+	)
+
+	imageID := domain.ID(2)
+	executor := &domain.User{ID: 3}
+	mockImage := &domain.Image{ID: imageID, AuthorID: executor.ID}
+
+	t.Run("SuccessLock", func(t *testing.T) {
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanModerateImage(executor, mockImage).Return(true)
+		mockImageUC.EXPECT().SetCommentsLocked(gomock.Any(), imageID, true).Return(nil)
+
+		assert.NoError(t, commentUC.SetLocked(context.Background(), imageID, true, executor))
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanModerateImage(executor, mockImage).Return(false)
+		mockImageUC.EXPECT().SetCommentsLocked(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := commentUC.SetLocked(context.Background(), imageID, true, executor)
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+	})
+}
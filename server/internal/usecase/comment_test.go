@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/pillowskiy/gopix/internal/domain"
 	"github.com/pillowskiy/gopix/internal/repository"
@@ -24,12 +25,19 @@ func TestCommentUseCase_Create(t *testing.T) {
 	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
 	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
 
-	commentUC := usecase.NewCommentUseCase(mockRepo, mockACL, mockImageUC, mockLog)
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 2, mockLog,
+	)
 
 	commentID := domain.ID(1)
 	imageID := domain.ID(2)
 	authorID := domain.ID(3)
+	executor := &domain.User{ID: authorID, Username: "author"}
+	mockImage := &domain.Image{ID: imageID, AuthorID: authorID}
 
 	mockComment := &domain.Comment{
 		ID:       commentID,
@@ -39,54 +47,147 @@ func TestCommentUseCase_Create(t *testing.T) {
 	}
 
 	t.Run("SucessCreate", func(t *testing.T) {
-		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(&domain.Image{ID: imageID}, nil)
-		mockRepo.EXPECT().HasUserCommented(gomock.Any(), imageID, authorID).Return(false, nil)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
 		mockRepo.EXPECT().Create(gomock.Any(), mockComment).Return(mockComment, nil)
+		mockRepo.EXPECT().ReplaceMentions(gomock.Any(), commentID, []string{}).Return([]domain.CommentMention{}, nil)
+		mockNotifMng.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
-		createdComment, err := commentUC.Create(context.Background(), mockComment)
+		createdComment, err := commentUC.Create(context.Background(), mockComment, executor)
 		if assert.NoError(t, err) {
 			assert.Equal(t, mockComment, createdComment)
 		}
 	})
 
+	t.Run("NotifiesImageAuthor", func(t *testing.T) {
+		imageAuthorID := domain.ID(5)
+		img := &domain.Image{ID: imageID, AuthorID: imageAuthorID}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(img, nil)
+		mockRestrictions.EXPECT().IsBlocked(gomock.Any(), imageAuthorID, executor.ID).Return(false, nil)
+		mockRepo.EXPECT().Create(gomock.Any(), mockComment).Return(mockComment, nil)
+		mockRepo.EXPECT().ReplaceMentions(gomock.Any(), commentID, []string{}).Return([]domain.CommentMention{}, nil)
+		mockNotifMng.EXPECT().
+			Notify(gomock.Any(), imageAuthorID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ domain.ID, notif *domain.Notification) error {
+				assert.Equal(t, &executor.ID, notif.ActorID)
+				return nil
+			})
+
+		_, err := commentUC.Create(context.Background(), mockComment, executor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("BlockedByImageAuthor", func(t *testing.T) {
+		imageAuthorID := domain.ID(5)
+		img := &domain.Image{ID: imageID, AuthorID: imageAuthorID}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(img, nil)
+		mockRestrictions.EXPECT().IsBlocked(gomock.Any(), imageAuthorID, executor.ID).Return(true, nil)
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		createdComment, err := commentUC.Create(context.Background(), mockComment, executor)
+		assert.Equal(t, usecase.ErrForbidden, err)
+		assert.Nil(t, createdComment)
+	})
+
 	t.Run("IncorrectImageRef", func(t *testing.T) {
 		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(nil, repository.ErrNotFound)
-		mockRepo.EXPECT().HasUserCommented(gomock.Any(), imageID, authorID).Times(0)
 		mockRepo.EXPECT().Create(gomock.Any(), mockComment).Times(0)
 
-		createdComment, err := commentUC.Create(context.Background(), mockComment)
+		createdComment, err := commentUC.Create(context.Background(), mockComment, executor)
 		assert.Error(t, err)
 		assert.Equal(t, usecase.ErrIncorrectImageRef, err)
 		assert.Nil(t, createdComment)
 	})
 
-	t.Run("AlreadyCommented", func(t *testing.T) {
-		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(&domain.Image{ID: imageID}, nil)
-		mockRepo.EXPECT().HasUserCommented(gomock.Any(), imageID, authorID).Return(true, nil)
-		mockRepo.EXPECT().Create(gomock.Any(), mockComment).Times(0)
+	t.Run("SuccessReply", func(t *testing.T) {
+		parentID := domain.ID(4)
+		reply := &domain.Comment{ID: commentID, ImageID: imageID, AuthorID: authorID, ParentID: &parentID, Text: "reply"}
 
-		createdComment, err := commentUC.Create(context.Background(), mockComment)
-		assert.Error(t, err)
-		assert.Equal(t, usecase.ErrAlreadyExists, err)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), parentID).Return(&domain.Comment{ID: parentID, ImageID: imageID, Depth: 1}, nil)
+		mockRepo.EXPECT().Create(gomock.Any(), reply).Return(reply, nil)
+		mockRepo.EXPECT().ReplaceMentions(gomock.Any(), commentID, []string{}).Return([]domain.CommentMention{}, nil)
+
+		createdComment, err := commentUC.Create(context.Background(), reply, executor)
+		if assert.NoError(t, err) {
+			assert.Equal(t, 2, createdComment.Depth)
+		}
+	})
+
+	t.Run("ReplyTooDeep", func(t *testing.T) {
+		parentID := domain.ID(4)
+		reply := &domain.Comment{ImageID: imageID, AuthorID: authorID, ParentID: &parentID, Text: "reply"}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), parentID).Return(&domain.Comment{ID: parentID, ImageID: imageID, Depth: 2}, nil)
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		createdComment, err := commentUC.Create(context.Background(), reply, executor)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
 		assert.Nil(t, createdComment)
 	})
 
-	t.Run("RepoError_HasUserComment", func(t *testing.T) {
-		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(&domain.Image{ID: imageID}, nil)
-		mockRepo.EXPECT().HasUserCommented(gomock.Any(), imageID, authorID).Return(false, errors.New("repo error"))
-		mockRepo.EXPECT().Create(gomock.Any(), mockComment).Times(0)
+	t.Run("ParentOfAnotherImage", func(t *testing.T) {
+		parentID := domain.ID(4)
+		reply := &domain.Comment{ImageID: imageID, AuthorID: authorID, ParentID: &parentID, Text: "reply"}
 
-		createdComment, err := commentUC.Create(context.Background(), mockComment)
-		assert.Error(t, err)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), parentID).Return(&domain.Comment{ID: parentID, ImageID: 5}, nil)
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		createdComment, err := commentUC.Create(context.Background(), reply, executor)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+		assert.Nil(t, createdComment)
+	})
+
+	t.Run("ParentNotFound", func(t *testing.T) {
+		parentID := domain.ID(4)
+		reply := &domain.Comment{ImageID: imageID, AuthorID: authorID, ParentID: &parentID, Text: "reply"}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), parentID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		createdComment, err := commentUC.Create(context.Background(), reply, executor)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+		assert.Nil(t, createdComment)
+	})
+
+	t.Run("CommentsLocked", func(t *testing.T) {
+		lockedImage := &domain.Image{ID: imageID, AuthorID: 5, CommentsLocked: true}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(lockedImage, nil)
+		mockACL.EXPECT().CanModerateImage(executor, lockedImage).Return(false)
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		createdComment, err := commentUC.Create(context.Background(), mockComment, executor)
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+		assert.Nil(t, createdComment)
+	})
+
+	t.Run("HiddenParent", func(t *testing.T) {
+		parentID := domain.ID(4)
+		hiddenAt := time.Now()
+		reply := &domain.Comment{ImageID: imageID, AuthorID: authorID, ParentID: &parentID, Text: "reply"}
+		parent := &domain.Comment{ID: parentID, ImageID: imageID, AuthorID: 5, HiddenAt: &hiddenAt}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), parentID).Return(parent, nil)
+		mockACL.EXPECT().CanModerateImage(executor, mockImage).Return(false)
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		createdComment, err := commentUC.Create(context.Background(), reply, executor)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
 		assert.Nil(t, createdComment)
 	})
 
 	t.Run("RepoError", func(t *testing.T) {
-		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(&domain.Image{ID: imageID}, nil)
-		mockRepo.EXPECT().HasUserCommented(gomock.Any(), imageID, authorID).Return(false, nil)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
 		mockRepo.EXPECT().Create(gomock.Any(), mockComment).Return(nil, errors.New("repo error"))
+		mockRepo.EXPECT().ReplaceMentions(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
-		createdComment, err := commentUC.Create(context.Background(), mockComment)
+		createdComment, err := commentUC.Create(context.Background(), mockComment, executor)
 		assert.Error(t, err)
 		assert.Nil(t, createdComment)
 	})
@@ -102,8 +203,13 @@ func TestCommentUseCase_GetByImageID(t *testing.T) {
 	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
 	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
 
-	commentUC := usecase.NewCommentUseCase(mockRepo, mockACL, mockImageUC, mockLog)
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 0, mockLog,
+	)
 
 	imageID := domain.ID(1)
 
@@ -114,36 +220,131 @@ func TestCommentUseCase_GetByImageID(t *testing.T) {
 
 	sortMethod := domain.CommentNewestSort
 
-	pag := &domain.Pagination[domain.DetailedComment]{
-		PaginationInput: *pagInput,
-		Items: []domain.DetailedComment{
-			{
-				Comment: domain.Comment{
-					ID:       1,
-					ImageID:  imageID,
-					AuthorID: 1,
-					Text:     "test",
-				},
+	newPag := func() *domain.Pagination[domain.DetailedComment] {
+		return &domain.Pagination[domain.DetailedComment]{
+			PaginationInput: *pagInput,
+			Items: []domain.DetailedComment{
+				{Comment: domain.Comment{ID: 1, ImageID: imageID, AuthorID: 1, Text: "first"}},
+				{Comment: domain.Comment{ID: 2, ImageID: imageID, AuthorID: 1, Text: "second"}},
 			},
-		},
-		Total: 1,
+			Total: 2,
+		}
+	}
+
+	rootID, replyID := domain.ID(1), domain.ID(3)
+	replies := []domain.DetailedComment{
+		{Comment: domain.Comment{ID: replyID, ImageID: imageID, ParentID: &rootID, Depth: 1}},
+		{Comment: domain.Comment{ID: 4, ImageID: imageID, ParentID: &replyID, Depth: 2}},
+	}
+
+	mentions := map[domain.ID][]domain.CommentMention{
+		4: {{UserID: 5, Username: "user"}},
 	}
 
-	t.Run("SuccessGetByImageID", func(t *testing.T) {
+	reactions := map[domain.ID][]domain.ReactionCount{
+		3: {{Emoji: "🔥", Count: 1}},
+	}
+
+	t.Run("SuccessGetByImageID_Tree", func(t *testing.T) {
 		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(&domain.Image{ID: imageID}, nil)
-		mockRepo.EXPECT().GetByImageID(gomock.Any(), imageID, pagInput, sortMethod).Return(pag, nil)
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), domain.ID(0), gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().GetByImageID(gomock.Any(), imageID, pagInput, sortMethod, &domain.CommentVisibility{}).Return(newPag(), nil)
+		mockRepo.EXPECT().GetThreads(gomock.Any(), []domain.ID{1, 2}, gomock.Any(), gomock.Any()).Return(replies, nil)
+		mockRepo.EXPECT().GetMentions(gomock.Any(), []domain.ID{1, 2, 3, 4}).Return(mentions, nil)
+		mockReactionUC.EXPECT().
+			Counts(gomock.Any(), domain.ReactionTargetComment, []domain.ID{1, 2, 3, 4}, nil).
+			Return(reactions, nil)
+
+		pag, err := commentUC.GetByImageID(context.Background(), imageID, pagInput, sortMethod, domain.CommentTreeView, nil)
+		if assert.NoError(t, err) {
+			assert.Len(t, pag.Items, 2)
+			assert.Len(t, pag.Items[0].Replies, 1)
+			assert.Equal(t, replyID, pag.Items[0].Replies[0].ID)
+			assert.Len(t, pag.Items[0].Replies[0].Replies, 1)
+			assert.Equal(t, mentions[4], pag.Items[0].Replies[0].Replies[0].Mentions)
+			assert.Equal(t, reactions[3], pag.Items[0].Replies[0].Reactions)
+			assert.Empty(t, pag.Items[1].Reactions)
+			assert.Empty(t, pag.Items[1].Replies)
+		}
+	})
+
+	t.Run("SuccessGetByImageID_Flat", func(t *testing.T) {
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(&domain.Image{ID: imageID}, nil)
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), domain.ID(0), gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().GetByImageID(gomock.Any(), imageID, pagInput, sortMethod, &domain.CommentVisibility{}).Return(newPag(), nil)
+		mockRepo.EXPECT().GetThreads(gomock.Any(), []domain.ID{1, 2}, gomock.Any(), gomock.Any()).Return(replies, nil)
+		mockRepo.EXPECT().GetMentions(gomock.Any(), []domain.ID{1, 2, 3, 4}).Return(mentions, nil)
+		mockReactionUC.EXPECT().
+			Counts(gomock.Any(), domain.ReactionTargetComment, []domain.ID{1, 2, 3, 4}, nil).
+			Return(reactions, nil)
+
+		pag, err := commentUC.GetByImageID(context.Background(), imageID, pagInput, sortMethod, domain.CommentFlatView, nil)
+		if assert.NoError(t, err) {
+			ids := make([]domain.ID, len(pag.Items))
+			for i, cmt := range pag.Items {
+				ids[i] = cmt.ID
+				assert.Empty(t, cmt.Replies)
+			}
+			assert.Equal(t, []domain.ID{1, 3, 4, 2}, ids)
+		}
+	})
+
+	t.Run("TombstoneHidesAuthor", func(t *testing.T) {
+		deletedAt := time.Now()
+		pag := newPag()
+		pag.Items[0].DeletedAt = &deletedAt
+		pag.Items[0].Author = domain.CommentAuthor{ID: 1, Username: "author"}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(&domain.Image{ID: imageID}, nil)
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), domain.ID(0), gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().GetByImageID(gomock.Any(), imageID, pagInput, sortMethod, &domain.CommentVisibility{}).Return(pag, nil)
+		mockRepo.EXPECT().GetThreads(gomock.Any(), []domain.ID{1, 2}, gomock.Any(), gomock.Any()).Return(replies, nil)
+		mockRepo.EXPECT().GetMentions(gomock.Any(), gomock.Any()).Return(mentions, nil)
+		mockReactionUC.EXPECT().Counts(gomock.Any(), gomock.Any(), gomock.Any(), nil).Return(reactions, nil)
 
-		pag, err := commentUC.GetByImageID(context.Background(), imageID, pagInput, sortMethod)
+		pag, err := commentUC.GetByImageID(context.Background(), imageID, pagInput, sortMethod, domain.CommentTreeView, nil)
 		if assert.NoError(t, err) {
-			assert.NotNil(t, pag)
+			assert.True(t, pag.Items[0].IsDeleted())
+			assert.Empty(t, pag.Items[0].Author)
+			assert.Len(t, pag.Items[0].Replies, 1)
 		}
 	})
 
+	t.Run("ModeratorSeesHidden", func(t *testing.T) {
+		moderator := &domain.User{ID: 7}
+		img := &domain.Image{ID: imageID, AuthorID: moderator.ID}
+		vis := &domain.CommentVisibility{ViewerID: &moderator.ID, ShowHidden: true}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(img, nil)
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), img.AuthorID, gomock.Any()).Return(true, nil)
+		mockACL.EXPECT().CanModerateImage(moderator, img).Return(true)
+		mockRepo.EXPECT().GetByImageID(gomock.Any(), imageID, pagInput, sortMethod, vis).Return(newPag(), nil)
+		mockRepo.EXPECT().GetThreads(gomock.Any(), []domain.ID{1, 2}, gomock.Any(), vis).Return(replies, nil)
+		mockRepo.EXPECT().GetMentions(gomock.Any(), gomock.Any()).Return(mentions, nil)
+		mockReactionUC.EXPECT().Counts(gomock.Any(), gomock.Any(), gomock.Any(), &moderator.ID).Return(reactions, nil)
+
+		_, err := commentUC.GetByImageID(context.Background(), imageID, pagInput, sortMethod, domain.CommentTreeView, moderator)
+		assert.NoError(t, err)
+	})
+
+	t.Run("HiddenAuthor", func(t *testing.T) {
+		img := &domain.Image{ID: imageID, AuthorID: 2}
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(img, nil)
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), img.AuthorID, nil).Return(false, nil)
+		mockRepo.EXPECT().GetByImageID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		cmts, err := commentUC.GetByImageID(
+			context.Background(), imageID, pagInput, sortMethod, domain.CommentTreeView, nil,
+		)
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+		assert.Nil(t, cmts)
+	})
+
 	t.Run("IncorrectImageRef", func(t *testing.T) {
 		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(nil, repository.ErrNotFound)
-		mockRepo.EXPECT().GetByImageID(gomock.Any(), imageID, pagInput, sortMethod).Times(0)
+		mockRepo.EXPECT().GetByImageID(gomock.Any(), imageID, pagInput, sortMethod, &domain.CommentVisibility{}).Times(0)
 
-		pag, err := commentUC.GetByImageID(context.Background(), imageID, pagInput, sortMethod)
+		pag, err := commentUC.GetByImageID(context.Background(), imageID, pagInput, sortMethod, domain.CommentTreeView, nil)
 		assert.Error(t, err)
 		assert.Equal(t, usecase.ErrIncorrectImageRef, err)
 		assert.Nil(t, pag)
@@ -151,9 +352,10 @@ func TestCommentUseCase_GetByImageID(t *testing.T) {
 
 	t.Run("Unprocessable", func(t *testing.T) {
 		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(&domain.Image{ID: imageID}, nil)
-		mockRepo.EXPECT().GetByImageID(gomock.Any(), imageID, pagInput, sortMethod).Return(nil, repository.ErrIncorrectInput)
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), domain.ID(0), gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().GetByImageID(gomock.Any(), imageID, pagInput, sortMethod, &domain.CommentVisibility{}).Return(nil, repository.ErrIncorrectInput)
 
-		pag, err := commentUC.GetByImageID(context.Background(), imageID, pagInput, sortMethod)
+		pag, err := commentUC.GetByImageID(context.Background(), imageID, pagInput, sortMethod, domain.CommentTreeView, nil)
 		assert.Error(t, err)
 		assert.Equal(t, usecase.ErrUnprocessable, err)
 		assert.Nil(t, pag)
@@ -161,9 +363,22 @@ func TestCommentUseCase_GetByImageID(t *testing.T) {
 
 	t.Run("RepoError", func(t *testing.T) {
 		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(&domain.Image{ID: imageID}, nil)
-		mockRepo.EXPECT().GetByImageID(gomock.Any(), imageID, pagInput, sortMethod).Return(nil, errors.New("repo error"))
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), domain.ID(0), gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().GetByImageID(gomock.Any(), imageID, pagInput, sortMethod, &domain.CommentVisibility{}).Return(nil, errors.New("repo error"))
+		mockRepo.EXPECT().GetThreads(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
-		pag, err := commentUC.GetByImageID(context.Background(), imageID, pagInput, sortMethod)
+		pag, err := commentUC.GetByImageID(context.Background(), imageID, pagInput, sortMethod, domain.CommentTreeView, nil)
+		assert.Error(t, err)
+		assert.Nil(t, pag)
+	})
+
+	t.Run("RepoError_GetThreads", func(t *testing.T) {
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(&domain.Image{ID: imageID}, nil)
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), domain.ID(0), gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().GetByImageID(gomock.Any(), imageID, pagInput, sortMethod, &domain.CommentVisibility{}).Return(newPag(), nil)
+		mockRepo.EXPECT().GetThreads(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("repo error"))
+
+		pag, err := commentUC.GetByImageID(context.Background(), imageID, pagInput, sortMethod, domain.CommentTreeView, nil)
 		assert.Error(t, err)
 		assert.Nil(t, pag)
 	})
@@ -179,8 +394,13 @@ func TestCommentUseCase_GetByID(t *testing.T) {
 	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
 	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
 
-	commentUC := usecase.NewCommentUseCase(mockRepo, mockACL, mockImageUC, mockLog)
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 0, mockLog,
+	)
 
 	commentID := domain.ID(1)
 	mockComment := &domain.Comment{
@@ -227,8 +447,13 @@ func TestCommentUseCase_Delete(t *testing.T) {
 	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
 	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
 
-	commentUC := usecase.NewCommentUseCase(mockRepo, mockACL, mockImageUC, mockLog)
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 0, mockLog,
+	)
 
 	commentID := domain.ID(1)
 	mockComment := &domain.Comment{ID: commentID}
@@ -237,12 +462,56 @@ func TestCommentUseCase_Delete(t *testing.T) {
 	t.Run("SuccessDelete", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
 		mockACL.EXPECT().CanModify(mockExecutor, mockComment).Return(true)
+		mockRepo.EXPECT().CountReplies(gomock.Any(), commentID).Return(0, nil)
+		mockRepo.EXPECT().Delete(gomock.Any(), commentID).Return(nil)
+
+		err := commentUC.Delete(context.Background(), commentID, mockExecutor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("SuccessDelete_Tombstone", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
+		mockACL.EXPECT().CanModify(mockExecutor, mockComment).Return(true)
+		mockRepo.EXPECT().CountReplies(gomock.Any(), commentID).Return(2, nil)
+		mockRepo.EXPECT().SoftDelete(gomock.Any(), commentID, mockExecutor.ID).Return(nil)
+		mockRepo.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(0)
+
+		err := commentUC.Delete(context.Background(), commentID, mockExecutor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("SuccessDelete_PrunesTombstones", func(t *testing.T) {
+		deletedAt := time.Now()
+		rootID, tombstoneID := domain.ID(2), domain.ID(3)
+		reply := &domain.Comment{ID: commentID, ParentID: &tombstoneID}
+		tombstone := &domain.Comment{ID: tombstoneID, ParentID: &rootID, DeletedAt: &deletedAt}
+		root := &domain.Comment{ID: rootID}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(reply, nil)
+		mockACL.EXPECT().CanModify(mockExecutor, reply).Return(true)
+		mockRepo.EXPECT().CountReplies(gomock.Any(), commentID).Return(0, nil)
 		mockRepo.EXPECT().Delete(gomock.Any(), commentID).Return(nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), tombstoneID).Return(tombstone, nil)
+		mockRepo.EXPECT().CountReplies(gomock.Any(), tombstoneID).Return(0, nil)
+		mockRepo.EXPECT().Delete(gomock.Any(), tombstoneID).Return(nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), rootID).Return(root, nil)
+		mockRepo.EXPECT().Delete(gomock.Any(), rootID).Times(0)
 
 		err := commentUC.Delete(context.Background(), commentID, mockExecutor)
 		assert.NoError(t, err)
 	})
 
+	t.Run("AlreadyDeleted", func(t *testing.T) {
+		deletedAt := time.Now()
+		tombstone := &domain.Comment{ID: commentID, DeletedAt: &deletedAt}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(tombstone, nil)
+		mockACL.EXPECT().CanModify(gomock.Any(), gomock.Any()).Times(0)
+
+		err := commentUC.Delete(context.Background(), commentID, mockExecutor)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+
 	t.Run("NotFound", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(nil, repository.ErrNotFound)
 		mockACL.EXPECT().CanModify(mockExecutor, mockComment).Times(0)
@@ -266,6 +535,7 @@ func TestCommentUseCase_Delete(t *testing.T) {
 	t.Run("RepoError", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
 		mockACL.EXPECT().CanModify(mockExecutor, mockComment).Return(true)
+		mockRepo.EXPECT().CountReplies(gomock.Any(), commentID).Return(0, nil)
 		mockRepo.EXPECT().Delete(gomock.Any(), commentID).Return(errors.New("repo error"))
 
 		err := commentUC.Delete(context.Background(), commentID, mockExecutor)
@@ -273,6 +543,60 @@ func TestCommentUseCase_Delete(t *testing.T) {
 	})
 }
 
+func TestCommentUseCase_GetRevisions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockCommentImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
+	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
+
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 0, mockLog,
+	)
+
+	commentID := domain.ID(1)
+	mockComment := &domain.Comment{ID: commentID}
+	mockExecutor := &domain.User{ID: 1, Permissions: int(domain.PermissionsAdmin)}
+
+	t.Run("SuccessGetRevisions", func(t *testing.T) {
+		revisions := []domain.CommentRevision{{ID: 2, CommentID: commentID, Text: "before"}}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
+		mockACL.EXPECT().CanModerate(mockExecutor).Return(true)
+		mockRepo.EXPECT().GetRevisions(gomock.Any(), commentID).Return(revisions, nil)
+
+		actual, err := commentUC.GetRevisions(context.Background(), commentID, mockExecutor)
+		assert.NoError(t, err)
+		assert.Equal(t, revisions, actual)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
+		mockACL.EXPECT().CanModerate(mockExecutor).Return(false)
+		mockRepo.EXPECT().GetRevisions(gomock.Any(), gomock.Any()).Times(0)
+
+		actual, err := commentUC.GetRevisions(context.Background(), commentID, mockExecutor)
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanModerate(gomock.Any()).Times(0)
+
+		actual, err := commentUC.GetRevisions(context.Background(), commentID, mockExecutor)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+		assert.Nil(t, actual)
+	})
+}
+
 func TestCommentUseCase_Update(t *testing.T) {
 	t.Parallel()
 
@@ -283,8 +607,13 @@ func TestCommentUseCase_Update(t *testing.T) {
 	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
 	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
 
-	commentUC := usecase.NewCommentUseCase(mockRepo, mockACL, mockImageUC, mockLog)
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 0, mockLog,
+	)
 
 	commentID := domain.ID(1)
 	mockComment := &domain.Comment{ID: commentID}
@@ -293,7 +622,9 @@ func TestCommentUseCase_Update(t *testing.T) {
 	t.Run("SuccessUpdate", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
 		mockACL.EXPECT().CanModify(mockExecutor, mockComment).Return(true)
-		mockRepo.EXPECT().Update(gomock.Any(), commentID, mockComment).Return(mockComment, nil)
+		mockRepo.EXPECT().GetMentions(gomock.Any(), []domain.ID{commentID}).Return(map[domain.ID][]domain.CommentMention{}, nil)
+		mockRepo.EXPECT().Update(gomock.Any(), commentID, mockComment, mockExecutor.ID).Return(mockComment, nil)
+		mockRepo.EXPECT().ReplaceMentions(gomock.Any(), commentID, []string{}).Return([]domain.CommentMention{}, nil)
 
 		updComment, err := commentUC.Update(context.Background(), commentID, mockComment, mockExecutor)
 		if assert.NoError(t, err) {
@@ -304,7 +635,7 @@ func TestCommentUseCase_Update(t *testing.T) {
 	t.Run("NotFound", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(nil, repository.ErrNotFound)
 		mockACL.EXPECT().CanModify(mockExecutor, mockComment).Times(0)
-		mockRepo.EXPECT().Update(gomock.Any(), commentID, mockComment).Times(0)
+		mockRepo.EXPECT().Update(gomock.Any(), commentID, mockComment, mockExecutor.ID).Times(0)
 
 		updComment, err := commentUC.Update(context.Background(), commentID, mockComment, mockExecutor)
 		assert.Error(t, err)
@@ -315,7 +646,7 @@ func TestCommentUseCase_Update(t *testing.T) {
 	t.Run("Forbidden", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
 		mockACL.EXPECT().CanModify(mockExecutor, mockComment).Return(false)
-		mockRepo.EXPECT().Update(gomock.Any(), commentID, mockComment).Times(0)
+		mockRepo.EXPECT().Update(gomock.Any(), commentID, mockComment, mockExecutor.ID).Times(0)
 
 		updComment, err := commentUC.Update(context.Background(), commentID, mockComment, mockExecutor)
 		assert.Error(t, err)
@@ -326,7 +657,8 @@ func TestCommentUseCase_Update(t *testing.T) {
 	t.Run("RepoError", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
 		mockACL.EXPECT().CanModify(mockExecutor, mockComment).Return(true)
-		mockRepo.EXPECT().Update(gomock.Any(), commentID, mockComment).Return(nil, errors.New("repo error"))
+		mockRepo.EXPECT().GetMentions(gomock.Any(), []domain.ID{commentID}).Return(map[domain.ID][]domain.CommentMention{}, nil)
+		mockRepo.EXPECT().Update(gomock.Any(), commentID, mockComment, mockExecutor.ID).Return(nil, errors.New("repo error"))
 
 		updComment, err := commentUC.Update(context.Background(), commentID, mockComment, mockExecutor)
 		assert.Error(t, err)
@@ -344,8 +676,13 @@ func TestCommentUseCase_LikeComment(t *testing.T) {
 	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
 	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
 
-	commentUC := usecase.NewCommentUseCase(mockRepo, mockACL, mockImageUC, mockLog)
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 0, mockLog,
+	)
 
 	commentID := domain.ID(1)
 	mockComment := &domain.Comment{ID: commentID}
@@ -400,8 +737,13 @@ func TestCommentUseCase_UnlikeComment(t *testing.T) {
 	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
 	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
 
-	commentUC := usecase.NewCommentUseCase(mockRepo, mockACL, mockImageUC, mockLog)
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 0, mockLog,
+	)
 
 	commentID := domain.ID(1)
 	mockExecutor := &domain.User{ID: 1, Permissions: int(domain.PermissionsAdmin)}
@@ -444,33 +786,53 @@ func TestCommentUseCase_GetReplies(t *testing.T) {
 	mockRepo := usecaseMock.NewMockCommentRepository(ctrl)
 	mockACL := usecaseMock.NewMockCommentAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
+	mockRestrictions := usecaseMock.NewMockCommentRestrictions(ctrl)
 
-	commentUC := usecase.NewCommentUseCase(mockRepo, mockACL, mockImageUC, mockLog)
+	commentUC := usecase.NewCommentUseCase(
+		mockRepo, mockACL, mockImageUC, mockNotifMng, mockReactionUC, mockRestrictions, 0, mockLog,
+	)
 
 	commentID := domain.ID(1)
-	executorID := new(domain.ID)
+	imageID := domain.ID(2)
+	executor := &domain.User{ID: 3}
+	pagInput := &domain.PaginationInput{PerPage: 10, Page: 1}
 
-	mockComment := &domain.Comment{ID: commentID}
-	mockComments := []domain.DetailedComment{
-		{
-			Comment: *mockComment,
+	mockImage := &domain.Image{ID: imageID, AuthorID: 4}
+	mockComment := &domain.Comment{ID: commentID, ImageID: imageID}
+	vis := &domain.CommentVisibility{ViewerID: &executor.ID}
+	mockReplies := &domain.Pagination[domain.DetailedComment]{
+		PaginationInput: *pagInput,
+		Items: []domain.DetailedComment{
+			{
+				Comment: *mockComment,
+			},
 		},
+		Total: 1,
 	}
 
 	t.Run("SuccessGetReplies", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
-		mockRepo.EXPECT().GetReplies(gomock.Any(), commentID, executorID).Return(mockComments, nil)
-
-		cmts, err := commentUC.GetReplies(context.Background(), commentID, executorID)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), mockImage.AuthorID, executor).Return(true, nil)
+		mockACL.EXPECT().CanModerateImage(executor, mockImage).Return(false)
+		mockRepo.EXPECT().GetReplies(gomock.Any(), commentID, pagInput, vis).Return(mockReplies, nil)
+		mockRepo.EXPECT().GetMentions(gomock.Any(), []domain.ID{commentID}).Return(map[domain.ID][]domain.CommentMention{}, nil)
+		mockReactionUC.EXPECT().
+			Counts(gomock.Any(), domain.ReactionTargetComment, []domain.ID{commentID}, &executor.ID).
+			Return(map[domain.ID][]domain.ReactionCount{}, nil)
+
+		cmts, err := commentUC.GetReplies(context.Background(), commentID, pagInput, executor)
 		assert.NoError(t, err)
-		assert.Equal(t, mockComments, cmts)
+		assert.Equal(t, mockReplies, cmts)
 	})
 
 	t.Run("NotFound", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(nil, repository.ErrNotFound)
-		mockRepo.EXPECT().GetReplies(gomock.Any(), commentID, executorID).Times(0)
+		mockRepo.EXPECT().GetReplies(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
-		cmts, err := commentUC.GetReplies(context.Background(), commentID, executorID)
+		cmts, err := commentUC.GetReplies(context.Background(), commentID, pagInput, executor)
 		assert.Error(t, err)
 		assert.Equal(t, err, usecase.ErrNotFound)
 		assert.Nil(t, cmts)
@@ -478,10 +840,39 @@ func TestCommentUseCase_GetReplies(t *testing.T) {
 
 	t.Run("RepoError", func(t *testing.T) {
 		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
-		mockRepo.EXPECT().GetReplies(gomock.Any(), commentID, executorID).Return(nil, errors.New("repo error"))
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), mockImage.AuthorID, executor).Return(true, nil)
+		mockACL.EXPECT().CanModerateImage(executor, mockImage).Return(false)
+		mockRepo.EXPECT().GetReplies(gomock.Any(), commentID, pagInput, vis).Return(nil, errors.New("repo error"))
 
-		cmts, err := commentUC.GetReplies(context.Background(), commentID, executorID)
+		cmts, err := commentUC.GetReplies(context.Background(), commentID, pagInput, executor)
 		assert.Error(t, err)
 		assert.Nil(t, cmts)
 	})
+
+	t.Run("HiddenAuthor", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(mockComment, nil)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), mockImage.AuthorID, executor).Return(false, nil)
+		mockRepo.EXPECT().GetReplies(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		cmts, err := commentUC.GetReplies(context.Background(), commentID, pagInput, executor)
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+		assert.Nil(t, cmts)
+	})
+
+	t.Run("HiddenParent", func(t *testing.T) {
+		hiddenAt := time.Now()
+		hidden := &domain.Comment{ID: commentID, ImageID: imageID, AuthorID: 5, HiddenAt: &hiddenAt}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), commentID).Return(hidden, nil)
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockImageUC.EXPECT().CanViewAuthor(gomock.Any(), mockImage.AuthorID, executor).Return(true, nil)
+		mockACL.EXPECT().CanModerateImage(executor, mockImage).Return(false)
+		mockRepo.EXPECT().GetReplies(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		cmts, err := commentUC.GetReplies(context.Background(), commentID, pagInput, executor)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+		assert.Nil(t, cmts)
+	})
 }
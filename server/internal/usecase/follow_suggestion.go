@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/pkg/logger"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+// followSuggestionSeeds is the number of the newest liked images used to look for the similar authors
+const followSuggestionSeeds = 5
+
+// followSuggestionSimilarConcurrency bounds the concurrent similarity searches of the seeds
+const followSuggestionSimilarConcurrency = 3
+
+type FollowSuggestionRepository interface {
+	MutualSuggestions(ctx context.Context, userID domain.ID, limit int) ([]domain.FollowSuggestion, error)
+	LikedAuthorsSuggestions(ctx context.Context, userID domain.ID, limit int) ([]domain.FollowSuggestion, error)
+	NewestLikedImages(ctx context.Context, userID domain.ID, limit int) ([]domain.ID, error)
+	SuggestableAuthors(
+		ctx context.Context, userID domain.ID, imageIDs []domain.ID,
+	) (map[domain.ID]domain.ImageAuthor, error)
+}
+
+type FollowSuggestionFeaturesUseCase interface {
+	Similar(ctx context.Context, imageID domain.ID) ([]domain.ID, error)
+}
+
+type followSuggestionUseCase struct {
+	repo       FollowSuggestionRepository
+	featuresUC FollowSuggestionFeaturesUseCase
+	logger     logger.Logger
+}
+
+func NewFollowSuggestionUseCase(
+	repo FollowSuggestionRepository,
+	featuresUC FollowSuggestionFeaturesUseCase,
+	logger logger.Logger,
+) *followSuggestionUseCase {
+	return &followSuggestionUseCase{repo: repo, featuresUC: featuresUC, logger: logger}
+}
+
+// Suggest ranks the accounts followed by the followings, the authors of the liked images
+// and the authors of the images similar to the newest liked ones.
+// Scores are combined as independent evidences, so an account backed by several sources ranks higher
+func (uc *followSuggestionUseCase) Suggest(
+	ctx context.Context, userID domain.ID, limit int,
+) ([]domain.FollowSuggestion, error) {
+	scores := make(map[domain.ID]*domain.FollowSuggestion)
+	addScore := func(author domain.ImageAuthor, score float64, source domain.FollowSuggestionSource) {
+		suggestion, ok := scores[author.ID]
+		if !ok {
+			suggestion = &domain.FollowSuggestion{ImageAuthor: author}
+			scores[author.ID] = suggestion
+		}
+		suggestion.Score = 1 - (1-suggestion.Score)*(1-score)
+		suggestion.Sources = append(suggestion.Sources, source)
+	}
+
+	mutual, err := uc.repo.MutualSuggestions(ctx, userID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "FollowSuggestionUseCase.Suggest.MutualSuggestions")
+	}
+	for _, suggestion := range mutual {
+		addScore(suggestion.ImageAuthor, suggestion.Score, domain.FollowSuggestionMutual)
+	}
+
+	liked, err := uc.repo.LikedAuthorsSuggestions(ctx, userID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "FollowSuggestionUseCase.Suggest.LikedAuthorsSuggestions")
+	}
+	for _, suggestion := range liked {
+		addScore(suggestion.ImageAuthor, suggestion.Score, domain.FollowSuggestionLiked)
+	}
+
+	similar, err := uc.similarSuggestions(ctx, userID)
+	if err != nil {
+		// The vectorization service is optional for suggestions, the other sources still work without it
+		uc.logger.Warnf("FollowSuggestionUseCase.Suggest.similarSuggestions: %v", err)
+	}
+	for _, suggestion := range similar {
+		addScore(suggestion.ImageAuthor, suggestion.Score, domain.FollowSuggestionSimilar)
+	}
+
+	suggestions := make([]domain.FollowSuggestion, 0, len(scores))
+	for _, suggestion := range scores {
+		suggestions = append(suggestions, *suggestion)
+	}
+
+	slices.SortFunc(suggestions, func(a, b domain.FollowSuggestion) int {
+		if a.Score != b.Score {
+			if a.Score > b.Score {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Username, b.Username)
+	})
+
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	return suggestions, nil
+}
+
+// similarSuggestions weights the authors of the images similar to the newest liked ones by the similarity rank,
+// the most similar image has the biggest weight
+func (uc *followSuggestionUseCase) similarSuggestions(
+	ctx context.Context, userID domain.ID,
+) ([]domain.FollowSuggestion, error) {
+	seedIDs, err := uc.repo.NewestLikedImages(ctx, userID, followSuggestionSeeds)
+	if err != nil {
+		return nil, err
+	}
+
+	// The seeds are searched concurrently, a failed search only drops its own seed
+	similar := make([][]domain.ID, len(seedIDs))
+	var g errgroup.Group
+	g.SetLimit(followSuggestionSimilarConcurrency)
+	for i, seedID := range seedIDs {
+		g.Go(func() error {
+			similarIDs, err := uc.featuresUC.Similar(ctx, seedID)
+			if err != nil {
+				uc.logger.Warnf("FollowSuggestionUseCase.similarSuggestions.Similar(%d): %v", seedID, err)
+				return nil
+			}
+			similar[i] = similarIDs
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	var totalWeight float64
+	weights := make(map[domain.ID]float64)
+	for _, similarIDs := range similar {
+		similarIDs = slices.DeleteFunc(similarIDs, func(id domain.ID) bool { return slices.Contains(seedIDs, id) })
+		for rank, id := range similarIDs {
+			weight := 1 / float64(rank+1)
+			totalWeight += weight
+			weights[id] += weight
+		}
+	}
+
+	if len(weights) == 0 {
+		return nil, nil
+	}
+
+	imageIDs := make([]domain.ID, 0, len(weights))
+	for id := range weights {
+		imageIDs = append(imageIDs, id)
+	}
+
+	authors, err := uc.repo.SuggestableAuthors(ctx, userID, imageIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[domain.ID]*domain.FollowSuggestion)
+	for imageID, author := range authors {
+		suggestion, ok := scores[author.ID]
+		if !ok {
+			suggestion = &domain.FollowSuggestion{ImageAuthor: author}
+			scores[author.ID] = suggestion
+		}
+		suggestion.Score += weights[imageID] / totalWeight
+	}
+
+	suggestions := make([]domain.FollowSuggestion, 0, len(scores))
+	for _, suggestion := range scores {
+		suggestions = append(suggestions, *suggestion)
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,121 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	usecaseMock "github.com/pillowskiy/gopix/internal/usecase/mock"
+	loggerMock "github.com/pillowskiy/gopix/pkg/logger/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFollowSuggestionUseCase_Suggest(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockFollowSuggestionRepository(ctrl)
+	mockFeatUC := usecaseMock.NewMockFollowSuggestionFeaturesUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+
+	suggestionUC := usecase.NewFollowSuggestionUseCase(mockRepo, mockFeatUC, mockLog)
+
+	userID := domain.ID(1)
+	alice := domain.ImageAuthor{ID: 10, Username: "alice"}
+	bob := domain.ImageAuthor{ID: 11, Username: "bob"}
+	carol := domain.ImageAuthor{ID: 12, Username: "carol"}
+
+	t.Run("SuccessSuggest", func(t *testing.T) {
+		mockRepo.EXPECT().MutualSuggestions(gomock.Any(), userID, 3).Return([]domain.FollowSuggestion{
+			{ImageAuthor: alice, Score: 1},
+			{ImageAuthor: bob, Score: 0.5},
+		}, nil)
+		mockRepo.EXPECT().LikedAuthorsSuggestions(gomock.Any(), userID, 3).Return([]domain.FollowSuggestion{
+			{ImageAuthor: bob, Score: 0.5},
+		}, nil)
+		mockRepo.EXPECT().NewestLikedImages(gomock.Any(), userID, gomock.Any()).Return([]domain.ID{100}, nil)
+		mockFeatUC.EXPECT().Similar(gomock.Any(), domain.ID(100)).Return([]domain.ID{100, 200, 201}, nil)
+		mockRepo.EXPECT().SuggestableAuthors(gomock.Any(), userID, gomock.Len(2)).Return(
+			map[domain.ID]domain.ImageAuthor{200: carol, 201: carol}, nil,
+		)
+
+		suggestions, err := suggestionUC.Suggest(context.Background(), userID, 3)
+		if !assert.NoError(t, err) || !assert.Len(t, suggestions, 3) {
+			return
+		}
+
+		assert.Equal(t, alice.ID, suggestions[0].ID)
+		assert.InDelta(t, 1, suggestions[0].Score, 0.001)
+		assert.Equal(t, carol.ID, suggestions[1].ID)
+		assert.InDelta(t, 1, suggestions[1].Score, 0.001)
+		assert.Equal(t, []domain.FollowSuggestionSource{domain.FollowSuggestionSimilar}, suggestions[1].Sources)
+
+		assert.Equal(t, bob.ID, suggestions[2].ID)
+		assert.InDelta(t, 0.75, suggestions[2].Score, 0.001)
+		assert.Equal(t, []domain.FollowSuggestionSource{
+			domain.FollowSuggestionMutual, domain.FollowSuggestionLiked,
+		}, suggestions[2].Sources)
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		mockRepo.EXPECT().MutualSuggestions(gomock.Any(), userID, 1).Return([]domain.FollowSuggestion{
+			{ImageAuthor: alice, Score: 1},
+		}, nil)
+		mockRepo.EXPECT().LikedAuthorsSuggestions(gomock.Any(), userID, 1).Return([]domain.FollowSuggestion{
+			{ImageAuthor: bob, Score: 1},
+		}, nil)
+		mockRepo.EXPECT().NewestLikedImages(gomock.Any(), userID, gomock.Any()).Return(nil, nil)
+
+		suggestions, err := suggestionUC.Suggest(context.Background(), userID, 1)
+		if assert.NoError(t, err) && assert.Len(t, suggestions, 1) {
+			assert.Equal(t, alice.ID, suggestions[0].ID)
+		}
+	})
+
+	t.Run("SimilarError", func(t *testing.T) {
+		mockRepo.EXPECT().MutualSuggestions(gomock.Any(), userID, 3).Return(nil, nil)
+		mockRepo.EXPECT().LikedAuthorsSuggestions(gomock.Any(), userID, 3).Return([]domain.FollowSuggestion{
+			{ImageAuthor: bob, Score: 1},
+		}, nil)
+		mockRepo.EXPECT().NewestLikedImages(gomock.Any(), userID, gomock.Any()).Return([]domain.ID{100}, nil)
+		mockFeatUC.EXPECT().Similar(gomock.Any(), domain.ID(100)).Return(nil, errors.New("vec error"))
+		mockRepo.EXPECT().SuggestableAuthors(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockLog.EXPECT().Warnf(gomock.Any(), gomock.Any(), gomock.Any())
+
+		suggestions, err := suggestionUC.Suggest(context.Background(), userID, 3)
+		if assert.NoError(t, err) && assert.Len(t, suggestions, 1) {
+			assert.Equal(t, []domain.FollowSuggestionSource{domain.FollowSuggestionLiked}, suggestions[0].Sources)
+		}
+	})
+
+	t.Run("SimilarSeedError", func(t *testing.T) {
+		mockRepo.EXPECT().MutualSuggestions(gomock.Any(), userID, 3).Return(nil, nil)
+		mockRepo.EXPECT().LikedAuthorsSuggestions(gomock.Any(), userID, 3).Return(nil, nil)
+		mockRepo.EXPECT().NewestLikedImages(gomock.Any(), userID, gomock.Any()).Return([]domain.ID{100, 101}, nil)
+		mockFeatUC.EXPECT().Similar(gomock.Any(), domain.ID(100)).Return(nil, errors.New("vec error"))
+		mockFeatUC.EXPECT().Similar(gomock.Any(), domain.ID(101)).Return([]domain.ID{200}, nil)
+		mockRepo.EXPECT().SuggestableAuthors(gomock.Any(), userID, []domain.ID{200}).Return(
+			map[domain.ID]domain.ImageAuthor{200: carol}, nil,
+		)
+		mockLog.EXPECT().Warnf(gomock.Any(), gomock.Any(), gomock.Any())
+
+		suggestions, err := suggestionUC.Suggest(context.Background(), userID, 3)
+		if assert.NoError(t, err) && assert.Len(t, suggestions, 1) {
+			assert.Equal(t, carol.ID, suggestions[0].ID)
+			assert.Equal(t, []domain.FollowSuggestionSource{domain.FollowSuggestionSimilar}, suggestions[0].Sources)
+		}
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo.EXPECT().MutualSuggestions(gomock.Any(), userID, 3).Return(nil, errors.New("repo error"))
+
+		suggestions, err := suggestionUC.Suggest(context.Background(), userID, 3)
+		assert.Error(t, err)
+		assert.Nil(t, suggestions)
+	})
+}
@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
 	"github.com/pkg/errors"
 )
 
@@ -14,17 +16,50 @@ type FollowingRepository interface {
 	Stats(
 		ctx context.Context, userID domain.ID, executorID *domain.ID,
 	) (*domain.FollowingStats, error)
+	Followers(
+		ctx context.Context, userID domain.ID, viewerID *domain.ID, input *domain.FollowListInput,
+	) ([]domain.Follow, error)
+	Following(
+		ctx context.Context, userID domain.ID, viewerID *domain.ID, input *domain.FollowListInput,
+	) ([]domain.Follow, error)
+	IsPrivate(ctx context.Context, userID domain.ID) (bool, error)
+	CanViewAuthor(ctx context.Context, authorID domain.ID, viewerID *domain.ID) (bool, error)
+	HasRequested(ctx context.Context, requesterID, targetID domain.ID) (bool, error)
+	RequestFollow(ctx context.Context, userID domain.ID, executorID domain.ID) error
+	CancelRequest(ctx context.Context, userID domain.ID, executorID domain.ID) error
+	ApproveRequest(ctx context.Context, requesterID, targetID domain.ID) error
+	DenyRequest(ctx context.Context, requesterID, targetID domain.ID) error
+	FollowRequests(
+		ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput,
+	) (*domain.Pagination[domain.FollowRequest], error)
+}
+
+type FollowingRestrictions interface {
+	IsBlocked(ctx context.Context, userID, otherID domain.ID) (bool, error)
 }
 
 type FollowingUseCase struct {
-	repo FollowingRepository
+	repo         FollowingRepository
+	restrictions FollowingRestrictions
+	notifMng     NotificationManager
 }
 
-func NewFollowingUseCase(repo FollowingRepository) *FollowingUseCase {
-	return &FollowingUseCase{repo: repo}
+func NewFollowingUseCase(
+	repo FollowingRepository, restrictions FollowingRestrictions, notifMng NotificationManager,
+) *FollowingUseCase {
+	return &FollowingUseCase{repo: repo, restrictions: restrictions, notifMng: notifMng}
 }
 
 func (uc *FollowingUseCase) Follow(ctx context.Context, userID domain.ID, executor *domain.User) error {
+	blocked, err := uc.restrictions.IsBlocked(ctx, executor.ID, userID)
+	if err != nil {
+		return errors.Wrap(err, "FollowingUseCase.Follow.IsBlocked")
+	}
+
+	if blocked {
+		return ErrForbidden
+	}
+
 	isFollowing, err := uc.IsFollowing(ctx, executor.ID, userID)
 	if err != nil {
 		return err
@@ -34,7 +69,46 @@ func (uc *FollowingUseCase) Follow(ctx context.Context, userID domain.ID, execut
 		return ErrAlreadyExists
 	}
 
-	return uc.repo.Follow(ctx, userID, executor.ID)
+	private, err := uc.repo.IsPrivate(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return errors.Wrap(err, "FollowingUseCase.Follow.IsPrivate")
+	}
+
+	if !private {
+		return uc.repo.Follow(ctx, userID, executor.ID)
+	}
+
+	return uc.requestFollow(ctx, userID, executor)
+}
+
+// requestFollow leaves the pending request for the private account to approve
+func (uc *FollowingUseCase) requestFollow(ctx context.Context, userID domain.ID, executor *domain.User) error {
+	requested, err := uc.repo.HasRequested(ctx, executor.ID, userID)
+	if err != nil {
+		return errors.Wrap(err, "FollowingUseCase.requestFollow.HasRequested")
+	}
+
+	if requested {
+		return ErrAlreadyExists
+	}
+
+	if err := uc.repo.RequestFollow(ctx, userID, executor.ID); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return ErrAlreadyExists
+		}
+		return errors.Wrap(err, "FollowingUseCase.requestFollow.RequestFollow")
+	}
+
+	_ = uc.notifMng.Notify(ctx, userID, &domain.Notification{
+		Title:   "New follow request",
+		Message: fmt.Sprintf("%s requested to follow you", executor.Username),
+		ActorID: &executor.ID,
+	})
+
+	return nil
 }
 
 func (uc *FollowingUseCase) Unfollow(ctx context.Context, userID domain.ID, executor *domain.User) error {
@@ -43,11 +117,59 @@ func (uc *FollowingUseCase) Unfollow(ctx context.Context, userID domain.ID, exec
 		return err
 	}
 
-	if !isFollowing {
+	if isFollowing {
+		return uc.repo.Unfollow(ctx, userID, executor.ID)
+	}
+
+	// Unfollowing the private account before the approval cancels the pending request
+	requested, err := uc.repo.HasRequested(ctx, executor.ID, userID)
+	if err != nil {
+		return errors.Wrap(err, "FollowingUseCase.Unfollow.HasRequested")
+	}
+
+	if !requested {
 		return ErrNotFound
 	}
 
-	return uc.repo.Unfollow(ctx, userID, executor.ID)
+	return uc.repo.CancelRequest(ctx, userID, executor.ID)
+}
+
+func (uc *FollowingUseCase) FollowRequests(
+	ctx context.Context, pagInput *domain.PaginationInput, executor *domain.User,
+) (*domain.Pagination[domain.FollowRequest], error) {
+	return uc.repo.FollowRequests(ctx, executor.ID, pagInput)
+}
+
+// ApproveRequest is idempotent, approving the request of the follower again does nothing
+func (uc *FollowingUseCase) ApproveRequest(ctx context.Context, requesterID domain.ID, executor *domain.User) error {
+	if err := uc.repo.ApproveRequest(ctx, requesterID, executor.ID); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return errors.Wrap(err, "FollowingUseCase.ApproveRequest")
+	}
+
+	_ = uc.notifMng.Notify(ctx, requesterID, &domain.Notification{
+		Title:   "Follow request approved",
+		Message: fmt.Sprintf("%s approved your follow request", executor.Username),
+		ActorID: &executor.ID,
+	})
+
+	return nil
+}
+
+func (uc *FollowingUseCase) DenyRequest(ctx context.Context, requesterID domain.ID, executor *domain.User) error {
+	if err := uc.repo.DenyRequest(ctx, requesterID, executor.ID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return errors.Wrap(err, "FollowingUseCase.DenyRequest")
+	}
+
+	return nil
 }
 
 func (uc *FollowingUseCase) IsFollowing(ctx context.Context, followerID, followingID domain.ID) (bool, error) {
@@ -64,3 +186,63 @@ func (uc *FollowingUseCase) Stats(
 ) (*domain.FollowingStats, error) {
 	return uc.repo.Stats(ctx, userID, executorID)
 }
+
+func (uc *FollowingUseCase) Followers(
+	ctx context.Context, userID domain.ID, input *domain.FollowListInput, executor *domain.User,
+) (*domain.CursorPagination[domain.Follow], error) {
+	if err := uc.checkListAccess(ctx, userID, executor); err != nil {
+		return nil, err
+	}
+
+	follows, err := uc.repo.Followers(ctx, userID, executorID(executor), withNextPageProbe(input))
+	if err != nil {
+		return nil, errors.Wrap(err, "FollowingUseCase.Followers")
+	}
+
+	return followsPage(follows, input.Limit), nil
+}
+
+func (uc *FollowingUseCase) Following(
+	ctx context.Context, userID domain.ID, input *domain.FollowListInput, executor *domain.User,
+) (*domain.CursorPagination[domain.Follow], error) {
+	if err := uc.checkListAccess(ctx, userID, executor); err != nil {
+		return nil, err
+	}
+
+	follows, err := uc.repo.Following(ctx, userID, executorID(executor), withNextPageProbe(input))
+	if err != nil {
+		return nil, errors.Wrap(err, "FollowingUseCase.Following")
+	}
+
+	return followsPage(follows, input.Limit), nil
+}
+
+// checkListAccess hides the follow lists of the private accounts from non-followers and of the blocked users
+func (uc *FollowingUseCase) checkListAccess(ctx context.Context, userID domain.ID, executor *domain.User) error {
+	canView, err := uc.repo.CanViewAuthor(ctx, userID, executorID(executor))
+	if err != nil {
+		return errors.Wrap(err, "FollowingUseCase.checkListAccess")
+	}
+	if !canView {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// withNextPageProbe requests one extra follow to know whether the next page exists
+func withNextPageProbe(input *domain.FollowListInput) *domain.FollowListInput {
+	probe := *input
+	probe.Limit++
+	return &probe
+}
+
+func followsPage(follows []domain.Follow, limit int) *domain.CursorPagination[domain.Follow] {
+	page := &domain.CursorPagination[domain.Follow]{Items: follows}
+	if len(follows) > limit {
+		page.Items = follows[:limit]
+		page.NextCursor = domain.NewFollowCursor(&page.Items[limit-1]).String()
+	}
+
+	return page
+}
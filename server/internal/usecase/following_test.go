@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
 	"github.com/pillowskiy/gopix/internal/usecase"
 	usecaseMock "github.com/pillowskiy/gopix/internal/usecase/mock"
 	"github.com/stretchr/testify/assert"
@@ -19,20 +21,74 @@ func TestFollowingUseCase_Follow(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := usecaseMock.NewMockFollowingRepository(ctrl)
-	followingUC := usecase.NewFollowingUseCase(mockRepo)
+	mockRestrictions := usecaseMock.NewMockFollowingRestrictions(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	followingUC := usecase.NewFollowingUseCase(mockRepo, mockRestrictions, mockNotifMng)
 
 	mockUserID := domain.ID(1)
 	mockExecutor := &domain.User{ID: 2}
 
 	t.Run("SuccessFollow", func(t *testing.T) {
+		mockRestrictions.EXPECT().IsBlocked(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
 		mockRepo.EXPECT().IsFollowing(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
+		mockRepo.EXPECT().IsPrivate(gomock.Any(), mockUserID).Return(false, nil)
 		mockRepo.EXPECT().Follow(gomock.Any(), mockUserID, mockExecutor.ID).Return(nil)
 
 		err := followingUC.Follow(context.Background(), mockUserID, mockExecutor)
 		assert.NoError(t, err)
 	})
 
+	t.Run("SuccessRequest_Private", func(t *testing.T) {
+		mockRestrictions.EXPECT().IsBlocked(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
+		mockRepo.EXPECT().IsFollowing(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
+		mockRepo.EXPECT().IsPrivate(gomock.Any(), mockUserID).Return(true, nil)
+		mockRepo.EXPECT().HasRequested(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
+		mockRepo.EXPECT().RequestFollow(gomock.Any(), mockUserID, mockExecutor.ID).Return(nil)
+		mockRepo.EXPECT().Follow(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockNotifMng.EXPECT().Notify(gomock.Any(), mockUserID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ domain.ID, notif *domain.Notification) error {
+				assert.Equal(t, &mockExecutor.ID, notif.ActorID)
+				return nil
+			},
+		)
+
+		err := followingUC.Follow(context.Background(), mockUserID, mockExecutor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("AlreadyRequested", func(t *testing.T) {
+		mockRestrictions.EXPECT().IsBlocked(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
+		mockRepo.EXPECT().IsFollowing(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
+		mockRepo.EXPECT().IsPrivate(gomock.Any(), mockUserID).Return(true, nil)
+		mockRepo.EXPECT().HasRequested(gomock.Any(), mockExecutor.ID, mockUserID).Return(true, nil)
+		mockRepo.EXPECT().RequestFollow(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := followingUC.Follow(context.Background(), mockUserID, mockExecutor)
+		assert.Equal(t, usecase.ErrAlreadyExists, err)
+	})
+
+	t.Run("ConcurrentRequest", func(t *testing.T) {
+		mockRestrictions.EXPECT().IsBlocked(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
+		mockRepo.EXPECT().IsFollowing(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
+		mockRepo.EXPECT().IsPrivate(gomock.Any(), mockUserID).Return(true, nil)
+		mockRepo.EXPECT().HasRequested(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
+		mockRepo.EXPECT().RequestFollow(gomock.Any(), mockUserID, mockExecutor.ID).Return(repository.ErrAlreadyExists)
+		mockNotifMng.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := followingUC.Follow(context.Background(), mockUserID, mockExecutor)
+		assert.Equal(t, usecase.ErrAlreadyExists, err)
+	})
+
+	t.Run("Blocked", func(t *testing.T) {
+		mockRestrictions.EXPECT().IsBlocked(gomock.Any(), mockExecutor.ID, mockUserID).Return(true, nil)
+		mockRepo.EXPECT().Follow(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := followingUC.Follow(context.Background(), mockUserID, mockExecutor)
+		assert.Equal(t, usecase.ErrForbidden, err)
+	})
+
 	t.Run("AlreadyFollowing", func(t *testing.T) {
+		mockRestrictions.EXPECT().IsBlocked(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
 		mockRepo.EXPECT().IsFollowing(gomock.Any(), mockExecutor.ID, mockUserID).Return(true, nil)
 		mockRepo.EXPECT().Follow(gomock.Any(), mockUserID, mockExecutor.ID).Times(0)
 
@@ -43,6 +99,7 @@ func TestFollowingUseCase_Follow(t *testing.T) {
 	})
 
 	t.Run("RepoErrror_IsFollowing", func(t *testing.T) {
+		mockRestrictions.EXPECT().IsBlocked(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
 		mockRepo.EXPECT().IsFollowing(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, errors.New("repo error"))
 		mockRepo.EXPECT().Follow(gomock.Any(), mockUserID, mockExecutor.ID).Times(0)
 
@@ -51,7 +108,9 @@ func TestFollowingUseCase_Follow(t *testing.T) {
 	})
 
 	t.Run("RepoError", func(t *testing.T) {
+		mockRestrictions.EXPECT().IsBlocked(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
 		mockRepo.EXPECT().IsFollowing(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
+		mockRepo.EXPECT().IsPrivate(gomock.Any(), mockUserID).Return(false, nil)
 		mockRepo.EXPECT().Follow(gomock.Any(), mockUserID, mockExecutor.ID).Return(errors.New("repo error"))
 
 		err := followingUC.Follow(context.Background(), mockUserID, mockExecutor)
@@ -66,7 +125,9 @@ func TestFollowingUseCase_Unfollow(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := usecaseMock.NewMockFollowingRepository(ctrl)
-	followingUC := usecase.NewFollowingUseCase(mockRepo)
+	mockRestrictions := usecaseMock.NewMockFollowingRestrictions(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	followingUC := usecase.NewFollowingUseCase(mockRepo, mockRestrictions, mockNotifMng)
 
 	mockUserID := domain.ID(1)
 	mockExecutor := &domain.User{ID: 2}
@@ -79,8 +140,18 @@ func TestFollowingUseCase_Unfollow(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("SuccessCancelRequest", func(t *testing.T) {
+		mockRepo.EXPECT().IsFollowing(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
+		mockRepo.EXPECT().HasRequested(gomock.Any(), mockExecutor.ID, mockUserID).Return(true, nil)
+		mockRepo.EXPECT().CancelRequest(gomock.Any(), mockUserID, mockExecutor.ID).Return(nil)
+
+		err := followingUC.Unfollow(context.Background(), mockUserID, mockExecutor)
+		assert.NoError(t, err)
+	})
+
 	t.Run("NotFollowing", func(t *testing.T) {
 		mockRepo.EXPECT().IsFollowing(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
+		mockRepo.EXPECT().HasRequested(gomock.Any(), mockExecutor.ID, mockUserID).Return(false, nil)
 		mockRepo.EXPECT().Unfollow(gomock.Any(), mockUserID, mockExecutor.ID).Times(0)
 
 		err := followingUC.Unfollow(context.Background(), mockUserID, mockExecutor)
@@ -105,6 +176,81 @@ func TestFollowingUseCase_Unfollow(t *testing.T) {
 	})
 }
 
+func TestFollowingUseCase_ApproveRequest(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockFollowingRepository(ctrl)
+	mockRestrictions := usecaseMock.NewMockFollowingRestrictions(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	followingUC := usecase.NewFollowingUseCase(mockRepo, mockRestrictions, mockNotifMng)
+
+	mockRequesterID := domain.ID(1)
+	mockExecutor := &domain.User{ID: 2}
+
+	t.Run("SuccessApprove", func(t *testing.T) {
+		mockRepo.EXPECT().ApproveRequest(gomock.Any(), mockRequesterID, mockExecutor.ID).Return(nil)
+		mockNotifMng.EXPECT().Notify(gomock.Any(), mockRequesterID, gomock.Any()).Return(nil)
+
+		err := followingUC.ApproveRequest(context.Background(), mockRequesterID, mockExecutor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().ApproveRequest(gomock.Any(), mockRequesterID, mockExecutor.ID).Return(repository.ErrNotFound)
+		mockNotifMng.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := followingUC.ApproveRequest(context.Background(), mockRequesterID, mockExecutor)
+		assert.Equal(t, usecase.ErrNotFound, err)
+	})
+
+	t.Run("AlreadyFollowing", func(t *testing.T) {
+		mockRepo.EXPECT().ApproveRequest(gomock.Any(), mockRequesterID, mockExecutor.ID).Return(repository.ErrAlreadyExists)
+		mockNotifMng.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := followingUC.ApproveRequest(context.Background(), mockRequesterID, mockExecutor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo.EXPECT().ApproveRequest(gomock.Any(), mockRequesterID, mockExecutor.ID).Return(errors.New("repo error"))
+
+		err := followingUC.ApproveRequest(context.Background(), mockRequesterID, mockExecutor)
+		assert.Error(t, err)
+	})
+}
+
+func TestFollowingUseCase_DenyRequest(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockFollowingRepository(ctrl)
+	mockRestrictions := usecaseMock.NewMockFollowingRestrictions(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	followingUC := usecase.NewFollowingUseCase(mockRepo, mockRestrictions, mockNotifMng)
+
+	mockRequesterID := domain.ID(1)
+	mockExecutor := &domain.User{ID: 2}
+
+	t.Run("SuccessDeny", func(t *testing.T) {
+		mockRepo.EXPECT().DenyRequest(gomock.Any(), mockRequesterID, mockExecutor.ID).Return(nil)
+
+		err := followingUC.DenyRequest(context.Background(), mockRequesterID, mockExecutor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().DenyRequest(gomock.Any(), mockRequesterID, mockExecutor.ID).Return(repository.ErrNotFound)
+
+		err := followingUC.DenyRequest(context.Background(), mockRequesterID, mockExecutor)
+		assert.Equal(t, usecase.ErrNotFound, err)
+	})
+}
+
 func TestFollowingUseCase_IsFollowing(t *testing.T) {
 	t.Parallel()
 
@@ -112,7 +258,9 @@ func TestFollowingUseCase_IsFollowing(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := usecaseMock.NewMockFollowingRepository(ctrl)
-	followingUC := usecase.NewFollowingUseCase(mockRepo)
+	mockRestrictions := usecaseMock.NewMockFollowingRestrictions(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	followingUC := usecase.NewFollowingUseCase(mockRepo, mockRestrictions, mockNotifMng)
 
 	mockFollowerID := domain.ID(1)
 	mockFollowingID := domain.ID(2)
@@ -141,7 +289,9 @@ func TestFollowingUseCase_Stats(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := usecaseMock.NewMockFollowingRepository(ctrl)
-	followingUC := usecase.NewFollowingUseCase(mockRepo)
+	mockRestrictions := usecaseMock.NewMockFollowingRestrictions(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	followingUC := usecase.NewFollowingUseCase(mockRepo, mockRestrictions, mockNotifMng)
 
 	mockUserID := domain.ID(1)
 	mockExecutorID := new(domain.ID)
@@ -162,3 +312,132 @@ func TestFollowingUseCase_Stats(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestFollowingUseCase_Followers(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockFollowingRepository(ctrl)
+	mockRestrictions := usecaseMock.NewMockFollowingRestrictions(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	followingUC := usecase.NewFollowingUseCase(mockRepo, mockRestrictions, mockNotifMng)
+
+	mockUserID := domain.ID(1)
+	mockExecutor := &domain.User{ID: 2}
+	followedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mockFollows := []domain.Follow{
+		{ImageAuthor: domain.ImageAuthor{ID: 1843246093478330369}, FollowedAt: followedAt},
+		{ImageAuthor: domain.ImageAuthor{ID: 1843246093478330368}, FollowedAt: followedAt},
+		{ImageAuthor: domain.ImageAuthor{ID: 1843246093478330367}, FollowedAt: followedAt.Add(-time.Hour)},
+	}
+
+	t.Run("SuccessFollowers_NextPage", func(t *testing.T) {
+		input := &domain.FollowListInput{Query: "us", Limit: 2}
+		mockRepo.EXPECT().CanViewAuthor(gomock.Any(), mockUserID, &mockExecutor.ID).Return(true, nil)
+		mockRepo.EXPECT().Followers(gomock.Any(), mockUserID, &mockExecutor.ID, &domain.FollowListInput{
+			Query: "us", Limit: 3,
+		}).Return(mockFollows, nil)
+
+		page, err := followingUC.Followers(context.Background(), mockUserID, input, mockExecutor)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t, mockFollows[:2], page.Items)
+		cursor, err := domain.ParseFollowCursor(page.NextCursor)
+		if assert.NoError(t, err) {
+			assert.Equal(t, &domain.FollowCursor{FollowedAt: followedAt, UserID: mockFollows[1].ID}, cursor)
+		}
+	})
+
+	t.Run("SuccessFollowers_LastPage", func(t *testing.T) {
+		input := &domain.FollowListInput{Limit: 5}
+		mockRepo.EXPECT().CanViewAuthor(gomock.Any(), mockUserID, gomock.Nil()).Return(true, nil)
+		mockRepo.EXPECT().Followers(gomock.Any(), mockUserID, nil, gomock.Any()).Return(mockFollows, nil)
+
+		page, err := followingUC.Followers(context.Background(), mockUserID, input, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, mockFollows, page.Items)
+			assert.Empty(t, page.NextCursor)
+		}
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		input := &domain.FollowListInput{Limit: 5}
+		mockRepo.EXPECT().CanViewAuthor(gomock.Any(), mockUserID, gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().Followers(gomock.Any(), mockUserID, gomock.Any(), gomock.Any()).Return(
+			nil, errors.New("repo error"),
+		)
+
+		page, err := followingUC.Followers(context.Background(), mockUserID, input, mockExecutor)
+		assert.Error(t, err)
+		assert.Nil(t, page)
+	})
+
+	t.Run("HiddenUser", func(t *testing.T) {
+		input := &domain.FollowListInput{Limit: 1}
+		mockRepo.EXPECT().CanViewAuthor(gomock.Any(), mockUserID, &mockExecutor.ID).Return(false, nil)
+		mockRepo.EXPECT().Followers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		page, err := followingUC.Followers(context.Background(), mockUserID, input, mockExecutor)
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+		assert.Nil(t, page)
+	})
+}
+
+func TestFollowingUseCase_Following(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockFollowingRepository(ctrl)
+	mockRestrictions := usecaseMock.NewMockFollowingRestrictions(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	followingUC := usecase.NewFollowingUseCase(mockRepo, mockRestrictions, mockNotifMng)
+
+	mockUserID := domain.ID(1)
+	mockExecutor := &domain.User{ID: 2}
+
+	t.Run("SuccessFollowing", func(t *testing.T) {
+		cursor := &domain.FollowCursor{FollowedAt: time.Now().UTC(), UserID: 3}
+		input := &domain.FollowListInput{Limit: 1, Cursor: cursor}
+		mockFollows := []domain.Follow{{ImageAuthor: domain.ImageAuthor{ID: 2}, FollowsYou: true}}
+
+		mockRepo.EXPECT().CanViewAuthor(gomock.Any(), mockUserID, &mockExecutor.ID).Return(true, nil)
+		mockRepo.EXPECT().Following(gomock.Any(), mockUserID, &mockExecutor.ID, &domain.FollowListInput{
+			Limit: 2, Cursor: cursor,
+		}).Return(mockFollows, nil)
+
+		page, err := followingUC.Following(context.Background(), mockUserID, input, mockExecutor)
+		if assert.NoError(t, err) {
+			assert.Equal(t, mockFollows, page.Items)
+			assert.Empty(t, page.NextCursor)
+		}
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		input := &domain.FollowListInput{Limit: 1}
+		mockRepo.EXPECT().CanViewAuthor(gomock.Any(), mockUserID, gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().Following(gomock.Any(), mockUserID, gomock.Any(), gomock.Any()).Return(
+			nil, errors.New("repo error"),
+		)
+
+		page, err := followingUC.Following(context.Background(), mockUserID, input, mockExecutor)
+		assert.Error(t, err)
+		assert.Nil(t, page)
+	})
+
+	t.Run("HiddenUser", func(t *testing.T) {
+		input := &domain.FollowListInput{Limit: 1}
+		mockRepo.EXPECT().CanViewAuthor(gomock.Any(), mockUserID, &mockExecutor.ID).Return(false, nil)
+		mockRepo.EXPECT().Following(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		page, err := followingUC.Following(context.Background(), mockUserID, input, mockExecutor)
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+		assert.Nil(t, page)
+	})
+}
@@ -7,11 +7,16 @@ import (
 
 	"github.com/pillowskiy/gopix/internal/domain"
 	"github.com/pillowskiy/gopix/internal/repository"
+	"github.com/pillowskiy/gopix/pkg/image"
 	"github.com/pillowskiy/gopix/pkg/logger"
 )
 
 const imageTTL = 3600
 
+// The vector service has no notion of pagination or access levels,
+// so we take a fixed window of the nearest images and paginate it on our side
+const semanticSearchLimit = 200
+
 type ImageFileStorage interface {
 	Put(ctx context.Context, file *domain.FileNode) error
 	Delete(ctx context.Context, path string) error
@@ -33,13 +38,21 @@ type ImageRepository interface {
 	AddView(ctx context.Context, imageID domain.ID, userID *domain.ID) error
 	States(ctx context.Context, imageID domain.ID, userID domain.ID) (*domain.ImageStates, error)
 	Discover(
-		ctx context.Context, pagInput *domain.PaginationInput, sort domain.ImageSortMethod,
+		ctx context.Context, pagInput *domain.PaginationInput, sort domain.ImageSortMethod, viewerID *domain.ID,
 	) (*domain.Pagination[domain.ImageWithMeta], error)
 	HasLike(ctx context.Context, imageID domain.ID, userID domain.ID) (bool, error)
 	AddLike(ctx context.Context, imageID domain.ID, userID domain.ID) error
 	RemoveLike(ctx context.Context, imageID domain.ID, userID domain.ID) error
 	Favorites(
-		ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput,
+		ctx context.Context, userID domain.ID, viewerID *domain.ID, pagInput *domain.PaginationInput,
+	) (*domain.Pagination[domain.ImageWithMeta], error)
+	Search(
+		ctx context.Context, query string, viewerID *domain.ID, pagInput *domain.PaginationInput,
+	) (*domain.Pagination[domain.ImageWithMeta], error)
+	SetCommentsLocked(ctx context.Context, id domain.ID, locked bool) error
+	CanViewAuthor(ctx context.Context, authorID domain.ID, viewerID *domain.ID) (bool, error)
+	FindManyVisible(
+		ctx context.Context, ids []domain.ID, viewerID *domain.ID, pagInput *domain.PaginationInput,
 	) (*domain.Pagination[domain.ImageWithMeta], error)
 
 	repository.Transactional
@@ -49,6 +62,8 @@ type ImageFeaturesUseCase interface {
 	CreateFileNode(ctx context.Context, file *domain.File) (*domain.FileNode, error)
 	ExtractFeatures(ctx context.Context, imageID domain.ID, file *domain.FileNode) error
 	Similar(ctx context.Context, imageID domain.ID) ([]domain.ID, error)
+	SearchByImage(ctx context.Context, file *domain.FileNode) ([]domain.ID, error)
+	SearchByText(ctx context.Context, query string, limit int) ([]domain.ID, error)
 	DeleteFeatures(ctx context.Context, imageID domain.ID) error
 }
 
@@ -60,14 +75,21 @@ type NotificationManager interface {
 	Notify(ctx context.Context, userID domain.ID, notif *domain.Notification) error
 }
 
+// ImageTagSuggester tags the freshly uploaded images with the high-confidence suggestions
+type ImageTagSuggester interface {
+	AutoApply(ctx context.Context, imageID domain.ID) ([]domain.TagSuggestion, error)
+}
+
 type imageUseCase struct {
-	storage    ImageFileStorage
-	cache      ImageCache
-	repo       ImageRepository
-	featuresUC ImageFeaturesUseCase
-	acl        ImageAccessPolicy
-	notifMng   NotificationManager
-	logger     logger.Logger
+	storage      ImageFileStorage
+	cache        ImageCache
+	repo         ImageRepository
+	featuresUC   ImageFeaturesUseCase
+	acl          ImageAccessPolicy
+	notifMng     NotificationManager
+	reactionUC   ReactionCounter
+	tagSuggester ImageTagSuggester
+	logger       logger.Logger
 }
 
 func NewImageUseCase(
@@ -77,16 +99,20 @@ func NewImageUseCase(
 	featuresUC ImageFeaturesUseCase,
 	acl ImageAccessPolicy,
 	notifMng NotificationManager,
+	reactionUC ReactionCounter,
+	tagSuggester ImageTagSuggester,
 	logger logger.Logger,
 ) *imageUseCase {
 	return &imageUseCase{
-		storage:    storage,
-		repo:       repo,
-		featuresUC: featuresUC,
-		cache:      cache,
-		acl:        acl,
-		notifMng:   notifMng,
-		logger:     logger,
+		storage:      storage,
+		repo:         repo,
+		featuresUC:   featuresUC,
+		cache:        cache,
+		acl:          acl,
+		notifMng:     notifMng,
+		reactionUC:   reactionUC,
+		tagSuggester: tagSuggester,
+		logger:       logger,
 	}
 }
 
@@ -128,6 +154,12 @@ func (uc *imageUseCase) Create(
 	})
 	if err != nil {
 		uc.logger.Error(err)
+		return
+	}
+
+	// The image is already uploaded, so the failed suggestions shouldn't fail the upload
+	if _, tagErr := uc.tagSuggester.AutoApply(ctx, img.ID); tagErr != nil {
+		uc.logger.Errorf("ImageUseCase.Create.AutoApply: %v", tagErr)
 	}
 
 	return
@@ -148,6 +180,85 @@ func (uc *imageUseCase) Similar(
 	return uc.repo.FindMany(ctx, ids)
 }
 
+func (uc *imageUseCase) SearchSimilar(
+	ctx context.Context, file *domain.File,
+) ([]domain.ImageWithMeta, error) {
+	fileNode, err := uc.featuresUC.CreateFileNode(ctx, file)
+	if err != nil {
+		if errors.Is(err, image.ErrUnsupportedMime) {
+			return nil, ErrUnprocessable
+		}
+		return nil, fmt.Errorf("failed to create file node: %w", err)
+	}
+
+	if !fileNode.HasAllowedContentType() {
+		return nil, ErrUnprocessable
+	}
+
+	ids, err := uc.featuresUC.SearchByImage(ctx, fileNode)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return []domain.ImageWithMeta{}, nil
+	}
+
+	images, err := uc.repo.FindMany(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only public images are exposed to an ad-hoc search,
+	// keeping the vector service ordering (the closest first)
+	imagesMap := make(map[domain.ID]domain.ImageWithMeta, len(images))
+	for _, img := range images {
+		imagesMap[img.ID] = img
+	}
+
+	res := make([]domain.ImageWithMeta, 0, len(images))
+	for _, id := range ids {
+		img, ok := imagesMap[id]
+		if ok && img.AccessLevel == domain.ImageAccessPublic {
+			res = append(res, img)
+		}
+	}
+
+	return res, nil
+}
+
+func (uc *imageUseCase) Search(
+	ctx context.Context,
+	query string,
+	mode domain.ImageSearchMode,
+	pagInput *domain.PaginationInput,
+	executor *domain.User,
+) (*domain.Pagination[domain.ImageWithMeta], error) {
+	var viewerID *domain.ID
+	if executor != nil {
+		viewerID = &executor.ID
+	}
+
+	if mode == domain.ImageSearchSemantic {
+		ids, err := uc.featuresUC.SearchByText(ctx, query, semanticSearchLimit)
+		if err == nil {
+			if len(ids) == 0 {
+				return &domain.Pagination[domain.ImageWithMeta]{
+					Items:           []domain.ImageWithMeta{},
+					PaginationInput: *pagInput,
+				}, nil
+			}
+
+			return uc.repo.FindManyVisible(ctx, ids, viewerID, pagInput)
+		}
+
+		// The vector service is optional for search, so we degrade to full-text one
+		uc.logger.Warnf("ImageUseCase.Search: semantic search is unavailable: %v", err)
+	}
+
+	return uc.repo.Search(ctx, query, viewerID, pagInput)
+}
+
 func (uc *imageUseCase) Delete(
 	ctx context.Context,
 	id domain.ID,
@@ -186,7 +297,9 @@ func (uc *imageUseCase) Delete(
 	return nil
 }
 
-func (uc *imageUseCase) GetDetailed(ctx context.Context, id domain.ID) (*domain.DetailedImage, error) {
+func (uc *imageUseCase) GetDetailed(
+	ctx context.Context, id domain.ID, executor *domain.User,
+) (*domain.DetailedImage, error) {
 	img, err := uc.repo.GetDetailed(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
@@ -195,9 +308,28 @@ func (uc *imageUseCase) GetDetailed(ctx context.Context, id domain.ID) (*domain.
 		return nil, err
 	}
 
+	canView, err := uc.CanViewAuthor(ctx, img.AuthorID, executor)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return nil, ErrForbidden
+	}
+
+	reactions, err := uc.reactionUC.Counts(ctx, domain.ReactionTargetImage, []domain.ID{id}, nil)
+	if err != nil {
+		return nil, err
+	}
+	img.Reactions = orEmptyReactions(reactions[id])
+
 	return img, nil
 }
 
+// CanViewAuthor reports whether the author is neither private for the executor nor blocked with them
+func (uc *imageUseCase) CanViewAuthor(ctx context.Context, authorID domain.ID, executor *domain.User) (bool, error) {
+	return uc.repo.CanViewAuthor(ctx, authorID, executorID(executor))
+}
+
 func (uc *imageUseCase) AddView(ctx context.Context, imageID domain.ID, userID *domain.ID) error {
 	return uc.repo.AddView(ctx, imageID, userID)
 }
@@ -221,15 +353,26 @@ func (uc *imageUseCase) RemoveLike(ctx context.Context, imageID domain.ID, userI
 func (uc *imageUseCase) States(
 	ctx context.Context, imageID domain.ID, userID domain.ID,
 ) (*domain.ImageStates, error) {
-	return uc.repo.States(ctx, imageID, userID)
+	states, err := uc.repo.States(ctx, imageID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	states.Reactions, err = uc.reactionUC.UserReactions(ctx, domain.ReactionTargetImage, imageID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return states, nil
 }
 
 func (uc *imageUseCase) Discover(
 	ctx context.Context,
 	pagInput *domain.PaginationInput,
 	sort domain.ImageSortMethod,
+	executor *domain.User,
 ) (*domain.Pagination[domain.ImageWithMeta], error) {
-	pag, err := uc.repo.Discover(ctx, pagInput, sort)
+	pag, err := uc.repo.Discover(ctx, pagInput, sort, executorID(executor))
 	if err != nil && errors.Is(err, repository.ErrIncorrectInput) {
 		return nil, ErrUnprocessable
 	}
@@ -241,8 +384,9 @@ func (uc *imageUseCase) Favorites(
 	ctx context.Context,
 	userID domain.ID,
 	pagInput *domain.PaginationInput,
+	executor *domain.User,
 ) (*domain.Pagination[domain.ImageWithMeta], error) {
-	pag, err := uc.repo.Favorites(ctx, userID, pagInput)
+	pag, err := uc.repo.Favorites(ctx, userID, executorID(executor), pagInput)
 	if err != nil && errors.Is(err, repository.ErrIncorrectInput) {
 		return nil, ErrUnprocessable
 	}
@@ -304,6 +448,18 @@ func (uc *imageUseCase) Update(
 	return updated, nil
 }
 
+func (uc *imageUseCase) SetCommentsLocked(ctx context.Context, id domain.ID, locked bool) error {
+	if err := uc.repo.SetCommentsLocked(ctx, id, locked); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	uc.deleteCachedImage(ctx, id)
+	return nil
+}
+
 func (uc *imageUseCase) deleteCachedImage(ctx context.Context, id domain.ID) {
 	if err := uc.cache.Del(ctx, id.String()); err != nil {
 		uc.logger.Errorf("ImageUseCase.deleteCached: %v", err)
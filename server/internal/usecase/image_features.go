@@ -13,7 +13,15 @@ import (
 
 type ImageVecRepository interface {
 	Similar(ctx context.Context, imageID domain.ID) ([]domain.ID, error)
+	SearchByImage(ctx context.Context, file *domain.FileNode) ([]domain.ID, error)
+	SearchByText(ctx context.Context, query string, limit int) ([]domain.ID, error)
 	Features(ctx context.Context, imageID domain.ID, file *domain.FileNode) error
+	// BatchFeatures reports the error of every image separately,
+	// the returned error means the whole batch has failed
+	BatchFeatures(ctx context.Context, files map[domain.ID]*domain.FileNode) (map[domain.ID]error, error)
+	// ReplaceFeatures works like BatchFeatures, but overwrites the stored vectors.
+	// The old vector is kept until the new one is computed
+	ReplaceFeatures(ctx context.Context, files map[domain.ID]*domain.FileNode) (map[domain.ID]error, error)
 	DeleteFeatures(ctx context.Context, imageID domain.ID) error
 }
 
@@ -120,3 +128,12 @@ func (uc *imageFeaturesUseCase) Similar(ctx context.Context, imageID domain.ID)
 	// TODO: Add search by image properties if the vector repo found nothing
 	return uc.vecRepo.Similar(ctx, imageID)
 }
+
+func (uc *imageFeaturesUseCase) SearchByImage(ctx context.Context, fileNode *domain.FileNode) ([]domain.ID, error) {
+	defer fileNode.Restore()
+	return uc.vecRepo.SearchByImage(ctx, fileNode)
+}
+
+func (uc *imageFeaturesUseCase) SearchByText(ctx context.Context, query string, limit int) ([]domain.ID, error) {
+	return uc.vecRepo.SearchByText(ctx, query, limit)
+}
@@ -0,0 +1,104 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
+	"github.com/pillowskiy/gopix/internal/repository/fakerepo"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	usecaseMock "github.com/pillowskiy/gopix/internal/usecase/mock"
+	loggerMock "github.com/pillowskiy/gopix/pkg/logger/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImageFeaturesUseCase_FakeVecRepository(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vecRepo := fakerepo.NewVectorizationRepository()
+	mockPropsRepo := usecaseMock.NewMockImagePropsRepository(ctrl)
+	mockExtractor := usecaseMock.NewMockFeaturesExtractor(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+
+	featUC := usecase.NewImageFeaturesUseCase(vecRepo, mockPropsRepo, mockExtractor, mockLog)
+
+	newFileNode := func(data []byte) *domain.FileNode {
+		return &domain.FileNode{
+			File: domain.File{Reader: bytes.NewReader(data), Size: int64(len(data))},
+			Name: "image.png",
+		}
+	}
+
+	expectExtractCall := func(imageID domain.ID) {
+		mockPropsRepo.EXPECT().Properties(gomock.Any(), imageID).Return(nil, repository.ErrNotFound)
+		mockPropsRepo.EXPECT().
+			DoInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn repository.InTransactionalCall) error {
+				return fn(ctx)
+			})
+		mockExtractor.EXPECT().Features(gomock.Any(), gomock.Any()).Return(&domain.ImageProperties{}, nil)
+		mockPropsRepo.EXPECT().Create(gomock.Any(), imageID, gomock.Any()).Return(nil)
+	}
+
+	firstImage := []byte{1, 1, 1, 2, 2, 3}
+	secondImage := []byte{200, 201, 202, 203, 204, 205}
+
+	expectExtractCall(1)
+	assert.NoError(t, featUC.ExtractFeatures(context.Background(), 1, newFileNode(firstImage)))
+	expectExtractCall(2)
+	assert.NoError(t, featUC.ExtractFeatures(context.Background(), 2, newFileNode(secondImage)))
+
+	t.Run("Similar", func(t *testing.T) {
+		ids, err := featUC.Similar(context.Background(), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.ID{2, 1}, ids)
+	})
+
+	t.Run("SearchByImage", func(t *testing.T) {
+		ids, err := featUC.SearchByImage(context.Background(), newFileNode(firstImage))
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.ID{1, 2}, ids)
+	})
+
+	t.Run("SearchByText_Limit", func(t *testing.T) {
+		ids, err := featUC.SearchByText(context.Background(), "red car", 1)
+		assert.NoError(t, err)
+		assert.Len(t, ids, 1)
+	})
+
+	t.Run("BatchFeatures", func(t *testing.T) {
+		errs, err := vecRepo.BatchFeatures(context.Background(), map[domain.ID]*domain.FileNode{
+			2: newFileNode(secondImage),
+			3: newFileNode(firstImage),
+		})
+		assert.NoError(t, err)
+		assert.Error(t, errs[2])
+		assert.NoError(t, errs[3])
+
+		assert.NoError(t, vecRepo.DeleteFeatures(context.Background(), 3))
+	})
+
+	t.Run("ReplaceFeatures", func(t *testing.T) {
+		errs, err := vecRepo.ReplaceFeatures(context.Background(), map[domain.ID]*domain.FileNode{
+			2: newFileNode(firstImage),
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, errs[2])
+
+		ids, err := featUC.Similar(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Contains(t, ids, domain.ID(2))
+	})
+
+	t.Run("SimilarNotFound", func(t *testing.T) {
+		ids, err := featUC.Similar(context.Background(), 3)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+		assert.Nil(t, ids)
+	})
+}
@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+)
+
+const (
+	defaultReindexBatchSize   = 50
+	defaultReindexConcurrency = 4
+)
+
+type ReindexImageRepository interface {
+	// ListAfter returns images ordered by id, starting right after the cursor.
+	// When onlyMissing is set, only the images without known dimensions are returned
+	ListAfter(ctx context.Context, afterID domain.ID, limit int, onlyMissing bool) ([]domain.Image, error)
+	CountReindex(ctx context.Context, afterID domain.ID, onlyMissing bool) (int, error)
+}
+
+type ImageFileReader interface {
+	Get(ctx context.Context, path string) (*domain.File, error)
+}
+
+type ReindexPropsRepository interface {
+	Upsert(ctx context.Context, imageID domain.ID, props *domain.ImageProperties) error
+}
+
+type ReindexOptions struct {
+	// AfterID is the checkpoint cursor, images with greater ids are processed
+	AfterID     domain.ID
+	BatchSize   int
+	Concurrency int
+	// OnlyMissing skips images that already have their dimensions computed
+	OnlyMissing bool
+	Properties  bool
+	Vectors     bool
+	// DryRun downloads and inspects the images without writing anything
+	DryRun bool
+}
+
+type ReindexFailure struct {
+	ImageID domain.ID
+	Err     error
+}
+
+type ReindexProgress struct {
+	// LastID is safe to resume from, every image up to it has been reindexed successfully.
+	// It stops moving at the first failure, so a resumed run retries the failed images
+	LastID    domain.ID
+	Total     int
+	Processed int
+	Failed    int
+	Failures  []ReindexFailure
+}
+
+type imageReindexUseCase struct {
+	imgRepo       ReindexImageRepository
+	fileReader    ImageFileReader
+	propsRepo     ReindexPropsRepository
+	vecRepo       ImageVecRepository
+	featExtractor FeaturesExtractor
+}
+
+func NewImageReindexUseCase(
+	imgRepo ReindexImageRepository,
+	fileReader ImageFileReader,
+	propsRepo ReindexPropsRepository,
+	vecRepo ImageVecRepository,
+	featExtractor FeaturesExtractor,
+) *imageReindexUseCase {
+	return &imageReindexUseCase{
+		imgRepo:       imgRepo,
+		fileReader:    fileReader,
+		propsRepo:     propsRepo,
+		vecRepo:       vecRepo,
+		featExtractor: featExtractor,
+	}
+}
+
+// Reindex walks all the images in batches and recomputes their properties and vectors.
+// onBatch is called after every batch with the failures of that batch only,
+// returning an error from it stops the reindex
+func (uc *imageReindexUseCase) Reindex(
+	ctx context.Context, opts ReindexOptions, onBatch func(progress *ReindexProgress) error,
+) (*ReindexProgress, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultReindexBatchSize
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultReindexConcurrency
+	}
+
+	total, err := uc.imgRepo.CountReindex(ctx, opts.AfterID, opts.OnlyMissing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count images: %w", err)
+	}
+
+	progress := &ReindexProgress{LastID: opts.AfterID, Total: total}
+	cursor, failed := opts.AfterID, false
+	for {
+		images, err := uc.imgRepo.ListAfter(ctx, cursor, opts.BatchSize, opts.OnlyMissing)
+		if err != nil {
+			return progress, fmt.Errorf("failed to list images: %w", err)
+		}
+
+		if len(images) == 0 {
+			return progress, nil
+		}
+
+		failures := uc.reindexBatch(ctx, images, &opts)
+		if err := ctx.Err(); err != nil {
+			// The batch was interrupted, the checkpoint must not move past it
+			return progress, err
+		}
+
+		cursor = images[len(images)-1].ID
+		if !failed {
+			progress.LastID, failed = lastSucceeded(progress.LastID, images, failures)
+		}
+		progress.Processed += len(images)
+		progress.Failed += len(failures)
+		progress.Failures = failures
+
+		if onBatch != nil {
+			if err := onBatch(progress); err != nil {
+				return progress, err
+			}
+		}
+	}
+}
+
+// lastSucceeded moves the checkpoint over the batch ordered by id up to the first failed image
+func lastSucceeded(lastID domain.ID, images []domain.Image, failures []ReindexFailure) (domain.ID, bool) {
+	failedIDs := make(map[domain.ID]struct{}, len(failures))
+	for _, failure := range failures {
+		failedIDs[failure.ImageID] = struct{}{}
+	}
+
+	for _, img := range images {
+		if _, ok := failedIDs[img.ID]; ok {
+			return lastID, true
+		}
+		lastID = img.ID
+	}
+
+	return lastID, false
+}
+
+func (uc *imageReindexUseCase) reindexBatch(
+	ctx context.Context, images []domain.Image, opts *ReindexOptions,
+) []ReindexFailure {
+	var (
+		mut      sync.Mutex
+		wg       sync.WaitGroup
+		failures []ReindexFailure
+		nodes    = make(map[domain.ID]*domain.FileNode, len(images))
+		sem      = make(chan struct{}, opts.Concurrency)
+	)
+
+	fail := func(imageID domain.ID, err error) {
+		mut.Lock()
+		defer mut.Unlock()
+		failures = append(failures, ReindexFailure{ImageID: imageID, Err: err})
+	}
+
+	for _, img := range images {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return failures
+		}
+
+		wg.Add(1)
+		go func(img domain.Image) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			node, err := uc.reindexImage(ctx, &img, opts)
+			if err != nil {
+				fail(img.ID, err)
+				return
+			}
+
+			mut.Lock()
+			nodes[img.ID] = node
+			mut.Unlock()
+		}(img)
+	}
+	wg.Wait()
+
+	if !opts.Vectors || opts.DryRun || len(nodes) == 0 {
+		return failures
+	}
+
+	errs, err := uc.vecRepo.ReplaceFeatures(ctx, nodes)
+	if err != nil {
+		for imageID := range nodes {
+			fail(imageID, fmt.Errorf("failed to submit vectors: %w", err))
+		}
+		return failures
+	}
+
+	for imageID, err := range errs {
+		if err != nil {
+			fail(imageID, fmt.Errorf("failed to submit vector: %w", err))
+		}
+	}
+
+	return failures
+}
+
+// reindexImage recomputes the image properties,
+// the returned file node is ready to replace the stored vector
+func (uc *imageReindexUseCase) reindexImage(
+	ctx context.Context, img *domain.Image, opts *ReindexOptions,
+) (*domain.FileNode, error) {
+	file, err := uc.fileReader.Get(ctx, img.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download image: %w", err)
+	}
+
+	node, err := uc.featExtractor.MakeFileNode(ctx, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect image: %w", err)
+	}
+	node.Name = img.Path
+
+	if err := node.Restore(); err != nil {
+		return nil, err
+	}
+
+	if opts.Properties {
+		props, err := uc.featExtractor.Features(ctx, node)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract features: %w", err)
+		}
+		if props.Mime == "" {
+			props.Mime = node.ContentType
+		}
+
+		if !opts.DryRun {
+			if err := uc.propsRepo.Upsert(ctx, img.ID, props); err != nil {
+				return nil, fmt.Errorf("failed to store image properties: %w", err)
+			}
+		}
+
+		if err := node.Restore(); err != nil {
+			return nil, err
+		}
+	}
+
+	return node, nil
+}
@@ -0,0 +1,138 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	usecaseMock "github.com/pillowskiy/gopix/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImageReindexUseCase_Reindex(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImgRepo := usecaseMock.NewMockReindexImageRepository(ctrl)
+	mockFileReader := usecaseMock.NewMockImageFileReader(ctrl)
+	mockPropsRepo := usecaseMock.NewMockReindexPropsRepository(ctrl)
+	mockVecRepo := usecaseMock.NewMockImageVecRepository(ctrl)
+	mockExtractor := usecaseMock.NewMockFeaturesExtractor(ctrl)
+
+	reindexUC := usecase.NewImageReindexUseCase(
+		mockImgRepo, mockFileReader, mockPropsRepo, mockVecRepo, mockExtractor,
+	)
+
+	images := []domain.Image{
+		{ID: 1, Path: "1.png"},
+		{ID: 2, Path: "2.png"},
+		{ID: 3, Path: "3.png"},
+	}
+	props := &domain.ImageProperties{Width: 10, Height: 10, Ext: "png"}
+
+	expectInspect := func(img domain.Image) {
+		file := &domain.File{Reader: bytes.NewReader([]byte{1}), Size: 1}
+		mockFileReader.EXPECT().Get(gomock.Any(), img.Path).Return(file, nil)
+		mockExtractor.EXPECT().MakeFileNode(gomock.Any(), file).
+			Return(&domain.FileNode{File: *file, ContentType: "image/png"}, nil)
+		mockExtractor.EXPECT().Features(gomock.Any(), gomock.Any()).Return(props, nil)
+	}
+
+	t.Run("SuccessReindex", func(t *testing.T) {
+		opts := usecase.ReindexOptions{BatchSize: 2, Properties: true, Vectors: true}
+
+		mockImgRepo.EXPECT().CountReindex(gomock.Any(), domain.ID(0), false).Return(len(images), nil)
+		mockImgRepo.EXPECT().ListAfter(gomock.Any(), domain.ID(0), 2, false).Return(images[:2], nil)
+		mockImgRepo.EXPECT().ListAfter(gomock.Any(), domain.ID(2), 2, false).Return(images[2:], nil)
+		mockImgRepo.EXPECT().ListAfter(gomock.Any(), domain.ID(3), 2, false).Return([]domain.Image{}, nil)
+
+		for _, img := range images {
+			expectInspect(img)
+			mockPropsRepo.EXPECT().Upsert(gomock.Any(), img.ID, props).Return(nil)
+		}
+		// The stored vectors are replaced, never deleted up front
+		mockVecRepo.EXPECT().DeleteFeatures(gomock.Any(), gomock.Any()).Times(0)
+		mockVecRepo.EXPECT().ReplaceFeatures(gomock.Any(), gomock.Len(2)).
+			Return(map[domain.ID]error{1: nil, 2: errors.New("vec error")}, nil)
+		mockVecRepo.EXPECT().ReplaceFeatures(gomock.Any(), gomock.Len(1)).
+			Return(map[domain.ID]error{3: nil}, nil)
+
+		var checkpoints []domain.ID
+		progress, err := reindexUC.Reindex(context.Background(), opts, func(p *usecase.ReindexProgress) error {
+			checkpoints = append(checkpoints, p.LastID)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		// The checkpoint stops before the failed image, so the next run retries it
+		assert.Equal(t, []domain.ID{1, 1}, checkpoints)
+		assert.Equal(t, 3, progress.Processed)
+		assert.Equal(t, 1, progress.Failed)
+		assert.Equal(t, "image/png", props.Mime)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		opts := usecase.ReindexOptions{AfterID: 2, Properties: true, Vectors: true, DryRun: true}
+
+		mockImgRepo.EXPECT().CountReindex(gomock.Any(), domain.ID(2), false).Return(1, nil)
+		mockImgRepo.EXPECT().ListAfter(gomock.Any(), domain.ID(2), gomock.Any(), false).Return(images[2:], nil)
+		mockImgRepo.EXPECT().ListAfter(gomock.Any(), domain.ID(3), gomock.Any(), false).Return(nil, nil)
+		expectInspect(images[2])
+
+		progress, err := reindexUC.Reindex(context.Background(), opts, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, progress.Processed)
+		assert.Equal(t, 0, progress.Failed)
+	})
+
+	t.Run("DownloadFailure", func(t *testing.T) {
+		opts := usecase.ReindexOptions{OnlyMissing: true, Properties: true}
+
+		mockImgRepo.EXPECT().CountReindex(gomock.Any(), domain.ID(0), true).Return(1, nil)
+		mockImgRepo.EXPECT().ListAfter(gomock.Any(), domain.ID(0), gomock.Any(), true).Return(images[:1], nil)
+		mockImgRepo.EXPECT().ListAfter(gomock.Any(), domain.ID(1), gomock.Any(), true).Return(nil, nil)
+		mockFileReader.EXPECT().Get(gomock.Any(), images[0].Path).Return(nil, repository.ErrNotFound)
+
+		var failures []usecase.ReindexFailure
+		progress, err := reindexUC.Reindex(context.Background(), opts, func(p *usecase.ReindexProgress) error {
+			failures = append(failures, p.Failures...)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, progress.Failed)
+		assert.Len(t, failures, 1)
+		assert.ErrorIs(t, failures[0].Err, repository.ErrNotFound)
+	})
+
+	t.Run("StopOnCallbackError", func(t *testing.T) {
+		opts := usecase.ReindexOptions{BatchSize: 1, Properties: true, DryRun: true}
+		stopErr := errors.New("checkpoint error")
+
+		mockImgRepo.EXPECT().CountReindex(gomock.Any(), domain.ID(0), false).Return(len(images), nil)
+		mockImgRepo.EXPECT().ListAfter(gomock.Any(), domain.ID(0), 1, false).Return(images[:1], nil)
+		expectInspect(images[0])
+
+		progress, err := reindexUC.Reindex(context.Background(), opts, func(p *usecase.ReindexProgress) error {
+			return stopErr
+		})
+
+		assert.ErrorIs(t, err, stopErr)
+		assert.Equal(t, domain.ID(1), progress.LastID)
+	})
+
+	t.Run("CountError", func(t *testing.T) {
+		mockImgRepo.EXPECT().CountReindex(gomock.Any(), domain.ID(0), false).Return(0, errors.New("repo error"))
+
+		progress, err := reindexUC.Reindex(context.Background(), usecase.ReindexOptions{}, nil)
+		assert.Error(t, err)
+		assert.Nil(t, progress)
+	})
+}
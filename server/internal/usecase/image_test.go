@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/pillowskiy/gopix/internal/domain"
 	"github.com/pillowskiy/gopix/internal/repository"
 	"github.com/pillowskiy/gopix/internal/usecase"
 	usecaseMock "github.com/pillowskiy/gopix/internal/usecase/mock"
+	"github.com/pillowskiy/gopix/pkg/image"
 	loggerMock "github.com/pillowskiy/gopix/pkg/logger/mock"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -24,26 +26,35 @@ func TestImageUseCase_Create(t *testing.T) {
 	mockRepo := usecaseMock.NewMockImageRepository(ctrl)
 	mockCache := usecaseMock.NewMockImageCache(ctrl)
 	mockStorage := usecaseMock.NewMockImageFileStorage(ctrl)
-	mockVecRepo := usecaseMock.NewMockImageVecRepository(ctrl)
+	mockFeatUC := usecaseMock.NewMockImageFeaturesUseCase(ctrl)
 	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
+	mockNotifMng := usecaseMock.NewMockNotificationManager(ctrl)
+	mockTagSuggester := usecaseMock.NewMockImageTagSuggester(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, mockVecRepo, mockACL, mockLog)
+	imageUC := usecase.NewImageUseCase(
+		mockStorage, mockCache, mockRepo, mockFeatUC, mockACL, mockNotifMng, nil, mockTagSuggester, mockLog,
+	)
 
 	authorID := domain.ID(1)
 	fakePath := "fake.png"
+	mockUser := &domain.User{ID: authorID}
 
 	mockImage := &domain.Image{
 		AuthorID: authorID,
 		Path:     fakePath,
 	}
 
-	mockFile := &domain.FileNode{
-		Name:   "test.png",
+	mockFile := &domain.File{
 		Size:   1024,
 		Reader: bytes.NewReader([]byte{1, 2, 3}),
 	}
 
+	mockFileNode := &domain.FileNode{
+		Name: "test.png",
+		File: *mockFile,
+	}
+
 	expectedTxCall := func(ctx context.Context) {
 		mockRepo.EXPECT().
 			DoInTransaction(ctx, gomock.Any()).
@@ -55,50 +66,80 @@ func TestImageUseCase_Create(t *testing.T) {
 	t.Run("SuccessCreate", func(t *testing.T) {
 		ctx := context.Background()
 		expectedTxCall(ctx)
+		mockFeatUC.EXPECT().CreateFileNode(ctx, mockFile).Return(mockFileNode, nil)
 		mockRepo.EXPECT().Create(ctx, gomock.Any()).Return(mockImage, nil)
-		mockVecRepo.EXPECT().Features(ctx, mockImage.ID, mockFile).Return(nil)
-		mockStorage.EXPECT().Put(ctx, mockFile).Return(nil)
+		mockFeatUC.EXPECT().ExtractFeatures(ctx, mockImage.ID, mockFileNode).Return(nil)
+		mockStorage.EXPECT().Put(ctx, mockFileNode).Return(nil)
+		mockTagSuggester.EXPECT().AutoApply(ctx, mockImage.ID).Return(nil, nil)
 
-		createdImage, err := imageUC.Create(context.Background(), mockImage, mockFile)
+		createdImage, err := imageUC.Create(ctx, mockImage, mockFile, mockUser)
 		if assert.NoError(t, err) {
-			assert.NotEqual(t, fakePath, createdImage.Path)
 			assert.Equal(t, authorID, createdImage.AuthorID)
-			assert.Equal(t, mockFile.Name, createdImage.Path)
+			assert.Equal(t, mockFileNode.Name, createdImage.Path)
 		}
 	})
 
+	t.Run("AutoApplyError", func(t *testing.T) {
+		ctx := context.Background()
+		expectedTxCall(ctx)
+		mockFeatUC.EXPECT().CreateFileNode(ctx, mockFile).Return(mockFileNode, nil)
+		mockRepo.EXPECT().Create(ctx, gomock.Any()).Return(mockImage, nil)
+		mockFeatUC.EXPECT().ExtractFeatures(ctx, mockImage.ID, mockFileNode).Return(nil)
+		mockStorage.EXPECT().Put(ctx, mockFileNode).Return(nil)
+		mockTagSuggester.EXPECT().AutoApply(ctx, mockImage.ID).Return(nil, errors.New("suggestions error"))
+		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
+
+		createdImage, err := imageUC.Create(ctx, mockImage, mockFile, mockUser)
+		assert.NoError(t, err)
+		assert.NotNil(t, createdImage)
+	})
+
+	t.Run("FileNodeError", func(t *testing.T) {
+		expectedTxCall(context.Background())
+		mockFeatUC.EXPECT().CreateFileNode(gomock.Any(), mockFile).Return(nil, errors.New("file error"))
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+		mockLog.EXPECT().Error(gomock.Any())
+
+		createdImage, err := imageUC.Create(context.Background(), mockImage, mockFile, mockUser)
+		assert.Error(t, err)
+		assert.Nil(t, createdImage)
+	})
+
 	t.Run("RepoError", func(t *testing.T) {
 		expectedTxCall(context.Background())
+		mockFeatUC.EXPECT().CreateFileNode(gomock.Any(), mockFile).Return(mockFileNode, nil)
 		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, errors.New("repo error"))
-		mockVecRepo.EXPECT().Features(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-		mockStorage.EXPECT().Put(gomock.Any(), mockFile).Times(0)
+		mockFeatUC.EXPECT().ExtractFeatures(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockStorage.EXPECT().Put(gomock.Any(), gomock.Any()).Times(0)
 		mockLog.EXPECT().Error(gomock.Any())
 
-		createdImage, err := imageUC.Create(context.Background(), mockImage, mockFile)
+		createdImage, err := imageUC.Create(context.Background(), mockImage, mockFile, mockUser)
 		assert.Error(t, err)
 		assert.Nil(t, createdImage)
 	})
 
-	t.Run("VecRepoError", func(t *testing.T) {
+	t.Run("FeaturesError", func(t *testing.T) {
 		expectedTxCall(context.Background())
+		mockFeatUC.EXPECT().CreateFileNode(gomock.Any(), mockFile).Return(mockFileNode, nil)
 		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(mockImage, nil)
-		mockVecRepo.EXPECT().Features(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("vecrepo error"))
-		mockStorage.EXPECT().Put(gomock.Any(), mockFile).Times(0)
+		mockFeatUC.EXPECT().ExtractFeatures(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("features error"))
+		mockStorage.EXPECT().Put(gomock.Any(), gomock.Any()).Times(0)
 		mockLog.EXPECT().Error(gomock.Any())
 
-		createdImage, err := imageUC.Create(context.Background(), mockImage, mockFile)
+		createdImage, err := imageUC.Create(context.Background(), mockImage, mockFile, mockUser)
 		assert.Error(t, err)
 		assert.Nil(t, createdImage)
 	})
 
 	t.Run("StorageError", func(t *testing.T) {
 		expectedTxCall(context.Background())
+		mockFeatUC.EXPECT().CreateFileNode(gomock.Any(), mockFile).Return(mockFileNode, nil)
 		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(mockImage, nil)
-		mockVecRepo.EXPECT().Features(gomock.Any(), mockImage.ID, mockFile).Return(nil)
-		mockStorage.EXPECT().Put(gomock.Any(), mockFile).Return(errors.New("storage error"))
+		mockFeatUC.EXPECT().ExtractFeatures(gomock.Any(), mockImage.ID, mockFileNode).Return(nil)
+		mockStorage.EXPECT().Put(gomock.Any(), mockFileNode).Return(errors.New("storage error"))
 		mockLog.EXPECT().Error(gomock.Any())
 
-		createdImage, err := imageUC.Create(context.Background(), mockImage, mockFile)
+		createdImage, err := imageUC.Create(context.Background(), mockImage, mockFile, mockUser)
 		assert.Error(t, err)
 		assert.Nil(t, createdImage)
 	})
@@ -113,11 +154,11 @@ func TestImageUseCase_Delete(t *testing.T) {
 	mockRepo := usecaseMock.NewMockImageRepository(ctrl)
 	mockCache := usecaseMock.NewMockImageCache(ctrl)
 	mockStorage := usecaseMock.NewMockImageFileStorage(ctrl)
-	mockVecRepo := usecaseMock.NewMockImageVecRepository(ctrl)
+	mockFeatUC := usecaseMock.NewMockImageFeaturesUseCase(ctrl)
 	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, mockVecRepo, mockACL, mockLog)
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, mockFeatUC, mockACL, nil, nil, nil, mockLog)
 
 	authorID := domain.ID(1)
 
@@ -153,7 +194,7 @@ func TestImageUseCase_Delete(t *testing.T) {
 		expectedTxCall(ctx)
 		mockRepo.EXPECT().Delete(ctx, mockImage.ID).Return(nil)
 		mockStorage.EXPECT().Delete(ctx, mockImage.Path).Return(nil)
-		mockVecRepo.EXPECT().DeleteFeatures(ctx, mockImage.ID).Return(nil)
+		mockFeatUC.EXPECT().DeleteFeatures(ctx, mockImage.ID).Return(nil)
 
 		mockCache.EXPECT().Del(gomock.Any(), mockImage.ID.String()).Return(nil)
 
@@ -171,7 +212,7 @@ func TestImageUseCase_Delete(t *testing.T) {
 		expectedTxCall(ctx)
 		mockRepo.EXPECT().Delete(ctx, mockImage.ID).Return(nil)
 		mockStorage.EXPECT().Delete(ctx, mockImage.Path).Return(nil)
-		mockVecRepo.EXPECT().DeleteFeatures(ctx, mockImage.ID).Return(nil)
+		mockFeatUC.EXPECT().DeleteFeatures(ctx, mockImage.ID).Return(nil)
 
 		mockCache.EXPECT().Del(gomock.Any(), mockImage.ID.String()).Return(nil)
 
@@ -187,7 +228,7 @@ func TestImageUseCase_Delete(t *testing.T) {
 		mockACL.EXPECT().CanModify(mockUser, mockImage).Times(0)
 		mockRepo.EXPECT().Delete(gomock.Any(), mockImage.ID.String()).Times(0)
 		mockStorage.EXPECT().Delete(gomock.Any(), mockImage.Path).Times(0)
-		mockVecRepo.EXPECT().DeleteFeatures(gomock.Any(), mockImage.ID).Times(0)
+		mockFeatUC.EXPECT().DeleteFeatures(gomock.Any(), mockImage.ID).Times(0)
 		mockCache.EXPECT().Del(gomock.Any(), mockImage.ID.String()).Times(0)
 
 		err := imageUC.Delete(context.Background(), mockImage.ID, mockUser)
@@ -200,7 +241,7 @@ func TestImageUseCase_Delete(t *testing.T) {
 		mockACL.EXPECT().CanModify(mockUser, mockImage).Return(false)
 		mockRepo.EXPECT().Delete(gomock.Any(), mockImage.ID).Times(0)
 		mockStorage.EXPECT().Delete(gomock.Any(), mockImage.Path).Times(0)
-		mockVecRepo.EXPECT().DeleteFeatures(gomock.Any(), mockImage.ID).Times(0)
+		mockFeatUC.EXPECT().DeleteFeatures(gomock.Any(), mockImage.ID).Times(0)
 		mockCache.EXPECT().Del(gomock.Any(), mockImage.ID.String()).Times(0)
 
 		err := imageUC.Delete(context.Background(), mockImage.ID, mockUser)
@@ -217,7 +258,7 @@ func TestImageUseCase_Delete(t *testing.T) {
 		expectedTxCall(context.Background())
 		mockRepo.EXPECT().Delete(gomock.Any(), mockImage.ID).Return(repoError)
 		mockStorage.EXPECT().Delete(gomock.Any(), mockImage.Path).Times(0)
-		mockVecRepo.EXPECT().DeleteFeatures(gomock.Any(), mockImage.ID).Times(0)
+		mockFeatUC.EXPECT().DeleteFeatures(gomock.Any(), mockImage.ID).Times(0)
 		mockLog.EXPECT().Error(gomock.Any())
 
 		mockCache.EXPECT().Del(gomock.Any(), mockImage.ID.String()).Times(0)
@@ -234,7 +275,7 @@ func TestImageUseCase_Delete(t *testing.T) {
 		expectedTxCall(context.Background())
 		mockRepo.EXPECT().Delete(gomock.Any(), mockImage.ID).Return(nil)
 		mockStorage.EXPECT().Delete(gomock.Any(), mockImage.Path).Return(nil)
-		mockVecRepo.EXPECT().DeleteFeatures(gomock.Any(), mockImage.ID).Return(nil)
+		mockFeatUC.EXPECT().DeleteFeatures(gomock.Any(), mockImage.ID).Return(nil)
 
 		mockCache.EXPECT().Del(gomock.Any(), mockImage.ID.String()).Return(errors.New("cache error"))
 		mockLog.EXPECT().Errorf(gomock.Any(), gomock.Any())
@@ -252,7 +293,7 @@ func TestImageUseCase_Delete(t *testing.T) {
 		expectedTxCall(context.Background())
 		mockRepo.EXPECT().Delete(gomock.Any(), mockImage.ID).Return(nil)
 		mockStorage.EXPECT().Delete(gomock.Any(), mockImage.Path).Return(storageError)
-		mockVecRepo.EXPECT().DeleteFeatures(gomock.Any(), mockImage.ID).Times(0)
+		mockFeatUC.EXPECT().DeleteFeatures(gomock.Any(), mockImage.ID).Times(0)
 		mockLog.EXPECT().Error(gomock.Any())
 
 		mockCache.EXPECT().Del(gomock.Any(), mockImage.ID.String()).Times(0)
@@ -270,7 +311,7 @@ func TestImageUseCase_Delete(t *testing.T) {
 		expectedTxCall(context.Background())
 		mockRepo.EXPECT().Delete(gomock.Any(), mockImage.ID).Return(nil)
 		mockStorage.EXPECT().Delete(gomock.Any(), mockImage.Path).Return(nil)
-		mockVecRepo.EXPECT().DeleteFeatures(gomock.Any(), mockImage.ID).Return(vecRepoError)
+		mockFeatUC.EXPECT().DeleteFeatures(gomock.Any(), mockImage.ID).Return(vecRepoError)
 		mockLog.EXPECT().Errorf(gomock.Any(), vecRepoError)
 
 		mockCache.EXPECT().Del(gomock.Any(), mockImage.ID.String()).Return(nil)
@@ -290,12 +331,13 @@ func TestImageUseCase_GetDetailed(t *testing.T) {
 	mockCache := usecaseMock.NewMockImageCache(ctrl)
 	mockStorage := usecaseMock.NewMockImageFileStorage(ctrl)
 	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, mockLog)
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, nil, mockReactionUC, nil, mockLog)
 
 	mockDetailedImage := &domain.DetailedImage{
-		ImageWithAuthor: domain.ImageWithAuthor{
+		ImageWithMeta: domain.ImageWithMeta{
 			Image: domain.Image{
 				ID: 1,
 			},
@@ -303,17 +345,59 @@ func TestImageUseCase_GetDetailed(t *testing.T) {
 	}
 
 	t.Run("SuccessGet", func(t *testing.T) {
+		reactions := []domain.ReactionCount{{Emoji: "🔥", Count: 2}}
+
 		mockRepo.EXPECT().GetDetailed(gomock.Any(), gomock.Any()).Return(mockDetailedImage, nil)
+		mockRepo.EXPECT().CanViewAuthor(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+		mockReactionUC.EXPECT().
+			Counts(gomock.Any(), domain.ReactionTargetImage, []domain.ID{1}, nil).
+			Return(map[domain.ID][]domain.ReactionCount{1: reactions}, nil)
 
-		detailedImage, err := imageUC.GetDetailed(context.Background(), 1)
+		detailedImage, err := imageUC.GetDetailed(context.Background(), 1, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, mockDetailedImage, detailedImage)
+		assert.Equal(t, reactions, detailedImage.Reactions)
+	})
+
+	t.Run("WithoutReactions", func(t *testing.T) {
+		mockRepo.EXPECT().GetDetailed(gomock.Any(), gomock.Any()).Return(mockDetailedImage, nil)
+		mockRepo.EXPECT().CanViewAuthor(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+		mockReactionUC.EXPECT().
+			Counts(gomock.Any(), domain.ReactionTargetImage, []domain.ID{1}, nil).
+			Return(map[domain.ID][]domain.ReactionCount{}, nil)
+
+		detailedImage, err := imageUC.GetDetailed(context.Background(), 1, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, detailedImage.Reactions)
+		assert.Empty(t, detailedImage.Reactions)
+	})
+
+	t.Run("ReactionsError", func(t *testing.T) {
+		mockRepo.EXPECT().GetDetailed(gomock.Any(), gomock.Any()).Return(mockDetailedImage, nil)
+		mockRepo.EXPECT().CanViewAuthor(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+		mockReactionUC.EXPECT().
+			Counts(gomock.Any(), domain.ReactionTargetImage, []domain.ID{1}, nil).
+			Return(nil, errors.New("reactions error"))
+
+		detailedImage, err := imageUC.GetDetailed(context.Background(), 1, nil)
+		assert.Error(t, err)
+		assert.Nil(t, detailedImage)
+	})
+
+	t.Run("PrivateAuthor", func(t *testing.T) {
+		mockRepo.EXPECT().GetDetailed(gomock.Any(), gomock.Any()).Return(mockDetailedImage, nil)
+		mockRepo.EXPECT().CanViewAuthor(gomock.Any(), mockDetailedImage.AuthorID, gomock.Any()).Return(false, nil)
+		mockReactionUC.EXPECT().Counts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		detailedImage, err := imageUC.GetDetailed(context.Background(), 1, nil)
+		assert.Equal(t, usecase.ErrForbidden, err)
+		assert.Nil(t, detailedImage)
 	})
 
 	t.Run("NotFound", func(t *testing.T) {
 		mockRepo.EXPECT().GetDetailed(gomock.Any(), gomock.Any()).Return(nil, repository.ErrNotFound)
 
-		detailedImage, err := imageUC.GetDetailed(context.Background(), 1)
+		detailedImage, err := imageUC.GetDetailed(context.Background(), 1, nil)
 		assert.Error(t, err)
 		assert.Equal(t, usecase.ErrNotFound, err)
 		assert.Nil(t, detailedImage)
@@ -322,7 +406,7 @@ func TestImageUseCase_GetDetailed(t *testing.T) {
 	t.Run("RepoError", func(t *testing.T) {
 		mockRepo.EXPECT().GetDetailed(gomock.Any(), gomock.Any()).Return(nil, errors.New("repo error"))
 
-		detailedImage, err := imageUC.GetDetailed(context.Background(), 1)
+		detailedImage, err := imageUC.GetDetailed(context.Background(), 1, nil)
 		assert.Error(t, err)
 		assert.Nil(t, detailedImage)
 	})
@@ -337,17 +421,17 @@ func TestImageUseCase_Similar(t *testing.T) {
 	mockRepo := usecaseMock.NewMockImageRepository(ctrl)
 	mockCache := usecaseMock.NewMockImageCache(ctrl)
 	mockStorage := usecaseMock.NewMockImageFileStorage(ctrl)
-	mockVecRepo := usecaseMock.NewMockImageVecRepository(ctrl)
+	mockFeatUC := usecaseMock.NewMockImageFeaturesUseCase(ctrl)
 	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, mockVecRepo, mockACL, mockLog)
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, mockFeatUC, mockACL, nil, nil, nil, mockLog)
 
 	mockImageID := domain.ID(100)
 	mockImage := &domain.Image{ID: mockImageID}
 
 	mockSimilarIDs := []domain.ID{1, 2, 3}
-	mockSimilarImages := []domain.ImageWithAuthor{
+	mockSimilarImages := []domain.ImageWithMeta{
 		{
 			Image: domain.Image{
 				ID: 1,
@@ -369,7 +453,7 @@ func TestImageUseCase_Similar(t *testing.T) {
 
 	t.Run("SuccessSimilar", func(t *testing.T) {
 		expectGetByIDCall_Repo()
-		mockVecRepo.EXPECT().Similar(gomock.Any(), gomock.Any()).Return(mockSimilarIDs, nil)
+		mockFeatUC.EXPECT().Similar(gomock.Any(), gomock.Any()).Return(mockSimilarIDs, nil)
 		mockRepo.EXPECT().FindMany(gomock.Any(), mockSimilarIDs).Return(mockSimilarImages, nil)
 
 		similarImages, err := imageUC.Similar(context.Background(), mockImageID)
@@ -379,7 +463,7 @@ func TestImageUseCase_Similar(t *testing.T) {
 
 	t.Run("SuccessSimilar_Cached", func(t *testing.T) {
 		expectGetByIDCall_Cached()
-		mockVecRepo.EXPECT().Similar(gomock.Any(), gomock.Any()).Return(mockSimilarIDs, nil)
+		mockFeatUC.EXPECT().Similar(gomock.Any(), gomock.Any()).Return(mockSimilarIDs, nil)
 		mockRepo.EXPECT().FindMany(gomock.Any(), mockSimilarIDs).Return(mockSimilarImages, nil)
 
 		similarImages, err := imageUC.Similar(context.Background(), mockImageID)
@@ -390,7 +474,7 @@ func TestImageUseCase_Similar(t *testing.T) {
 	t.Run("NotFound", func(t *testing.T) {
 		mockCache.EXPECT().Get(gomock.Any(), mockImage.ID.String()).Return(nil, nil)
 		mockRepo.EXPECT().GetByID(gomock.Any(), mockImage.ID).Return(nil, repository.ErrNotFound)
-		mockVecRepo.EXPECT().Similar(gomock.Any(), gomock.Any()).Times(0)
+		mockFeatUC.EXPECT().Similar(gomock.Any(), gomock.Any()).Times(0)
 		mockRepo.EXPECT().FindMany(gomock.Any(), mockSimilarIDs).Times(0)
 
 		similarImages, err := imageUC.Similar(context.Background(), mockImageID)
@@ -401,7 +485,7 @@ func TestImageUseCase_Similar(t *testing.T) {
 
 	t.Run("VecRepoError", func(t *testing.T) {
 		expectGetByIDCall_Repo()
-		mockVecRepo.EXPECT().Similar(gomock.Any(), gomock.Any()).Return(nil, errors.New("vecrepo error"))
+		mockFeatUC.EXPECT().Similar(gomock.Any(), gomock.Any()).Return(nil, errors.New("vecrepo error"))
 		mockRepo.EXPECT().FindMany(gomock.Any(), mockSimilarIDs).Times(0)
 
 		similarImages, err := imageUC.Similar(context.Background(), mockImageID)
@@ -411,7 +495,7 @@ func TestImageUseCase_Similar(t *testing.T) {
 
 	t.Run("RepoError", func(t *testing.T) {
 		expectGetByIDCall_Repo()
-		mockVecRepo.EXPECT().Similar(gomock.Any(), gomock.Any()).Return(mockSimilarIDs, nil)
+		mockFeatUC.EXPECT().Similar(gomock.Any(), gomock.Any()).Return(mockSimilarIDs, nil)
 		mockRepo.EXPECT().FindMany(gomock.Any(), mockSimilarIDs).Return(nil, errors.New("repo error"))
 
 		similarImages, err := imageUC.Similar(context.Background(), mockImageID)
@@ -420,6 +504,190 @@ func TestImageUseCase_Similar(t *testing.T) {
 	})
 }
 
+func TestImageUseCase_SearchSimilar(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockImageRepository(ctrl)
+	mockCache := usecaseMock.NewMockImageCache(ctrl)
+	mockStorage := usecaseMock.NewMockImageFileStorage(ctrl)
+	mockFeatUC := usecaseMock.NewMockImageFeaturesUseCase(ctrl)
+	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, mockFeatUC, mockACL, nil, nil, nil, mockLog)
+
+	mockFile := &domain.File{Size: 3, Reader: bytes.NewReader([]byte{1, 2, 3})}
+	mockFileNode := &domain.FileNode{File: *mockFile, Name: "query.png", ContentType: "image/png"}
+
+	mockFoundIDs := []domain.ID{3, 1, 2}
+	mockFoundImages := []domain.ImageWithMeta{
+		{Image: domain.Image{ID: 1, AccessLevel: domain.ImageAccessPublic}},
+		{Image: domain.Image{ID: 2, AccessLevel: domain.ImageAccessPrivate}},
+		{Image: domain.Image{ID: 3, AccessLevel: domain.ImageAccessPublic}},
+	}
+
+	t.Run("SuccessSearchSimilar", func(t *testing.T) {
+		mockFeatUC.EXPECT().CreateFileNode(gomock.Any(), mockFile).Return(mockFileNode, nil)
+		mockFeatUC.EXPECT().SearchByImage(gomock.Any(), mockFileNode).Return(mockFoundIDs, nil)
+		mockRepo.EXPECT().FindMany(gomock.Any(), mockFoundIDs).Return(mockFoundImages, nil)
+
+		images, err := imageUC.SearchSimilar(context.Background(), mockFile)
+		if assert.NoError(t, err) {
+			assert.Len(t, images, 2, "Should skip non-public images")
+			assert.Equal(t, domain.ID(3), images[0].ID, "Should keep vector service ordering")
+			assert.Equal(t, domain.ID(1), images[1].ID)
+		}
+	})
+
+	t.Run("NothingFound", func(t *testing.T) {
+		mockFeatUC.EXPECT().CreateFileNode(gomock.Any(), mockFile).Return(mockFileNode, nil)
+		mockFeatUC.EXPECT().SearchByImage(gomock.Any(), mockFileNode).Return([]domain.ID{}, nil)
+		mockRepo.EXPECT().FindMany(gomock.Any(), gomock.Any()).Times(0)
+
+		images, err := imageUC.SearchSimilar(context.Background(), mockFile)
+		assert.NoError(t, err)
+		assert.Empty(t, images)
+	})
+
+	t.Run("NotAllowedContentType", func(t *testing.T) {
+		svgNode := &domain.FileNode{File: *mockFile, Name: "query.svg", ContentType: "image/svg+xml"}
+		mockFeatUC.EXPECT().CreateFileNode(gomock.Any(), mockFile).Return(svgNode, nil)
+		mockFeatUC.EXPECT().SearchByImage(gomock.Any(), gomock.Any()).Times(0)
+
+		images, err := imageUC.SearchSimilar(context.Background(), mockFile)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+		assert.Nil(t, images)
+	})
+
+	t.Run("UnsupportedMime", func(t *testing.T) {
+		mockFeatUC.EXPECT().CreateFileNode(gomock.Any(), mockFile).Return(
+			nil, fmt.Errorf("failed to get extension by mime: %w", image.ErrUnsupportedMime),
+		)
+		mockFeatUC.EXPECT().SearchByImage(gomock.Any(), gomock.Any()).Times(0)
+
+		images, err := imageUC.SearchSimilar(context.Background(), mockFile)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+		assert.Nil(t, images)
+	})
+
+	t.Run("FileNodeError", func(t *testing.T) {
+		mockFeatUC.EXPECT().CreateFileNode(gomock.Any(), mockFile).Return(nil, errors.New("read error"))
+		mockFeatUC.EXPECT().SearchByImage(gomock.Any(), gomock.Any()).Times(0)
+
+		images, err := imageUC.SearchSimilar(context.Background(), mockFile)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, usecase.ErrUnprocessable)
+		assert.Nil(t, images)
+	})
+
+	t.Run("VecRepoError", func(t *testing.T) {
+		mockFeatUC.EXPECT().CreateFileNode(gomock.Any(), mockFile).Return(mockFileNode, nil)
+		mockFeatUC.EXPECT().SearchByImage(gomock.Any(), mockFileNode).Return(nil, errors.New("vecrepo error"))
+		mockRepo.EXPECT().FindMany(gomock.Any(), gomock.Any()).Times(0)
+
+		images, err := imageUC.SearchSimilar(context.Background(), mockFile)
+		assert.Error(t, err)
+		assert.Nil(t, images)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockFeatUC.EXPECT().CreateFileNode(gomock.Any(), mockFile).Return(mockFileNode, nil)
+		mockFeatUC.EXPECT().SearchByImage(gomock.Any(), mockFileNode).Return(mockFoundIDs, nil)
+		mockRepo.EXPECT().FindMany(gomock.Any(), mockFoundIDs).Return(nil, errors.New("repo error"))
+
+		images, err := imageUC.SearchSimilar(context.Background(), mockFile)
+		assert.Error(t, err)
+		assert.Nil(t, images)
+	})
+}
+
+func TestImageUseCase_Search(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockImageRepository(ctrl)
+	mockCache := usecaseMock.NewMockImageCache(ctrl)
+	mockStorage := usecaseMock.NewMockImageFileStorage(ctrl)
+	mockFeatUC := usecaseMock.NewMockImageFeaturesUseCase(ctrl)
+	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, mockFeatUC, mockACL, nil, nil, nil, mockLog)
+
+	query := "red car"
+	mockUser := &domain.User{ID: 1}
+	pagInput := &domain.PaginationInput{Page: 1, PerPage: 10}
+	mockFoundIDs := []domain.ID{3, 1, 2}
+	mockPag := &domain.Pagination[domain.ImageWithMeta]{
+		PaginationInput: *pagInput,
+		Items:           []domain.ImageWithMeta{{Image: domain.Image{ID: 3}}},
+		Total:           1,
+	}
+
+	t.Run("SuccessSemanticSearch", func(t *testing.T) {
+		mockFeatUC.EXPECT().SearchByText(gomock.Any(), query, gomock.Any()).Return(mockFoundIDs, nil)
+		mockRepo.EXPECT().FindManyVisible(gomock.Any(), mockFoundIDs, &mockUser.ID, pagInput).Return(mockPag, nil)
+		mockRepo.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		pag, err := imageUC.Search(context.Background(), query, domain.ImageSearchSemantic, pagInput, mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPag, pag)
+	})
+
+	t.Run("SuccessSemanticSearch_Anonymous", func(t *testing.T) {
+		mockFeatUC.EXPECT().SearchByText(gomock.Any(), query, gomock.Any()).Return(mockFoundIDs, nil)
+		mockRepo.EXPECT().FindManyVisible(gomock.Any(), mockFoundIDs, nil, pagInput).Return(mockPag, nil)
+
+		pag, err := imageUC.Search(context.Background(), query, domain.ImageSearchSemantic, pagInput, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPag, pag)
+	})
+
+	t.Run("SemanticSearch_NothingFound", func(t *testing.T) {
+		mockFeatUC.EXPECT().SearchByText(gomock.Any(), query, gomock.Any()).Return([]domain.ID{}, nil)
+		mockRepo.EXPECT().FindManyVisible(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		pag, err := imageUC.Search(context.Background(), query, domain.ImageSearchSemantic, pagInput, nil)
+		if assert.NoError(t, err) {
+			assert.Empty(t, pag.Items)
+			assert.Equal(t, 0, pag.Total)
+		}
+	})
+
+	t.Run("SemanticSearch_FallbackToText", func(t *testing.T) {
+		mockFeatUC.EXPECT().SearchByText(gomock.Any(), query, gomock.Any()).Return(nil, errors.New("service unavailable"))
+		mockLog.EXPECT().Warnf(gomock.Any(), gomock.Any())
+		mockRepo.EXPECT().FindManyVisible(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockRepo.EXPECT().Search(gomock.Any(), query, &mockUser.ID, pagInput).Return(mockPag, nil)
+
+		pag, err := imageUC.Search(context.Background(), query, domain.ImageSearchSemantic, pagInput, mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPag, pag)
+	})
+
+	t.Run("SuccessTextSearch", func(t *testing.T) {
+		mockFeatUC.EXPECT().SearchByText(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockRepo.EXPECT().Search(gomock.Any(), query, &mockUser.ID, pagInput).Return(mockPag, nil)
+
+		pag, err := imageUC.Search(context.Background(), query, domain.ImageSearchText, pagInput, mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPag, pag)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo.EXPECT().Search(gomock.Any(), query, nil, pagInput).Return(nil, errors.New("repo error"))
+
+		pag, err := imageUC.Search(context.Background(), query, domain.ImageSearchText, pagInput, nil)
+		assert.Error(t, err)
+		assert.Nil(t, pag)
+	})
+}
+
 func TestImageUseCase_AddView(t *testing.T) {
 	t.Parallel()
 
@@ -432,7 +700,7 @@ func TestImageUseCase_AddView(t *testing.T) {
 	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, mockLog)
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, nil, nil, nil, mockLog)
 
 	imageID := domain.ID(1)
 	userID := domain.ID(2)
@@ -464,7 +732,7 @@ func TestImageUseCase_AddLike(t *testing.T) {
 	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, mockLog)
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, nil, nil, nil, mockLog)
 
 	imageID := domain.ID(1)
 	userID := domain.ID(2)
@@ -496,7 +764,7 @@ func TestImageUseCase_RemoveLike(t *testing.T) {
 	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, mockLog)
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, nil, nil, nil, mockLog)
 
 	imageID := domain.ID(1)
 	userID := domain.ID(2)
@@ -537,9 +805,10 @@ func TestImageUseCase_States(t *testing.T) {
 	mockCache := usecaseMock.NewMockImageCache(ctrl)
 	mockStorage := usecaseMock.NewMockImageFileStorage(ctrl)
 	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
+	mockReactionUC := usecaseMock.NewMockReactionCounter(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, mockLog)
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, nil, mockReactionUC, nil, mockLog)
 
 	imageID := domain.ID(1)
 	userID := domain.ID(2)
@@ -551,10 +820,14 @@ func TestImageUseCase_States(t *testing.T) {
 
 	t.Run("SuccessStates", func(t *testing.T) {
 		mockRepo.EXPECT().States(gomock.Any(), imageID, userID).Return(mockStates, nil)
+		mockReactionUC.EXPECT().
+			UserReactions(gomock.Any(), domain.ReactionTargetImage, imageID, userID).
+			Return([]string{"🔥"}, nil)
 
 		states, err := imageUC.States(context.Background(), imageID, userID)
 		assert.NoError(t, err)
 		assert.NotNil(t, states)
+		assert.Equal(t, []string{"🔥"}, states.Reactions)
 	})
 
 	t.Run("RepoError", func(t *testing.T) {
@@ -578,7 +851,7 @@ func TestImageUseCase_Discover(t *testing.T) {
 	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, mockLog)
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, nil, nil, nil, mockLog)
 
 	sort := domain.ImagePopularSort
 
@@ -592,9 +865,9 @@ func TestImageUseCase_Discover(t *testing.T) {
 		PerPage: 10,
 	}
 
-	pag := &domain.Pagination[domain.ImageWithAuthor]{
+	pag := &domain.Pagination[domain.ImageWithMeta]{
 		PaginationInput: *pagInput,
-		Items: []domain.ImageWithAuthor{
+		Items: []domain.ImageWithMeta{
 			{
 				Image: *mockImage,
 			},
@@ -603,17 +876,26 @@ func TestImageUseCase_Discover(t *testing.T) {
 	}
 
 	t.Run("SuccessDiscover", func(t *testing.T) {
-		mockRepo.EXPECT().Discover(gomock.Any(), pagInput, sort).Return(pag, nil)
+		mockRepo.EXPECT().Discover(gomock.Any(), pagInput, sort, nil).Return(pag, nil)
 
-		pag, err := imageUC.Discover(context.Background(), pagInput, sort)
+		pag, err := imageUC.Discover(context.Background(), pagInput, sort, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, pag)
+	})
+
+	t.Run("SuccessDiscover_Viewer", func(t *testing.T) {
+		executor := &domain.User{ID: 2}
+		mockRepo.EXPECT().Discover(gomock.Any(), pagInput, sort, &executor.ID).Return(pag, nil)
+
+		pag, err := imageUC.Discover(context.Background(), pagInput, sort, executor)
 		assert.NoError(t, err)
 		assert.NotNil(t, pag)
 	})
 
 	t.Run("IncorrectInput", func(t *testing.T) {
-		mockRepo.EXPECT().Discover(gomock.Any(), pagInput, sort).Return(nil, repository.ErrIncorrectInput)
+		mockRepo.EXPECT().Discover(gomock.Any(), pagInput, sort, nil).Return(nil, repository.ErrIncorrectInput)
 
-		pag, err := imageUC.Discover(context.Background(), pagInput, sort)
+		pag, err := imageUC.Discover(context.Background(), pagInput, sort, nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, usecase.ErrUnprocessable, err)
@@ -621,9 +903,9 @@ func TestImageUseCase_Discover(t *testing.T) {
 	})
 
 	t.Run("RepoError", func(t *testing.T) {
-		mockRepo.EXPECT().Discover(gomock.Any(), pagInput, sort).Return(nil, errors.New("repo error"))
+		mockRepo.EXPECT().Discover(gomock.Any(), pagInput, sort, nil).Return(nil, errors.New("repo error"))
 
-		pag, err := imageUC.Discover(context.Background(), pagInput, sort)
+		pag, err := imageUC.Discover(context.Background(), pagInput, sort, nil)
 		assert.Error(t, err)
 		assert.Nil(t, pag)
 	})
@@ -641,7 +923,7 @@ func TestImageUseCase_HasLike(t *testing.T) {
 	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, mockLog)
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, nil, nil, nil, mockLog)
 
 	imageID := domain.ID(1)
 	userID := domain.ID(2)
@@ -682,7 +964,7 @@ func TestImageUseCase_GetByID(t *testing.T) {
 	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, mockLog)
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, nil, nil, nil, mockLog)
 
 	imageID := domain.ID(1)
 	mockImage := &domain.Image{
@@ -766,7 +1048,7 @@ func TestImageUseCase_Update(t *testing.T) {
 	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, mockLog)
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, nil, nil, nil, mockLog)
 
 	authorID := domain.ID(1)
 	imageID := domain.ID(2)
@@ -878,3 +1160,35 @@ func TestImageUseCase_Update(t *testing.T) {
 		assert.Nil(t, updated)
 	})
 }
+
+func TestImageUseCase_SetCommentsLocked(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockImageRepository(ctrl)
+	mockCache := usecaseMock.NewMockImageCache(ctrl)
+	mockStorage := usecaseMock.NewMockImageFileStorage(ctrl)
+	mockACL := usecaseMock.NewMockImageAccessPolicy(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+
+	imageUC := usecase.NewImageUseCase(mockStorage, mockCache, mockRepo, nil, mockACL, nil, nil, nil, mockLog)
+
+	imageID := domain.ID(1)
+
+	t.Run("SuccessLock", func(t *testing.T) {
+		mockRepo.EXPECT().SetCommentsLocked(gomock.Any(), imageID, true).Return(nil)
+		mockCache.EXPECT().Del(gomock.Any(), imageID.String()).Return(nil)
+
+		assert.NoError(t, imageUC.SetCommentsLocked(context.Background(), imageID, true))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().SetCommentsLocked(gomock.Any(), imageID, true).Return(repository.ErrNotFound)
+		mockCache.EXPECT().Del(gomock.Any(), gomock.Any()).Times(0)
+
+		err := imageUC.SetCommentsLocked(context.Background(), imageID, true)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
@@ -40,6 +40,34 @@ func (m *MockAlbumRepository) EXPECT() *MockAlbumRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AcceptMember mocks base method.
+func (m *MockAlbumRepository) AcceptMember(ctx context.Context, albumID, userID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptMember", ctx, albumID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptMember indicates an expected call of AcceptMember.
+func (mr *MockAlbumRepositoryMockRecorder) AcceptMember(ctx, albumID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptMember", reflect.TypeOf((*MockAlbumRepository)(nil).AcceptMember), ctx, albumID, userID)
+}
+
+// AddMember mocks base method.
+func (m *MockAlbumRepository) AddMember(ctx context.Context, member *domain.AlbumMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockAlbumRepositoryMockRecorder) AddMember(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockAlbumRepository)(nil).AddMember), ctx, member)
+}
+
 // Create mocks base method.
 func (m *MockAlbumRepository) Create(ctx context.Context, album *domain.Album) (*domain.Album, error) {
 	m.ctrl.T.Helper()
@@ -83,34 +111,48 @@ func (mr *MockAlbumRepositoryMockRecorder) DeleteImage(ctx, albumID, imageID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockAlbumRepository)(nil).DeleteImage), ctx, albumID, imageID)
 }
 
+// Follow mocks base method.
+func (m *MockAlbumRepository) Follow(ctx context.Context, albumID, userID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, albumID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockAlbumRepositoryMockRecorder) Follow(ctx, albumID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockAlbumRepository)(nil).Follow), ctx, albumID, userID)
+}
+
 // GetAlbumImages mocks base method.
-func (m *MockAlbumRepository) GetAlbumImages(ctx context.Context, albumID domain.ID, pagInput *domain.PaginationInput) (*domain.Pagination[domain.ImageWithAuthor], error) {
+func (m *MockAlbumRepository) GetAlbumImages(ctx context.Context, albumID domain.ID, viewerID *domain.ID, pagInput *domain.PaginationInput, sort domain.AlbumImageSortMethod) (*domain.Pagination[domain.ImageWithMeta], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAlbumImages", ctx, albumID, pagInput)
-	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithAuthor])
+	ret := m.ctrl.Call(m, "GetAlbumImages", ctx, albumID, viewerID, pagInput, sort)
+	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithMeta])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAlbumImages indicates an expected call of GetAlbumImages.
-func (mr *MockAlbumRepositoryMockRecorder) GetAlbumImages(ctx, albumID, pagInput any) *gomock.Call {
+func (mr *MockAlbumRepositoryMockRecorder) GetAlbumImages(ctx, albumID, viewerID, pagInput, sort any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumImages", reflect.TypeOf((*MockAlbumRepository)(nil).GetAlbumImages), ctx, albumID, pagInput)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumImages", reflect.TypeOf((*MockAlbumRepository)(nil).GetAlbumImages), ctx, albumID, viewerID, pagInput, sort)
 }
 
 // GetByAuthorID mocks base method.
-func (m *MockAlbumRepository) GetByAuthorID(ctx context.Context, authorID domain.ID) ([]domain.DetailedAlbum, error) {
+func (m *MockAlbumRepository) GetByAuthorID(ctx context.Context, authorID domain.ID, viewerID *domain.ID) ([]domain.DetailedAlbum, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByAuthorID", ctx, authorID)
+	ret := m.ctrl.Call(m, "GetByAuthorID", ctx, authorID, viewerID)
 	ret0, _ := ret[0].([]domain.DetailedAlbum)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByAuthorID indicates an expected call of GetByAuthorID.
-func (mr *MockAlbumRepositoryMockRecorder) GetByAuthorID(ctx, authorID any) *gomock.Call {
+func (mr *MockAlbumRepositoryMockRecorder) GetByAuthorID(ctx, authorID, viewerID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthorID", reflect.TypeOf((*MockAlbumRepository)(nil).GetByAuthorID), ctx, authorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthorID", reflect.TypeOf((*MockAlbumRepository)(nil).GetByAuthorID), ctx, authorID, viewerID)
 }
 
 // GetByID mocks base method.
@@ -128,18 +170,236 @@ func (mr *MockAlbumRepositoryMockRecorder) GetByID(ctx, albumID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAlbumRepository)(nil).GetByID), ctx, albumID)
 }
 
+// GetFollowed mocks base method.
+func (m *MockAlbumRepository) GetFollowed(ctx context.Context, userID domain.ID) ([]domain.DetailedAlbum, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowed", ctx, userID)
+	ret0, _ := ret[0].([]domain.DetailedAlbum)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowed indicates an expected call of GetFollowed.
+func (mr *MockAlbumRepositoryMockRecorder) GetFollowed(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowed", reflect.TypeOf((*MockAlbumRepository)(nil).GetFollowed), ctx, userID)
+}
+
+// GetFollowers mocks base method.
+func (m *MockAlbumRepository) GetFollowers(ctx context.Context, albumID domain.ID) ([]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowers", ctx, albumID)
+	ret0, _ := ret[0].([]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowers indicates an expected call of GetFollowers.
+func (mr *MockAlbumRepositoryMockRecorder) GetFollowers(ctx, albumID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowers", reflect.TypeOf((*MockAlbumRepository)(nil).GetFollowers), ctx, albumID)
+}
+
+// GetMember mocks base method.
+func (m *MockAlbumRepository) GetMember(ctx context.Context, albumID, userID domain.ID) (*domain.AlbumMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, albumID, userID)
+	ret0, _ := ret[0].(*domain.AlbumMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockAlbumRepositoryMockRecorder) GetMember(ctx, albumID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockAlbumRepository)(nil).GetMember), ctx, albumID, userID)
+}
+
+// GetMembers mocks base method.
+func (m *MockAlbumRepository) GetMembers(ctx context.Context, albumID domain.ID) ([]domain.AlbumMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembers", ctx, albumID)
+	ret0, _ := ret[0].([]domain.AlbumMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembers indicates an expected call of GetMembers.
+func (mr *MockAlbumRepositoryMockRecorder) GetMembers(ctx, albumID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockAlbumRepository)(nil).GetMembers), ctx, albumID)
+}
+
+// GetMemberships mocks base method.
+func (m *MockAlbumRepository) GetMemberships(ctx context.Context, userID, authorID domain.ID) ([]domain.AlbumMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberships", ctx, userID, authorID)
+	ret0, _ := ret[0].([]domain.AlbumMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberships indicates an expected call of GetMemberships.
+func (mr *MockAlbumRepositoryMockRecorder) GetMemberships(ctx, userID, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberships", reflect.TypeOf((*MockAlbumRepository)(nil).GetMemberships), ctx, userID, authorID)
+}
+
+// GetRuleImages mocks base method.
+func (m *MockAlbumRepository) GetRuleImages(ctx context.Context, rule *domain.AlbumRule, similarIDs []domain.ID, viewerID *domain.ID, pagInput *domain.PaginationInput, sort domain.AlbumImageSortMethod) (*domain.Pagination[domain.ImageWithMeta], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuleImages", ctx, rule, similarIDs, viewerID, pagInput, sort)
+	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithMeta])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuleImages indicates an expected call of GetRuleImages.
+func (mr *MockAlbumRepositoryMockRecorder) GetRuleImages(ctx, rule, similarIDs, viewerID, pagInput, sort any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleImages", reflect.TypeOf((*MockAlbumRepository)(nil).GetRuleImages), ctx, rule, similarIDs, viewerID, pagInput, sort)
+}
+
+// ImageAddedBy mocks base method.
+func (m *MockAlbumRepository) ImageAddedBy(ctx context.Context, albumID, imageID domain.ID) (*domain.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageAddedBy", ctx, albumID, imageID)
+	ret0, _ := ret[0].(*domain.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageAddedBy indicates an expected call of ImageAddedBy.
+func (mr *MockAlbumRepositoryMockRecorder) ImageAddedBy(ctx, albumID, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageAddedBy", reflect.TypeOf((*MockAlbumRepository)(nil).ImageAddedBy), ctx, albumID, imageID)
+}
+
+// IsFollowing mocks base method.
+func (m *MockAlbumRepository) IsFollowing(ctx context.Context, albumID, userID domain.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFollowing", ctx, albumID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFollowing indicates an expected call of IsFollowing.
+func (mr *MockAlbumRepositoryMockRecorder) IsFollowing(ctx, albumID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFollowing", reflect.TypeOf((*MockAlbumRepository)(nil).IsFollowing), ctx, albumID, userID)
+}
+
+// MoveImage mocks base method.
+func (m *MockAlbumRepository) MoveImage(ctx context.Context, albumID, imageID domain.ID, position int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveImage", ctx, albumID, imageID, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveImage indicates an expected call of MoveImage.
+func (mr *MockAlbumRepositoryMockRecorder) MoveImage(ctx, albumID, imageID, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveImage", reflect.TypeOf((*MockAlbumRepository)(nil).MoveImage), ctx, albumID, imageID, position)
+}
+
 // PutImage mocks base method.
-func (m *MockAlbumRepository) PutImage(ctx context.Context, albumID, imageID domain.ID) error {
+func (m *MockAlbumRepository) PutImage(ctx context.Context, albumID, imageID, addedBy domain.ID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutImage", ctx, albumID, imageID)
+	ret := m.ctrl.Call(m, "PutImage", ctx, albumID, imageID, addedBy)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PutImage indicates an expected call of PutImage.
-func (mr *MockAlbumRepositoryMockRecorder) PutImage(ctx, albumID, imageID any) *gomock.Call {
+func (mr *MockAlbumRepositoryMockRecorder) PutImage(ctx, albumID, imageID, addedBy any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImage", reflect.TypeOf((*MockAlbumRepository)(nil).PutImage), ctx, albumID, imageID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImage", reflect.TypeOf((*MockAlbumRepository)(nil).PutImage), ctx, albumID, imageID, addedBy)
+}
+
+// RemoveMember mocks base method.
+func (m *MockAlbumRepository) RemoveMember(ctx context.Context, albumID, userID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, albumID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockAlbumRepositoryMockRecorder) RemoveMember(ctx, albumID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockAlbumRepository)(nil).RemoveMember), ctx, albumID, userID)
+}
+
+// SetCover mocks base method.
+func (m *MockAlbumRepository) SetCover(ctx context.Context, albumID domain.ID, imageID *domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCover", ctx, albumID, imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCover indicates an expected call of SetCover.
+func (mr *MockAlbumRepositoryMockRecorder) SetCover(ctx, albumID, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCover", reflect.TypeOf((*MockAlbumRepository)(nil).SetCover), ctx, albumID, imageID)
+}
+
+// SetImagesOrder mocks base method.
+func (m *MockAlbumRepository) SetImagesOrder(ctx context.Context, albumID domain.ID, imageIDs []domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetImagesOrder", ctx, albumID, imageIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetImagesOrder indicates an expected call of SetImagesOrder.
+func (mr *MockAlbumRepositoryMockRecorder) SetImagesOrder(ctx, albumID, imageIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetImagesOrder", reflect.TypeOf((*MockAlbumRepository)(nil).SetImagesOrder), ctx, albumID, imageIDs)
+}
+
+// SetRule mocks base method.
+func (m *MockAlbumRepository) SetRule(ctx context.Context, albumID domain.ID, rule *domain.AlbumRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRule", ctx, albumID, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRule indicates an expected call of SetRule.
+func (mr *MockAlbumRepositoryMockRecorder) SetRule(ctx, albumID, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRule", reflect.TypeOf((*MockAlbumRepository)(nil).SetRule), ctx, albumID, rule)
+}
+
+// SetShareToken mocks base method.
+func (m *MockAlbumRepository) SetShareToken(ctx context.Context, albumID domain.ID, token *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetShareToken", ctx, albumID, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetShareToken indicates an expected call of SetShareToken.
+func (mr *MockAlbumRepositoryMockRecorder) SetShareToken(ctx, albumID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShareToken", reflect.TypeOf((*MockAlbumRepository)(nil).SetShareToken), ctx, albumID, token)
+}
+
+// Unfollow mocks base method.
+func (m *MockAlbumRepository) Unfollow(ctx context.Context, albumID, userID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, albumID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow.
+func (mr *MockAlbumRepositoryMockRecorder) Unfollow(ctx, albumID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockAlbumRepository)(nil).Unfollow), ctx, albumID, userID)
 }
 
 // Update mocks base method.
@@ -157,6 +417,20 @@ func (mr *MockAlbumRepositoryMockRecorder) Update(ctx, albumID, album any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAlbumRepository)(nil).Update), ctx, albumID, album)
 }
 
+// UpdateMemberRole mocks base method.
+func (m *MockAlbumRepository) UpdateMemberRole(ctx context.Context, albumID, userID domain.ID, role domain.AlbumRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberRole", ctx, albumID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMemberRole indicates an expected call of UpdateMemberRole.
+func (mr *MockAlbumRepositoryMockRecorder) UpdateMemberRole(ctx, albumID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberRole", reflect.TypeOf((*MockAlbumRepository)(nil).UpdateMemberRole), ctx, albumID, userID, role)
+}
+
 // MockAlbumAccessPolicy is a mock of AlbumAccessPolicy interface.
 type MockAlbumAccessPolicy struct {
 	ctrl     *gomock.Controller
@@ -180,6 +454,62 @@ func (m *MockAlbumAccessPolicy) EXPECT() *MockAlbumAccessPolicyMockRecorder {
 	return m.recorder
 }
 
+// CanArrange mocks base method.
+func (m *MockAlbumAccessPolicy) CanArrange(user *domain.User, album *domain.Album, member *domain.AlbumMember) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanArrange", user, album, member)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanArrange indicates an expected call of CanArrange.
+func (mr *MockAlbumAccessPolicyMockRecorder) CanArrange(user, album, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanArrange", reflect.TypeOf((*MockAlbumAccessPolicy)(nil).CanArrange), user, album, member)
+}
+
+// CanAttachImage mocks base method.
+func (m *MockAlbumAccessPolicy) CanAttachImage(user *domain.User, image *domain.Image) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanAttachImage", user, image)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanAttachImage indicates an expected call of CanAttachImage.
+func (mr *MockAlbumAccessPolicyMockRecorder) CanAttachImage(user, image any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanAttachImage", reflect.TypeOf((*MockAlbumAccessPolicy)(nil).CanAttachImage), user, image)
+}
+
+// CanDeleteImage mocks base method.
+func (m *MockAlbumAccessPolicy) CanDeleteImage(user *domain.User, album *domain.Album, member *domain.AlbumMember, addedBy *domain.ID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanDeleteImage", user, album, member, addedBy)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanDeleteImage indicates an expected call of CanDeleteImage.
+func (mr *MockAlbumAccessPolicyMockRecorder) CanDeleteImage(user, album, member, addedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanDeleteImage", reflect.TypeOf((*MockAlbumAccessPolicy)(nil).CanDeleteImage), user, album, member, addedBy)
+}
+
+// CanManageMember mocks base method.
+func (m *MockAlbumAccessPolicy) CanManageMember(user *domain.User, album *domain.Album, member *domain.AlbumMember, role domain.AlbumRole) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanManageMember", user, album, member, role)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanManageMember indicates an expected call of CanManageMember.
+func (mr *MockAlbumAccessPolicyMockRecorder) CanManageMember(user, album, member, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanManageMember", reflect.TypeOf((*MockAlbumAccessPolicy)(nil).CanManageMember), user, album, member, role)
+}
+
 // CanModify mocks base method.
 func (m *MockAlbumAccessPolicy) CanModify(user *domain.User, album *domain.Album) bool {
 	m.ctrl.T.Helper()
@@ -194,6 +524,34 @@ func (mr *MockAlbumAccessPolicyMockRecorder) CanModify(user, album any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanModify", reflect.TypeOf((*MockAlbumAccessPolicy)(nil).CanModify), user, album)
 }
 
+// CanPutImage mocks base method.
+func (m *MockAlbumAccessPolicy) CanPutImage(user *domain.User, album *domain.Album, member *domain.AlbumMember) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanPutImage", user, album, member)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanPutImage indicates an expected call of CanPutImage.
+func (mr *MockAlbumAccessPolicyMockRecorder) CanPutImage(user, album, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanPutImage", reflect.TypeOf((*MockAlbumAccessPolicy)(nil).CanPutImage), user, album, member)
+}
+
+// CanView mocks base method.
+func (m *MockAlbumAccessPolicy) CanView(user *domain.User, album *domain.Album, member *domain.AlbumMember, shareToken string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanView", user, album, member, shareToken)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanView indicates an expected call of CanView.
+func (mr *MockAlbumAccessPolicyMockRecorder) CanView(user, album, member, shareToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanView", reflect.TypeOf((*MockAlbumAccessPolicy)(nil).CanView), user, album, member, shareToken)
+}
+
 // MockAlbumImageUseCase is a mock of AlbumImageUseCase interface.
 type MockAlbumImageUseCase struct {
 	ctrl     *gomock.Controller
@@ -217,6 +575,21 @@ func (m *MockAlbumImageUseCase) EXPECT() *MockAlbumImageUseCaseMockRecorder {
 	return m.recorder
 }
 
+// CanViewAuthor mocks base method.
+func (m *MockAlbumImageUseCase) CanViewAuthor(ctx context.Context, authorID domain.ID, executor *domain.User) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewAuthor", ctx, authorID, executor)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewAuthor indicates an expected call of CanViewAuthor.
+func (mr *MockAlbumImageUseCaseMockRecorder) CanViewAuthor(ctx, authorID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewAuthor", reflect.TypeOf((*MockAlbumImageUseCase)(nil).CanViewAuthor), ctx, authorID, executor)
+}
+
 // GetByID mocks base method.
 func (m *MockAlbumImageUseCase) GetByID(ctx context.Context, imageID domain.ID) (*domain.Image, error) {
 	m.ctrl.T.Helper()
@@ -231,3 +604,41 @@ func (mr *MockAlbumImageUseCaseMockRecorder) GetByID(ctx, imageID any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAlbumImageUseCase)(nil).GetByID), ctx, imageID)
 }
+
+// MockAlbumFeaturesUseCase is a mock of AlbumFeaturesUseCase interface.
+type MockAlbumFeaturesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlbumFeaturesUseCaseMockRecorder
+}
+
+// MockAlbumFeaturesUseCaseMockRecorder is the mock recorder for MockAlbumFeaturesUseCase.
+type MockAlbumFeaturesUseCaseMockRecorder struct {
+	mock *MockAlbumFeaturesUseCase
+}
+
+// NewMockAlbumFeaturesUseCase creates a new mock instance.
+func NewMockAlbumFeaturesUseCase(ctrl *gomock.Controller) *MockAlbumFeaturesUseCase {
+	mock := &MockAlbumFeaturesUseCase{ctrl: ctrl}
+	mock.recorder = &MockAlbumFeaturesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlbumFeaturesUseCase) EXPECT() *MockAlbumFeaturesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Similar mocks base method.
+func (m *MockAlbumFeaturesUseCase) Similar(ctx context.Context, imageID domain.ID) ([]domain.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Similar", ctx, imageID)
+	ret0, _ := ret[0].([]domain.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Similar indicates an expected call of Similar.
+func (mr *MockAlbumFeaturesUseCaseMockRecorder) Similar(ctx, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Similar", reflect.TypeOf((*MockAlbumFeaturesUseCase)(nil).Similar), ctx, imageID)
+}
@@ -0,0 +1,215 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/album_export.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/album_export.go -destination=./internal/usecase/mock/mock_album_export.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	domain "github.com/pillowskiy/gopix/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAlbumExportAlbumUseCase is a mock of AlbumExportAlbumUseCase interface.
+type MockAlbumExportAlbumUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlbumExportAlbumUseCaseMockRecorder
+}
+
+// MockAlbumExportAlbumUseCaseMockRecorder is the mock recorder for MockAlbumExportAlbumUseCase.
+type MockAlbumExportAlbumUseCaseMockRecorder struct {
+	mock *MockAlbumExportAlbumUseCase
+}
+
+// NewMockAlbumExportAlbumUseCase creates a new mock instance.
+func NewMockAlbumExportAlbumUseCase(ctrl *gomock.Controller) *MockAlbumExportAlbumUseCase {
+	mock := &MockAlbumExportAlbumUseCase{ctrl: ctrl}
+	mock.recorder = &MockAlbumExportAlbumUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlbumExportAlbumUseCase) EXPECT() *MockAlbumExportAlbumUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetAlbumImages mocks base method.
+func (m *MockAlbumExportAlbumUseCase) GetAlbumImages(ctx context.Context, albumID domain.ID, pagInput *domain.PaginationInput, sort domain.AlbumImageSortMethod, executor *domain.User, shareToken string) (*domain.Pagination[domain.ImageWithMeta], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumImages", ctx, albumID, pagInput, sort, executor, shareToken)
+	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithMeta])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumImages indicates an expected call of GetAlbumImages.
+func (mr *MockAlbumExportAlbumUseCaseMockRecorder) GetAlbumImages(ctx, albumID, pagInput, sort, executor, shareToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumImages", reflect.TypeOf((*MockAlbumExportAlbumUseCase)(nil).GetAlbumImages), ctx, albumID, pagInput, sort, executor, shareToken)
+}
+
+// GetByID mocks base method.
+func (m *MockAlbumExportAlbumUseCase) GetByID(ctx context.Context, albumID domain.ID) (*domain.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, albumID)
+	ret0, _ := ret[0].(*domain.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAlbumExportAlbumUseCaseMockRecorder) GetByID(ctx, albumID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAlbumExportAlbumUseCase)(nil).GetByID), ctx, albumID)
+}
+
+// MockAlbumExportTagRepository is a mock of AlbumExportTagRepository interface.
+type MockAlbumExportTagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlbumExportTagRepositoryMockRecorder
+}
+
+// MockAlbumExportTagRepositoryMockRecorder is the mock recorder for MockAlbumExportTagRepository.
+type MockAlbumExportTagRepositoryMockRecorder struct {
+	mock *MockAlbumExportTagRepository
+}
+
+// NewMockAlbumExportTagRepository creates a new mock instance.
+func NewMockAlbumExportTagRepository(ctrl *gomock.Controller) *MockAlbumExportTagRepository {
+	mock := &MockAlbumExportTagRepository{ctrl: ctrl}
+	mock.recorder = &MockAlbumExportTagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlbumExportTagRepository) EXPECT() *MockAlbumExportTagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ImagesTags mocks base method.
+func (m *MockAlbumExportTagRepository) ImagesTags(ctx context.Context, imageIDs []domain.ID) (map[domain.ID][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImagesTags", ctx, imageIDs)
+	ret0, _ := ret[0].(map[domain.ID][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImagesTags indicates an expected call of ImagesTags.
+func (mr *MockAlbumExportTagRepositoryMockRecorder) ImagesTags(ctx, imageIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagesTags", reflect.TypeOf((*MockAlbumExportTagRepository)(nil).ImagesTags), ctx, imageIDs)
+}
+
+// MockAlbumExportImageStorage is a mock of AlbumExportImageStorage interface.
+type MockAlbumExportImageStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlbumExportImageStorageMockRecorder
+}
+
+// MockAlbumExportImageStorageMockRecorder is the mock recorder for MockAlbumExportImageStorage.
+type MockAlbumExportImageStorageMockRecorder struct {
+	mock *MockAlbumExportImageStorage
+}
+
+// NewMockAlbumExportImageStorage creates a new mock instance.
+func NewMockAlbumExportImageStorage(ctrl *gomock.Controller) *MockAlbumExportImageStorage {
+	mock := &MockAlbumExportImageStorage{ctrl: ctrl}
+	mock.recorder = &MockAlbumExportImageStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlbumExportImageStorage) EXPECT() *MockAlbumExportImageStorageMockRecorder {
+	return m.recorder
+}
+
+// Stream mocks base method.
+func (m *MockAlbumExportImageStorage) Stream(ctx context.Context, path string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, path)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockAlbumExportImageStorageMockRecorder) Stream(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockAlbumExportImageStorage)(nil).Stream), ctx, path)
+}
+
+// MockAlbumArchiveStorage is a mock of AlbumArchiveStorage interface.
+type MockAlbumArchiveStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlbumArchiveStorageMockRecorder
+}
+
+// MockAlbumArchiveStorageMockRecorder is the mock recorder for MockAlbumArchiveStorage.
+type MockAlbumArchiveStorageMockRecorder struct {
+	mock *MockAlbumArchiveStorage
+}
+
+// NewMockAlbumArchiveStorage creates a new mock instance.
+func NewMockAlbumArchiveStorage(ctrl *gomock.Controller) *MockAlbumArchiveStorage {
+	mock := &MockAlbumArchiveStorage{ctrl: ctrl}
+	mock.recorder = &MockAlbumArchiveStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlbumArchiveStorage) EXPECT() *MockAlbumArchiveStorageMockRecorder {
+	return m.recorder
+}
+
+// ArchiveURL mocks base method.
+func (m *MockAlbumArchiveStorage) ArchiveURL(ctx context.Context, key string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveURL", ctx, key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveURL indicates an expected call of ArchiveURL.
+func (mr *MockAlbumArchiveStorageMockRecorder) ArchiveURL(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveURL", reflect.TypeOf((*MockAlbumArchiveStorage)(nil).ArchiveURL), ctx, key)
+}
+
+// DeleteExpiredArchives mocks base method.
+func (m *MockAlbumArchiveStorage) DeleteExpiredArchives(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredArchives", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredArchives indicates an expected call of DeleteExpiredArchives.
+func (mr *MockAlbumArchiveStorageMockRecorder) DeleteExpiredArchives(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredArchives", reflect.TypeOf((*MockAlbumArchiveStorage)(nil).DeleteExpiredArchives), ctx)
+}
+
+// PutArchive mocks base method.
+func (m *MockAlbumArchiveStorage) PutArchive(ctx context.Context, key string, r io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutArchive", ctx, key, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutArchive indicates an expected call of PutArchive.
+func (mr *MockAlbumArchiveStorageMockRecorder) PutArchive(ctx, key, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutArchive", reflect.TypeOf((*MockAlbumArchiveStorage)(nil).PutArchive), ctx, key, r)
+}
@@ -40,6 +40,21 @@ func (m *MockCommentRepository) EXPECT() *MockCommentRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountReplies mocks base method.
+func (m *MockCommentRepository) CountReplies(ctx context.Context, commentID domain.ID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReplies", ctx, commentID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReplies indicates an expected call of CountReplies.
+func (mr *MockCommentRepositoryMockRecorder) CountReplies(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReplies", reflect.TypeOf((*MockCommentRepository)(nil).CountReplies), ctx, commentID)
+}
+
 // Create mocks base method.
 func (m *MockCommentRepository) Create(ctx context.Context, comment *domain.Comment) (*domain.Comment, error) {
 	m.ctrl.T.Helper()
@@ -85,48 +100,78 @@ func (mr *MockCommentRepositoryMockRecorder) GetByID(ctx, imageID any) *gomock.C
 }
 
 // GetByImageID mocks base method.
-func (m *MockCommentRepository) GetByImageID(ctx context.Context, imageID domain.ID, pagInput *domain.PaginationInput, sort domain.CommentSortMethod) (*domain.Pagination[domain.DetailedComment], error) {
+func (m *MockCommentRepository) GetByImageID(ctx context.Context, imageID domain.ID, pagInput *domain.PaginationInput, sort domain.CommentSortMethod, vis *domain.CommentVisibility) (*domain.Pagination[domain.DetailedComment], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByImageID", ctx, imageID, pagInput, sort)
+	ret := m.ctrl.Call(m, "GetByImageID", ctx, imageID, pagInput, sort, vis)
 	ret0, _ := ret[0].(*domain.Pagination[domain.DetailedComment])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByImageID indicates an expected call of GetByImageID.
-func (mr *MockCommentRepositoryMockRecorder) GetByImageID(ctx, imageID, pagInput, sort any) *gomock.Call {
+func (mr *MockCommentRepositoryMockRecorder) GetByImageID(ctx, imageID, pagInput, sort, vis any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByImageID", reflect.TypeOf((*MockCommentRepository)(nil).GetByImageID), ctx, imageID, pagInput, sort, vis)
+}
+
+// GetMentions mocks base method.
+func (m *MockCommentRepository) GetMentions(ctx context.Context, commentIDs []domain.ID) (map[domain.ID][]domain.CommentMention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMentions", ctx, commentIDs)
+	ret0, _ := ret[0].(map[domain.ID][]domain.CommentMention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMentions indicates an expected call of GetMentions.
+func (mr *MockCommentRepositoryMockRecorder) GetMentions(ctx, commentIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByImageID", reflect.TypeOf((*MockCommentRepository)(nil).GetByImageID), ctx, imageID, pagInput, sort)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMentions", reflect.TypeOf((*MockCommentRepository)(nil).GetMentions), ctx, commentIDs)
 }
 
 // GetReplies mocks base method.
-func (m *MockCommentRepository) GetReplies(ctx context.Context, commentID domain.ID, userID *domain.ID) ([]domain.DetailedComment, error) {
+func (m *MockCommentRepository) GetReplies(ctx context.Context, commentID domain.ID, pagInput *domain.PaginationInput, vis *domain.CommentVisibility) (*domain.Pagination[domain.DetailedComment], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReplies", ctx, commentID, userID)
-	ret0, _ := ret[0].([]domain.DetailedComment)
+	ret := m.ctrl.Call(m, "GetReplies", ctx, commentID, pagInput, vis)
+	ret0, _ := ret[0].(*domain.Pagination[domain.DetailedComment])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReplies indicates an expected call of GetReplies.
-func (mr *MockCommentRepositoryMockRecorder) GetReplies(ctx, commentID, userID any) *gomock.Call {
+func (mr *MockCommentRepositoryMockRecorder) GetReplies(ctx, commentID, pagInput, vis any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplies", reflect.TypeOf((*MockCommentRepository)(nil).GetReplies), ctx, commentID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplies", reflect.TypeOf((*MockCommentRepository)(nil).GetReplies), ctx, commentID, pagInput, vis)
 }
 
-// HasUserCommented mocks base method.
-func (m *MockCommentRepository) HasUserCommented(ctx context.Context, commentID, userID domain.ID) (bool, error) {
+// GetRevisions mocks base method.
+func (m *MockCommentRepository) GetRevisions(ctx context.Context, commentID domain.ID) ([]domain.CommentRevision, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasUserCommented", ctx, commentID, userID)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, commentID)
+	ret0, _ := ret[0].([]domain.CommentRevision)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// HasUserCommented indicates an expected call of HasUserCommented.
-func (mr *MockCommentRepositoryMockRecorder) HasUserCommented(ctx, commentID, userID any) *gomock.Call {
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockCommentRepositoryMockRecorder) GetRevisions(ctx, commentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasUserCommented", reflect.TypeOf((*MockCommentRepository)(nil).HasUserCommented), ctx, commentID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockCommentRepository)(nil).GetRevisions), ctx, commentID)
+}
+
+// GetThreads mocks base method.
+func (m *MockCommentRepository) GetThreads(ctx context.Context, rootIDs []domain.ID, maxDepth int, vis *domain.CommentVisibility) ([]domain.DetailedComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThreads", ctx, rootIDs, maxDepth, vis)
+	ret0, _ := ret[0].([]domain.DetailedComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThreads indicates an expected call of GetThreads.
+func (mr *MockCommentRepositoryMockRecorder) GetThreads(ctx, rootIDs, maxDepth, vis any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThreads", reflect.TypeOf((*MockCommentRepository)(nil).GetThreads), ctx, rootIDs, maxDepth, vis)
 }
 
 // HasUserLikedComment mocks base method.
@@ -158,6 +203,63 @@ func (mr *MockCommentRepositoryMockRecorder) LikeComment(ctx, commentID, userID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeComment", reflect.TypeOf((*MockCommentRepository)(nil).LikeComment), ctx, commentID, userID)
 }
 
+// Pin mocks base method.
+func (m *MockCommentRepository) Pin(ctx context.Context, imageID, commentID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pin", ctx, imageID, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pin indicates an expected call of Pin.
+func (mr *MockCommentRepositoryMockRecorder) Pin(ctx, imageID, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pin", reflect.TypeOf((*MockCommentRepository)(nil).Pin), ctx, imageID, commentID)
+}
+
+// ReplaceMentions mocks base method.
+func (m *MockCommentRepository) ReplaceMentions(ctx context.Context, commentID domain.ID, usernames []string) ([]domain.CommentMention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceMentions", ctx, commentID, usernames)
+	ret0, _ := ret[0].([]domain.CommentMention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceMentions indicates an expected call of ReplaceMentions.
+func (mr *MockCommentRepositoryMockRecorder) ReplaceMentions(ctx, commentID, usernames any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceMentions", reflect.TypeOf((*MockCommentRepository)(nil).ReplaceMentions), ctx, commentID, usernames)
+}
+
+// SetHidden mocks base method.
+func (m *MockCommentRepository) SetHidden(ctx context.Context, commentID domain.ID, hidden bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHidden", ctx, commentID, hidden)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHidden indicates an expected call of SetHidden.
+func (mr *MockCommentRepositoryMockRecorder) SetHidden(ctx, commentID, hidden any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHidden", reflect.TypeOf((*MockCommentRepository)(nil).SetHidden), ctx, commentID, hidden)
+}
+
+// SoftDelete mocks base method.
+func (m *MockCommentRepository) SoftDelete(ctx context.Context, commentID, executorID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", ctx, commentID, executorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockCommentRepositoryMockRecorder) SoftDelete(ctx, commentID, executorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockCommentRepository)(nil).SoftDelete), ctx, commentID, executorID)
+}
+
 // UnlikeComment mocks base method.
 func (m *MockCommentRepository) UnlikeComment(ctx context.Context, commentID, userID domain.ID) error {
 	m.ctrl.T.Helper()
@@ -172,19 +274,33 @@ func (mr *MockCommentRepositoryMockRecorder) UnlikeComment(ctx, commentID, userI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlikeComment", reflect.TypeOf((*MockCommentRepository)(nil).UnlikeComment), ctx, commentID, userID)
 }
 
+// Unpin mocks base method.
+func (m *MockCommentRepository) Unpin(ctx context.Context, commentID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unpin", ctx, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unpin indicates an expected call of Unpin.
+func (mr *MockCommentRepositoryMockRecorder) Unpin(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unpin", reflect.TypeOf((*MockCommentRepository)(nil).Unpin), ctx, commentID)
+}
+
 // Update mocks base method.
-func (m *MockCommentRepository) Update(ctx context.Context, commentID domain.ID, comment *domain.Comment) (*domain.Comment, error) {
+func (m *MockCommentRepository) Update(ctx context.Context, commentID domain.ID, comment *domain.Comment, editorID domain.ID) (*domain.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, commentID, comment)
+	ret := m.ctrl.Call(m, "Update", ctx, commentID, comment, editorID)
 	ret0, _ := ret[0].(*domain.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockCommentRepositoryMockRecorder) Update(ctx, commentID, comment any) *gomock.Call {
+func (mr *MockCommentRepositoryMockRecorder) Update(ctx, commentID, comment, editorID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCommentRepository)(nil).Update), ctx, commentID, comment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCommentRepository)(nil).Update), ctx, commentID, comment, editorID)
 }
 
 // MockCommentAccessPolicy is a mock of CommentAccessPolicy interface.
@@ -210,6 +326,34 @@ func (m *MockCommentAccessPolicy) EXPECT() *MockCommentAccessPolicyMockRecorder
 	return m.recorder
 }
 
+// CanModerate mocks base method.
+func (m *MockCommentAccessPolicy) CanModerate(user *domain.User) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanModerate", user)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanModerate indicates an expected call of CanModerate.
+func (mr *MockCommentAccessPolicyMockRecorder) CanModerate(user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanModerate", reflect.TypeOf((*MockCommentAccessPolicy)(nil).CanModerate), user)
+}
+
+// CanModerateImage mocks base method.
+func (m *MockCommentAccessPolicy) CanModerateImage(user *domain.User, image *domain.Image) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanModerateImage", user, image)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanModerateImage indicates an expected call of CanModerateImage.
+func (mr *MockCommentAccessPolicyMockRecorder) CanModerateImage(user, image any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanModerateImage", reflect.TypeOf((*MockCommentAccessPolicy)(nil).CanModerateImage), user, image)
+}
+
 // CanModify mocks base method.
 func (m *MockCommentAccessPolicy) CanModify(user *domain.User, comment *domain.Comment) bool {
 	m.ctrl.T.Helper()
@@ -247,6 +391,21 @@ func (m *MockCommentImageUseCase) EXPECT() *MockCommentImageUseCaseMockRecorder
 	return m.recorder
 }
 
+// CanViewAuthor mocks base method.
+func (m *MockCommentImageUseCase) CanViewAuthor(ctx context.Context, authorID domain.ID, executor *domain.User) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewAuthor", ctx, authorID, executor)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewAuthor indicates an expected call of CanViewAuthor.
+func (mr *MockCommentImageUseCaseMockRecorder) CanViewAuthor(ctx, authorID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewAuthor", reflect.TypeOf((*MockCommentImageUseCase)(nil).CanViewAuthor), ctx, authorID, executor)
+}
+
 // GetByID mocks base method.
 func (m *MockCommentImageUseCase) GetByID(ctx context.Context, imageID domain.ID) (*domain.Image, error) {
 	m.ctrl.T.Helper()
@@ -261,3 +420,55 @@ func (mr *MockCommentImageUseCaseMockRecorder) GetByID(ctx, imageID any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCommentImageUseCase)(nil).GetByID), ctx, imageID)
 }
+
+// SetCommentsLocked mocks base method.
+func (m *MockCommentImageUseCase) SetCommentsLocked(ctx context.Context, imageID domain.ID, locked bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCommentsLocked", ctx, imageID, locked)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCommentsLocked indicates an expected call of SetCommentsLocked.
+func (mr *MockCommentImageUseCaseMockRecorder) SetCommentsLocked(ctx, imageID, locked any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCommentsLocked", reflect.TypeOf((*MockCommentImageUseCase)(nil).SetCommentsLocked), ctx, imageID, locked)
+}
+
+// MockCommentRestrictions is a mock of CommentRestrictions interface.
+type MockCommentRestrictions struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentRestrictionsMockRecorder
+}
+
+// MockCommentRestrictionsMockRecorder is the mock recorder for MockCommentRestrictions.
+type MockCommentRestrictionsMockRecorder struct {
+	mock *MockCommentRestrictions
+}
+
+// NewMockCommentRestrictions creates a new mock instance.
+func NewMockCommentRestrictions(ctrl *gomock.Controller) *MockCommentRestrictions {
+	mock := &MockCommentRestrictions{ctrl: ctrl}
+	mock.recorder = &MockCommentRestrictionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentRestrictions) EXPECT() *MockCommentRestrictionsMockRecorder {
+	return m.recorder
+}
+
+// IsBlocked mocks base method.
+func (m *MockCommentRestrictions) IsBlocked(ctx context.Context, userID, otherID domain.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", ctx, userID, otherID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockCommentRestrictionsMockRecorder) IsBlocked(ctx, userID, otherID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockCommentRestrictions)(nil).IsBlocked), ctx, userID, otherID)
+}
@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/follow_suggestion.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/follow_suggestion.go -destination=./internal/usecase/mock/mock_follow_suggestion.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/pillowskiy/gopix/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFollowSuggestionRepository is a mock of FollowSuggestionRepository interface.
+type MockFollowSuggestionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowSuggestionRepositoryMockRecorder
+}
+
+// MockFollowSuggestionRepositoryMockRecorder is the mock recorder for MockFollowSuggestionRepository.
+type MockFollowSuggestionRepositoryMockRecorder struct {
+	mock *MockFollowSuggestionRepository
+}
+
+// NewMockFollowSuggestionRepository creates a new mock instance.
+func NewMockFollowSuggestionRepository(ctrl *gomock.Controller) *MockFollowSuggestionRepository {
+	mock := &MockFollowSuggestionRepository{ctrl: ctrl}
+	mock.recorder = &MockFollowSuggestionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowSuggestionRepository) EXPECT() *MockFollowSuggestionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// NewestLikedImages mocks base method.
+func (m *MockFollowSuggestionRepository) NewestLikedImages(ctx context.Context, userID domain.ID, limit int) ([]domain.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewestLikedImages", ctx, userID, limit)
+	ret0, _ := ret[0].([]domain.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewestLikedImages indicates an expected call of NewestLikedImages.
+func (mr *MockFollowSuggestionRepositoryMockRecorder) NewestLikedImages(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewestLikedImages", reflect.TypeOf((*MockFollowSuggestionRepository)(nil).NewestLikedImages), ctx, userID, limit)
+}
+
+// LikedAuthorsSuggestions mocks base method.
+func (m *MockFollowSuggestionRepository) LikedAuthorsSuggestions(ctx context.Context, userID domain.ID, limit int) ([]domain.FollowSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikedAuthorsSuggestions", ctx, userID, limit)
+	ret0, _ := ret[0].([]domain.FollowSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LikedAuthorsSuggestions indicates an expected call of LikedAuthorsSuggestions.
+func (mr *MockFollowSuggestionRepositoryMockRecorder) LikedAuthorsSuggestions(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedAuthorsSuggestions", reflect.TypeOf((*MockFollowSuggestionRepository)(nil).LikedAuthorsSuggestions), ctx, userID, limit)
+}
+
+// MutualSuggestions mocks base method.
+func (m *MockFollowSuggestionRepository) MutualSuggestions(ctx context.Context, userID domain.ID, limit int) ([]domain.FollowSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MutualSuggestions", ctx, userID, limit)
+	ret0, _ := ret[0].([]domain.FollowSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MutualSuggestions indicates an expected call of MutualSuggestions.
+func (mr *MockFollowSuggestionRepositoryMockRecorder) MutualSuggestions(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MutualSuggestions", reflect.TypeOf((*MockFollowSuggestionRepository)(nil).MutualSuggestions), ctx, userID, limit)
+}
+
+// SuggestableAuthors mocks base method.
+func (m *MockFollowSuggestionRepository) SuggestableAuthors(ctx context.Context, userID domain.ID, imageIDs []domain.ID) (map[domain.ID]domain.ImageAuthor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestableAuthors", ctx, userID, imageIDs)
+	ret0, _ := ret[0].(map[domain.ID]domain.ImageAuthor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestableAuthors indicates an expected call of SuggestableAuthors.
+func (mr *MockFollowSuggestionRepositoryMockRecorder) SuggestableAuthors(ctx, userID, imageIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestableAuthors", reflect.TypeOf((*MockFollowSuggestionRepository)(nil).SuggestableAuthors), ctx, userID, imageIDs)
+}
+
+// MockFollowSuggestionFeaturesUseCase is a mock of FollowSuggestionFeaturesUseCase interface.
+type MockFollowSuggestionFeaturesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowSuggestionFeaturesUseCaseMockRecorder
+}
+
+// MockFollowSuggestionFeaturesUseCaseMockRecorder is the mock recorder for MockFollowSuggestionFeaturesUseCase.
+type MockFollowSuggestionFeaturesUseCaseMockRecorder struct {
+	mock *MockFollowSuggestionFeaturesUseCase
+}
+
+// NewMockFollowSuggestionFeaturesUseCase creates a new mock instance.
+func NewMockFollowSuggestionFeaturesUseCase(ctrl *gomock.Controller) *MockFollowSuggestionFeaturesUseCase {
+	mock := &MockFollowSuggestionFeaturesUseCase{ctrl: ctrl}
+	mock.recorder = &MockFollowSuggestionFeaturesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowSuggestionFeaturesUseCase) EXPECT() *MockFollowSuggestionFeaturesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Similar mocks base method.
+func (m *MockFollowSuggestionFeaturesUseCase) Similar(ctx context.Context, imageID domain.ID) ([]domain.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Similar", ctx, imageID)
+	ret0, _ := ret[0].([]domain.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Similar indicates an expected call of Similar.
+func (mr *MockFollowSuggestionFeaturesUseCaseMockRecorder) Similar(ctx, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Similar", reflect.TypeOf((*MockFollowSuggestionFeaturesUseCase)(nil).Similar), ctx, imageID)
+}
@@ -40,6 +40,63 @@ func (m *MockFollowingRepository) EXPECT() *MockFollowingRepositoryMockRecorder
 	return m.recorder
 }
 
+// ApproveRequest mocks base method.
+func (m *MockFollowingRepository) ApproveRequest(ctx context.Context, requesterID, targetID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveRequest", ctx, requesterID, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveRequest indicates an expected call of ApproveRequest.
+func (mr *MockFollowingRepositoryMockRecorder) ApproveRequest(ctx, requesterID, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveRequest", reflect.TypeOf((*MockFollowingRepository)(nil).ApproveRequest), ctx, requesterID, targetID)
+}
+
+// CanViewAuthor mocks base method.
+func (m *MockFollowingRepository) CanViewAuthor(ctx context.Context, authorID domain.ID, viewerID *domain.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewAuthor", ctx, authorID, viewerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewAuthor indicates an expected call of CanViewAuthor.
+func (mr *MockFollowingRepositoryMockRecorder) CanViewAuthor(ctx, authorID, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewAuthor", reflect.TypeOf((*MockFollowingRepository)(nil).CanViewAuthor), ctx, authorID, viewerID)
+}
+
+// CancelRequest mocks base method.
+func (m *MockFollowingRepository) CancelRequest(ctx context.Context, userID, executorID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelRequest", ctx, userID, executorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelRequest indicates an expected call of CancelRequest.
+func (mr *MockFollowingRepositoryMockRecorder) CancelRequest(ctx, userID, executorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRequest", reflect.TypeOf((*MockFollowingRepository)(nil).CancelRequest), ctx, userID, executorID)
+}
+
+// DenyRequest mocks base method.
+func (m *MockFollowingRepository) DenyRequest(ctx context.Context, requesterID, targetID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenyRequest", ctx, requesterID, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DenyRequest indicates an expected call of DenyRequest.
+func (mr *MockFollowingRepositoryMockRecorder) DenyRequest(ctx, requesterID, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyRequest", reflect.TypeOf((*MockFollowingRepository)(nil).DenyRequest), ctx, requesterID, targetID)
+}
+
 // Follow mocks base method.
 func (m *MockFollowingRepository) Follow(ctx context.Context, userID, executorID domain.ID) error {
 	m.ctrl.T.Helper()
@@ -54,6 +111,66 @@ func (mr *MockFollowingRepositoryMockRecorder) Follow(ctx, userID, executorID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockFollowingRepository)(nil).Follow), ctx, userID, executorID)
 }
 
+// FollowRequests mocks base method.
+func (m *MockFollowingRepository) FollowRequests(ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput) (*domain.Pagination[domain.FollowRequest], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowRequests", ctx, userID, pagInput)
+	ret0, _ := ret[0].(*domain.Pagination[domain.FollowRequest])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowRequests indicates an expected call of FollowRequests.
+func (mr *MockFollowingRepositoryMockRecorder) FollowRequests(ctx, userID, pagInput any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowRequests", reflect.TypeOf((*MockFollowingRepository)(nil).FollowRequests), ctx, userID, pagInput)
+}
+
+// Followers mocks base method.
+func (m *MockFollowingRepository) Followers(ctx context.Context, userID domain.ID, viewerID *domain.ID, input *domain.FollowListInput) ([]domain.Follow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Followers", ctx, userID, viewerID, input)
+	ret0, _ := ret[0].([]domain.Follow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Followers indicates an expected call of Followers.
+func (mr *MockFollowingRepositoryMockRecorder) Followers(ctx, userID, viewerID, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Followers", reflect.TypeOf((*MockFollowingRepository)(nil).Followers), ctx, userID, viewerID, input)
+}
+
+// Following mocks base method.
+func (m *MockFollowingRepository) Following(ctx context.Context, userID domain.ID, viewerID *domain.ID, input *domain.FollowListInput) ([]domain.Follow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Following", ctx, userID, viewerID, input)
+	ret0, _ := ret[0].([]domain.Follow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Following indicates an expected call of Following.
+func (mr *MockFollowingRepositoryMockRecorder) Following(ctx, userID, viewerID, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Following", reflect.TypeOf((*MockFollowingRepository)(nil).Following), ctx, userID, viewerID, input)
+}
+
+// HasRequested mocks base method.
+func (m *MockFollowingRepository) HasRequested(ctx context.Context, requesterID, targetID domain.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasRequested", ctx, requesterID, targetID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasRequested indicates an expected call of HasRequested.
+func (mr *MockFollowingRepositoryMockRecorder) HasRequested(ctx, requesterID, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasRequested", reflect.TypeOf((*MockFollowingRepository)(nil).HasRequested), ctx, requesterID, targetID)
+}
+
 // IsFollowing mocks base method.
 func (m *MockFollowingRepository) IsFollowing(ctx context.Context, followerID, folowingID domain.ID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -69,6 +186,35 @@ func (mr *MockFollowingRepositoryMockRecorder) IsFollowing(ctx, followerID, folo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFollowing", reflect.TypeOf((*MockFollowingRepository)(nil).IsFollowing), ctx, followerID, folowingID)
 }
 
+// IsPrivate mocks base method.
+func (m *MockFollowingRepository) IsPrivate(ctx context.Context, userID domain.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPrivate", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPrivate indicates an expected call of IsPrivate.
+func (mr *MockFollowingRepositoryMockRecorder) IsPrivate(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPrivate", reflect.TypeOf((*MockFollowingRepository)(nil).IsPrivate), ctx, userID)
+}
+
+// RequestFollow mocks base method.
+func (m *MockFollowingRepository) RequestFollow(ctx context.Context, userID, executorID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestFollow", ctx, userID, executorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestFollow indicates an expected call of RequestFollow.
+func (mr *MockFollowingRepositoryMockRecorder) RequestFollow(ctx, userID, executorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestFollow", reflect.TypeOf((*MockFollowingRepository)(nil).RequestFollow), ctx, userID, executorID)
+}
+
 // Stats mocks base method.
 func (m *MockFollowingRepository) Stats(ctx context.Context, userID domain.ID, executorID *domain.ID) (*domain.FollowingStats, error) {
 	m.ctrl.T.Helper()
@@ -97,3 +243,41 @@ func (mr *MockFollowingRepositoryMockRecorder) Unfollow(ctx, userID, executorID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockFollowingRepository)(nil).Unfollow), ctx, userID, executorID)
 }
+
+// MockFollowingRestrictions is a mock of FollowingRestrictions interface.
+type MockFollowingRestrictions struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowingRestrictionsMockRecorder
+}
+
+// MockFollowingRestrictionsMockRecorder is the mock recorder for MockFollowingRestrictions.
+type MockFollowingRestrictionsMockRecorder struct {
+	mock *MockFollowingRestrictions
+}
+
+// NewMockFollowingRestrictions creates a new mock instance.
+func NewMockFollowingRestrictions(ctrl *gomock.Controller) *MockFollowingRestrictions {
+	mock := &MockFollowingRestrictions{ctrl: ctrl}
+	mock.recorder = &MockFollowingRestrictionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowingRestrictions) EXPECT() *MockFollowingRestrictionsMockRecorder {
+	return m.recorder
+}
+
+// IsBlocked mocks base method.
+func (m *MockFollowingRestrictions) IsBlocked(ctx context.Context, userID, otherID domain.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", ctx, userID, otherID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockFollowingRestrictionsMockRecorder) IsBlocked(ctx, userID, otherID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockFollowingRestrictions)(nil).IsBlocked), ctx, userID, otherID)
+}
@@ -186,6 +186,21 @@ func (mr *MockImageRepositoryMockRecorder) AddView(ctx, imageID, userID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddView", reflect.TypeOf((*MockImageRepository)(nil).AddView), ctx, imageID, userID)
 }
 
+// CanViewAuthor mocks base method.
+func (m *MockImageRepository) CanViewAuthor(ctx context.Context, authorID domain.ID, viewerID *domain.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewAuthor", ctx, authorID, viewerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewAuthor indicates an expected call of CanViewAuthor.
+func (mr *MockImageRepositoryMockRecorder) CanViewAuthor(ctx, authorID, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewAuthor", reflect.TypeOf((*MockImageRepository)(nil).CanViewAuthor), ctx, authorID, viewerID)
+}
+
 // Create mocks base method.
 func (m *MockImageRepository) Create(ctx context.Context, image *domain.Image) (*domain.Image, error) {
 	m.ctrl.T.Helper()
@@ -216,18 +231,18 @@ func (mr *MockImageRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 }
 
 // Discover mocks base method.
-func (m *MockImageRepository) Discover(ctx context.Context, pagInput *domain.PaginationInput, sort domain.ImageSortMethod) (*domain.Pagination[domain.ImageWithAuthor], error) {
+func (m *MockImageRepository) Discover(ctx context.Context, pagInput *domain.PaginationInput, sort domain.ImageSortMethod, viewerID *domain.ID) (*domain.Pagination[domain.ImageWithMeta], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Discover", ctx, pagInput, sort)
-	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithAuthor])
+	ret := m.ctrl.Call(m, "Discover", ctx, pagInput, sort, viewerID)
+	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithMeta])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Discover indicates an expected call of Discover.
-func (mr *MockImageRepositoryMockRecorder) Discover(ctx, pagInput, sort any) *gomock.Call {
+func (mr *MockImageRepositoryMockRecorder) Discover(ctx, pagInput, sort, viewerID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Discover", reflect.TypeOf((*MockImageRepository)(nil).Discover), ctx, pagInput, sort)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Discover", reflect.TypeOf((*MockImageRepository)(nil).Discover), ctx, pagInput, sort, viewerID)
 }
 
 // DoInTransaction mocks base method.
@@ -245,25 +260,25 @@ func (mr *MockImageRepositoryMockRecorder) DoInTransaction(arg0, arg1 any) *gomo
 }
 
 // Favorites mocks base method.
-func (m *MockImageRepository) Favorites(ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput) (*domain.Pagination[domain.ImageWithAuthor], error) {
+func (m *MockImageRepository) Favorites(ctx context.Context, userID domain.ID, viewerID *domain.ID, pagInput *domain.PaginationInput) (*domain.Pagination[domain.ImageWithMeta], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Favorites", ctx, userID, pagInput)
-	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithAuthor])
+	ret := m.ctrl.Call(m, "Favorites", ctx, userID, viewerID, pagInput)
+	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithMeta])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Favorites indicates an expected call of Favorites.
-func (mr *MockImageRepositoryMockRecorder) Favorites(ctx, userID, pagInput any) *gomock.Call {
+func (mr *MockImageRepositoryMockRecorder) Favorites(ctx, userID, viewerID, pagInput any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Favorites", reflect.TypeOf((*MockImageRepository)(nil).Favorites), ctx, userID, pagInput)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Favorites", reflect.TypeOf((*MockImageRepository)(nil).Favorites), ctx, userID, viewerID, pagInput)
 }
 
 // FindMany mocks base method.
-func (m *MockImageRepository) FindMany(ctx context.Context, ids []domain.ID) ([]domain.ImageWithAuthor, error) {
+func (m *MockImageRepository) FindMany(ctx context.Context, ids []domain.ID) ([]domain.ImageWithMeta, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindMany", ctx, ids)
-	ret0, _ := ret[0].([]domain.ImageWithAuthor)
+	ret0, _ := ret[0].([]domain.ImageWithMeta)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -274,6 +289,21 @@ func (mr *MockImageRepositoryMockRecorder) FindMany(ctx, ids any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMany", reflect.TypeOf((*MockImageRepository)(nil).FindMany), ctx, ids)
 }
 
+// FindManyVisible mocks base method.
+func (m *MockImageRepository) FindManyVisible(ctx context.Context, ids []domain.ID, viewerID *domain.ID, pagInput *domain.PaginationInput) (*domain.Pagination[domain.ImageWithMeta], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindManyVisible", ctx, ids, viewerID, pagInput)
+	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithMeta])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindManyVisible indicates an expected call of FindManyVisible.
+func (mr *MockImageRepositoryMockRecorder) FindManyVisible(ctx, ids, viewerID, pagInput any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindManyVisible", reflect.TypeOf((*MockImageRepository)(nil).FindManyVisible), ctx, ids, viewerID, pagInput)
+}
+
 // GetByID mocks base method.
 func (m *MockImageRepository) GetByID(ctx context.Context, id domain.ID) (*domain.Image, error) {
 	m.ctrl.T.Helper()
@@ -333,6 +363,35 @@ func (mr *MockImageRepositoryMockRecorder) RemoveLike(ctx, imageID, userID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLike", reflect.TypeOf((*MockImageRepository)(nil).RemoveLike), ctx, imageID, userID)
 }
 
+// Search mocks base method.
+func (m *MockImageRepository) Search(ctx context.Context, query string, viewerID *domain.ID, pagInput *domain.PaginationInput) (*domain.Pagination[domain.ImageWithMeta], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query, viewerID, pagInput)
+	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithMeta])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockImageRepositoryMockRecorder) Search(ctx, query, viewerID, pagInput any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockImageRepository)(nil).Search), ctx, query, viewerID, pagInput)
+}
+
+// SetCommentsLocked mocks base method.
+func (m *MockImageRepository) SetCommentsLocked(ctx context.Context, id domain.ID, locked bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCommentsLocked", ctx, id, locked)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCommentsLocked indicates an expected call of SetCommentsLocked.
+func (mr *MockImageRepositoryMockRecorder) SetCommentsLocked(ctx, id, locked any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCommentsLocked", reflect.TypeOf((*MockImageRepository)(nil).SetCommentsLocked), ctx, id, locked)
+}
+
 // States mocks base method.
 func (m *MockImageRepository) States(ctx context.Context, imageID, userID domain.ID) (*domain.ImageStates, error) {
 	m.ctrl.T.Helper()
@@ -363,31 +422,46 @@ func (mr *MockImageRepositoryMockRecorder) Update(ctx, id, image any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockImageRepository)(nil).Update), ctx, id, image)
 }
 
-// MockImageVecRepository is a mock of ImageVecRepository interface.
-type MockImageVecRepository struct {
+// MockImageFeaturesUseCase is a mock of ImageFeaturesUseCase interface.
+type MockImageFeaturesUseCase struct {
 	ctrl     *gomock.Controller
-	recorder *MockImageVecRepositoryMockRecorder
+	recorder *MockImageFeaturesUseCaseMockRecorder
 }
 
-// MockImageVecRepositoryMockRecorder is the mock recorder for MockImageVecRepository.
-type MockImageVecRepositoryMockRecorder struct {
-	mock *MockImageVecRepository
+// MockImageFeaturesUseCaseMockRecorder is the mock recorder for MockImageFeaturesUseCase.
+type MockImageFeaturesUseCaseMockRecorder struct {
+	mock *MockImageFeaturesUseCase
 }
 
-// NewMockImageVecRepository creates a new mock instance.
-func NewMockImageVecRepository(ctrl *gomock.Controller) *MockImageVecRepository {
-	mock := &MockImageVecRepository{ctrl: ctrl}
-	mock.recorder = &MockImageVecRepositoryMockRecorder{mock}
+// NewMockImageFeaturesUseCase creates a new mock instance.
+func NewMockImageFeaturesUseCase(ctrl *gomock.Controller) *MockImageFeaturesUseCase {
+	mock := &MockImageFeaturesUseCase{ctrl: ctrl}
+	mock.recorder = &MockImageFeaturesUseCaseMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockImageVecRepository) EXPECT() *MockImageVecRepositoryMockRecorder {
+func (m *MockImageFeaturesUseCase) EXPECT() *MockImageFeaturesUseCaseMockRecorder {
 	return m.recorder
 }
 
+// CreateFileNode mocks base method.
+func (m *MockImageFeaturesUseCase) CreateFileNode(ctx context.Context, file *domain.File) (*domain.FileNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFileNode", ctx, file)
+	ret0, _ := ret[0].(*domain.FileNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFileNode indicates an expected call of CreateFileNode.
+func (mr *MockImageFeaturesUseCaseMockRecorder) CreateFileNode(ctx, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFileNode", reflect.TypeOf((*MockImageFeaturesUseCase)(nil).CreateFileNode), ctx, file)
+}
+
 // DeleteFeatures mocks base method.
-func (m *MockImageVecRepository) DeleteFeatures(ctx context.Context, imageID domain.ID) error {
+func (m *MockImageFeaturesUseCase) DeleteFeatures(ctx context.Context, imageID domain.ID) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteFeatures", ctx, imageID)
 	ret0, _ := ret[0].(error)
@@ -395,27 +469,57 @@ func (m *MockImageVecRepository) DeleteFeatures(ctx context.Context, imageID dom
 }
 
 // DeleteFeatures indicates an expected call of DeleteFeatures.
-func (mr *MockImageVecRepositoryMockRecorder) DeleteFeatures(ctx, imageID any) *gomock.Call {
+func (mr *MockImageFeaturesUseCaseMockRecorder) DeleteFeatures(ctx, imageID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeatures", reflect.TypeOf((*MockImageVecRepository)(nil).DeleteFeatures), ctx, imageID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeatures", reflect.TypeOf((*MockImageFeaturesUseCase)(nil).DeleteFeatures), ctx, imageID)
 }
 
-// Features mocks base method.
-func (m *MockImageVecRepository) Features(ctx context.Context, imageID domain.ID, file *domain.FileNode) error {
+// ExtractFeatures mocks base method.
+func (m *MockImageFeaturesUseCase) ExtractFeatures(ctx context.Context, imageID domain.ID, file *domain.FileNode) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Features", ctx, imageID, file)
+	ret := m.ctrl.Call(m, "ExtractFeatures", ctx, imageID, file)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Features indicates an expected call of Features.
-func (mr *MockImageVecRepositoryMockRecorder) Features(ctx, imageID, file any) *gomock.Call {
+// ExtractFeatures indicates an expected call of ExtractFeatures.
+func (mr *MockImageFeaturesUseCaseMockRecorder) ExtractFeatures(ctx, imageID, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractFeatures", reflect.TypeOf((*MockImageFeaturesUseCase)(nil).ExtractFeatures), ctx, imageID, file)
+}
+
+// SearchByImage mocks base method.
+func (m *MockImageFeaturesUseCase) SearchByImage(ctx context.Context, file *domain.FileNode) ([]domain.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchByImage", ctx, file)
+	ret0, _ := ret[0].([]domain.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchByImage indicates an expected call of SearchByImage.
+func (mr *MockImageFeaturesUseCaseMockRecorder) SearchByImage(ctx, file any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Features", reflect.TypeOf((*MockImageVecRepository)(nil).Features), ctx, imageID, file)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByImage", reflect.TypeOf((*MockImageFeaturesUseCase)(nil).SearchByImage), ctx, file)
+}
+
+// SearchByText mocks base method.
+func (m *MockImageFeaturesUseCase) SearchByText(ctx context.Context, query string, limit int) ([]domain.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchByText", ctx, query, limit)
+	ret0, _ := ret[0].([]domain.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchByText indicates an expected call of SearchByText.
+func (mr *MockImageFeaturesUseCaseMockRecorder) SearchByText(ctx, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByText", reflect.TypeOf((*MockImageFeaturesUseCase)(nil).SearchByText), ctx, query, limit)
 }
 
 // Similar mocks base method.
-func (m *MockImageVecRepository) Similar(ctx context.Context, imageID domain.ID) ([]domain.ID, error) {
+func (m *MockImageFeaturesUseCase) Similar(ctx context.Context, imageID domain.ID) ([]domain.ID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Similar", ctx, imageID)
 	ret0, _ := ret[0].([]domain.ID)
@@ -424,9 +528,9 @@ func (m *MockImageVecRepository) Similar(ctx context.Context, imageID domain.ID)
 }
 
 // Similar indicates an expected call of Similar.
-func (mr *MockImageVecRepositoryMockRecorder) Similar(ctx, imageID any) *gomock.Call {
+func (mr *MockImageFeaturesUseCaseMockRecorder) Similar(ctx, imageID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Similar", reflect.TypeOf((*MockImageVecRepository)(nil).Similar), ctx, imageID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Similar", reflect.TypeOf((*MockImageFeaturesUseCase)(nil).Similar), ctx, imageID)
 }
 
 // MockImageAccessPolicy is a mock of ImageAccessPolicy interface.
@@ -465,3 +569,78 @@ func (mr *MockImageAccessPolicyMockRecorder) CanModify(user, image any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanModify", reflect.TypeOf((*MockImageAccessPolicy)(nil).CanModify), user, image)
 }
+
+// MockNotificationManager is a mock of NotificationManager interface.
+type MockNotificationManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationManagerMockRecorder
+}
+
+// MockNotificationManagerMockRecorder is the mock recorder for MockNotificationManager.
+type MockNotificationManagerMockRecorder struct {
+	mock *MockNotificationManager
+}
+
+// NewMockNotificationManager creates a new mock instance.
+func NewMockNotificationManager(ctrl *gomock.Controller) *MockNotificationManager {
+	mock := &MockNotificationManager{ctrl: ctrl}
+	mock.recorder = &MockNotificationManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationManager) EXPECT() *MockNotificationManagerMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotificationManager) Notify(ctx context.Context, userID domain.ID, notif *domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, userID, notif)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotificationManagerMockRecorder) Notify(ctx, userID, notif any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotificationManager)(nil).Notify), ctx, userID, notif)
+}
+
+// MockImageTagSuggester is a mock of ImageTagSuggester interface.
+type MockImageTagSuggester struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageTagSuggesterMockRecorder
+}
+
+// MockImageTagSuggesterMockRecorder is the mock recorder for MockImageTagSuggester.
+type MockImageTagSuggesterMockRecorder struct {
+	mock *MockImageTagSuggester
+}
+
+// NewMockImageTagSuggester creates a new mock instance.
+func NewMockImageTagSuggester(ctrl *gomock.Controller) *MockImageTagSuggester {
+	mock := &MockImageTagSuggester{ctrl: ctrl}
+	mock.recorder = &MockImageTagSuggesterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageTagSuggester) EXPECT() *MockImageTagSuggesterMockRecorder {
+	return m.recorder
+}
+
+// AutoApply mocks base method.
+func (m *MockImageTagSuggester) AutoApply(ctx context.Context, imageID domain.ID) ([]domain.TagSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoApply", ctx, imageID)
+	ret0, _ := ret[0].([]domain.TagSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AutoApply indicates an expected call of AutoApply.
+func (mr *MockImageTagSuggesterMockRecorder) AutoApply(ctx, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoApply", reflect.TypeOf((*MockImageTagSuggester)(nil).AutoApply), ctx, imageID)
+}
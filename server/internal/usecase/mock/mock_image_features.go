@@ -0,0 +1,278 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/image_features.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/image_features.go -destination=./internal/usecase/mock/mock_image_features.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/pillowskiy/gopix/internal/domain"
+	repository "github.com/pillowskiy/gopix/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockImageVecRepository is a mock of ImageVecRepository interface.
+type MockImageVecRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageVecRepositoryMockRecorder
+}
+
+// MockImageVecRepositoryMockRecorder is the mock recorder for MockImageVecRepository.
+type MockImageVecRepositoryMockRecorder struct {
+	mock *MockImageVecRepository
+}
+
+// NewMockImageVecRepository creates a new mock instance.
+func NewMockImageVecRepository(ctrl *gomock.Controller) *MockImageVecRepository {
+	mock := &MockImageVecRepository{ctrl: ctrl}
+	mock.recorder = &MockImageVecRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageVecRepository) EXPECT() *MockImageVecRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BatchFeatures mocks base method.
+func (m *MockImageVecRepository) BatchFeatures(ctx context.Context, files map[domain.ID]*domain.FileNode) (map[domain.ID]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchFeatures", ctx, files)
+	ret0, _ := ret[0].(map[domain.ID]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchFeatures indicates an expected call of BatchFeatures.
+func (mr *MockImageVecRepositoryMockRecorder) BatchFeatures(ctx, files any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchFeatures", reflect.TypeOf((*MockImageVecRepository)(nil).BatchFeatures), ctx, files)
+}
+
+// DeleteFeatures mocks base method.
+func (m *MockImageVecRepository) DeleteFeatures(ctx context.Context, imageID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeatures", ctx, imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeatures indicates an expected call of DeleteFeatures.
+func (mr *MockImageVecRepositoryMockRecorder) DeleteFeatures(ctx, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeatures", reflect.TypeOf((*MockImageVecRepository)(nil).DeleteFeatures), ctx, imageID)
+}
+
+// Features mocks base method.
+func (m *MockImageVecRepository) Features(ctx context.Context, imageID domain.ID, file *domain.FileNode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Features", ctx, imageID, file)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Features indicates an expected call of Features.
+func (mr *MockImageVecRepositoryMockRecorder) Features(ctx, imageID, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Features", reflect.TypeOf((*MockImageVecRepository)(nil).Features), ctx, imageID, file)
+}
+
+// ReplaceFeatures mocks base method.
+func (m *MockImageVecRepository) ReplaceFeatures(ctx context.Context, files map[domain.ID]*domain.FileNode) (map[domain.ID]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceFeatures", ctx, files)
+	ret0, _ := ret[0].(map[domain.ID]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceFeatures indicates an expected call of ReplaceFeatures.
+func (mr *MockImageVecRepositoryMockRecorder) ReplaceFeatures(ctx, files any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceFeatures", reflect.TypeOf((*MockImageVecRepository)(nil).ReplaceFeatures), ctx, files)
+}
+
+// SearchByImage mocks base method.
+func (m *MockImageVecRepository) SearchByImage(ctx context.Context, file *domain.FileNode) ([]domain.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchByImage", ctx, file)
+	ret0, _ := ret[0].([]domain.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchByImage indicates an expected call of SearchByImage.
+func (mr *MockImageVecRepositoryMockRecorder) SearchByImage(ctx, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByImage", reflect.TypeOf((*MockImageVecRepository)(nil).SearchByImage), ctx, file)
+}
+
+// SearchByText mocks base method.
+func (m *MockImageVecRepository) SearchByText(ctx context.Context, query string, limit int) ([]domain.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchByText", ctx, query, limit)
+	ret0, _ := ret[0].([]domain.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchByText indicates an expected call of SearchByText.
+func (mr *MockImageVecRepositoryMockRecorder) SearchByText(ctx, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByText", reflect.TypeOf((*MockImageVecRepository)(nil).SearchByText), ctx, query, limit)
+}
+
+// Similar mocks base method.
+func (m *MockImageVecRepository) Similar(ctx context.Context, imageID domain.ID) ([]domain.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Similar", ctx, imageID)
+	ret0, _ := ret[0].([]domain.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Similar indicates an expected call of Similar.
+func (mr *MockImageVecRepositoryMockRecorder) Similar(ctx, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Similar", reflect.TypeOf((*MockImageVecRepository)(nil).Similar), ctx, imageID)
+}
+
+// MockImagePropsRepository is a mock of ImagePropsRepository interface.
+type MockImagePropsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImagePropsRepositoryMockRecorder
+}
+
+// MockImagePropsRepositoryMockRecorder is the mock recorder for MockImagePropsRepository.
+type MockImagePropsRepositoryMockRecorder struct {
+	mock *MockImagePropsRepository
+}
+
+// NewMockImagePropsRepository creates a new mock instance.
+func NewMockImagePropsRepository(ctrl *gomock.Controller) *MockImagePropsRepository {
+	mock := &MockImagePropsRepository{ctrl: ctrl}
+	mock.recorder = &MockImagePropsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImagePropsRepository) EXPECT() *MockImagePropsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockImagePropsRepository) Create(ctx context.Context, imageID domain.ID, props *domain.ImageProperties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, imageID, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockImagePropsRepositoryMockRecorder) Create(ctx, imageID, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockImagePropsRepository)(nil).Create), ctx, imageID, props)
+}
+
+// Delete mocks base method.
+func (m *MockImagePropsRepository) Delete(ctx context.Context, imageID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockImagePropsRepositoryMockRecorder) Delete(ctx, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockImagePropsRepository)(nil).Delete), ctx, imageID)
+}
+
+// DoInTransaction mocks base method.
+func (m *MockImagePropsRepository) DoInTransaction(arg0 context.Context, arg1 repository.InTransactionalCall) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DoInTransaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DoInTransaction indicates an expected call of DoInTransaction.
+func (mr *MockImagePropsRepositoryMockRecorder) DoInTransaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoInTransaction", reflect.TypeOf((*MockImagePropsRepository)(nil).DoInTransaction), arg0, arg1)
+}
+
+// Properties mocks base method.
+func (m *MockImagePropsRepository) Properties(ctx context.Context, imageID domain.ID) (*domain.ImageProperties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Properties", ctx, imageID)
+	ret0, _ := ret[0].(*domain.ImageProperties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Properties indicates an expected call of Properties.
+func (mr *MockImagePropsRepositoryMockRecorder) Properties(ctx, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Properties", reflect.TypeOf((*MockImagePropsRepository)(nil).Properties), ctx, imageID)
+}
+
+// MockFeaturesExtractor is a mock of FeaturesExtractor interface.
+type MockFeaturesExtractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeaturesExtractorMockRecorder
+}
+
+// MockFeaturesExtractorMockRecorder is the mock recorder for MockFeaturesExtractor.
+type MockFeaturesExtractorMockRecorder struct {
+	mock *MockFeaturesExtractor
+}
+
+// NewMockFeaturesExtractor creates a new mock instance.
+func NewMockFeaturesExtractor(ctrl *gomock.Controller) *MockFeaturesExtractor {
+	mock := &MockFeaturesExtractor{ctrl: ctrl}
+	mock.recorder = &MockFeaturesExtractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeaturesExtractor) EXPECT() *MockFeaturesExtractorMockRecorder {
+	return m.recorder
+}
+
+// Features mocks base method.
+func (m *MockFeaturesExtractor) Features(ctx context.Context, fileNode *domain.FileNode) (*domain.ImageProperties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Features", ctx, fileNode)
+	ret0, _ := ret[0].(*domain.ImageProperties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Features indicates an expected call of Features.
+func (mr *MockFeaturesExtractorMockRecorder) Features(ctx, fileNode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Features", reflect.TypeOf((*MockFeaturesExtractor)(nil).Features), ctx, fileNode)
+}
+
+// MakeFileNode mocks base method.
+func (m *MockFeaturesExtractor) MakeFileNode(ctx context.Context, file *domain.File) (*domain.FileNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeFileNode", ctx, file)
+	ret0, _ := ret[0].(*domain.FileNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MakeFileNode indicates an expected call of MakeFileNode.
+func (mr *MockFeaturesExtractorMockRecorder) MakeFileNode(ctx, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeFileNode", reflect.TypeOf((*MockFeaturesExtractor)(nil).MakeFileNode), ctx, file)
+}
@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/image_reindex.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/image_reindex.go -destination=./internal/usecase/mock/mock_image_reindex.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/pillowskiy/gopix/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReindexImageRepository is a mock of ReindexImageRepository interface.
+type MockReindexImageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReindexImageRepositoryMockRecorder
+}
+
+// MockReindexImageRepositoryMockRecorder is the mock recorder for MockReindexImageRepository.
+type MockReindexImageRepositoryMockRecorder struct {
+	mock *MockReindexImageRepository
+}
+
+// NewMockReindexImageRepository creates a new mock instance.
+func NewMockReindexImageRepository(ctrl *gomock.Controller) *MockReindexImageRepository {
+	mock := &MockReindexImageRepository{ctrl: ctrl}
+	mock.recorder = &MockReindexImageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReindexImageRepository) EXPECT() *MockReindexImageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountReindex mocks base method.
+func (m *MockReindexImageRepository) CountReindex(ctx context.Context, afterID domain.ID, onlyMissing bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReindex", ctx, afterID, onlyMissing)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReindex indicates an expected call of CountReindex.
+func (mr *MockReindexImageRepositoryMockRecorder) CountReindex(ctx, afterID, onlyMissing any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReindex", reflect.TypeOf((*MockReindexImageRepository)(nil).CountReindex), ctx, afterID, onlyMissing)
+}
+
+// ListAfter mocks base method.
+func (m *MockReindexImageRepository) ListAfter(ctx context.Context, afterID domain.ID, limit int, onlyMissing bool) ([]domain.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAfter", ctx, afterID, limit, onlyMissing)
+	ret0, _ := ret[0].([]domain.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAfter indicates an expected call of ListAfter.
+func (mr *MockReindexImageRepositoryMockRecorder) ListAfter(ctx, afterID, limit, onlyMissing any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAfter", reflect.TypeOf((*MockReindexImageRepository)(nil).ListAfter), ctx, afterID, limit, onlyMissing)
+}
+
+// MockImageFileReader is a mock of ImageFileReader interface.
+type MockImageFileReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageFileReaderMockRecorder
+}
+
+// MockImageFileReaderMockRecorder is the mock recorder for MockImageFileReader.
+type MockImageFileReaderMockRecorder struct {
+	mock *MockImageFileReader
+}
+
+// NewMockImageFileReader creates a new mock instance.
+func NewMockImageFileReader(ctrl *gomock.Controller) *MockImageFileReader {
+	mock := &MockImageFileReader{ctrl: ctrl}
+	mock.recorder = &MockImageFileReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageFileReader) EXPECT() *MockImageFileReaderMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockImageFileReader) Get(ctx context.Context, path string) (*domain.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, path)
+	ret0, _ := ret[0].(*domain.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockImageFileReaderMockRecorder) Get(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockImageFileReader)(nil).Get), ctx, path)
+}
+
+// MockReindexPropsRepository is a mock of ReindexPropsRepository interface.
+type MockReindexPropsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReindexPropsRepositoryMockRecorder
+}
+
+// MockReindexPropsRepositoryMockRecorder is the mock recorder for MockReindexPropsRepository.
+type MockReindexPropsRepositoryMockRecorder struct {
+	mock *MockReindexPropsRepository
+}
+
+// NewMockReindexPropsRepository creates a new mock instance.
+func NewMockReindexPropsRepository(ctrl *gomock.Controller) *MockReindexPropsRepository {
+	mock := &MockReindexPropsRepository{ctrl: ctrl}
+	mock.recorder = &MockReindexPropsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReindexPropsRepository) EXPECT() *MockReindexPropsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Upsert mocks base method.
+func (m *MockReindexPropsRepository) Upsert(ctx context.Context, imageID domain.ID, props *domain.ImageProperties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, imageID, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockReindexPropsRepositoryMockRecorder) Upsert(ctx, imageID, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockReindexPropsRepository)(nil).Upsert), ctx, imageID, props)
+}
@@ -0,0 +1,257 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/reaction.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/reaction.go -destination=./internal/usecase/mock/mock_reaction.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/pillowskiy/gopix/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReactionRepository is a mock of ReactionRepository interface.
+type MockReactionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReactionRepositoryMockRecorder
+}
+
+// MockReactionRepositoryMockRecorder is the mock recorder for MockReactionRepository.
+type MockReactionRepositoryMockRecorder struct {
+	mock *MockReactionRepository
+}
+
+// NewMockReactionRepository creates a new mock instance.
+func NewMockReactionRepository(ctrl *gomock.Controller) *MockReactionRepository {
+	mock := &MockReactionRepository{ctrl: ctrl}
+	mock.recorder = &MockReactionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReactionRepository) EXPECT() *MockReactionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockReactionRepository) Add(ctx context.Context, reaction *domain.Reaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, reaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockReactionRepositoryMockRecorder) Add(ctx, reaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockReactionRepository)(nil).Add), ctx, reaction)
+}
+
+// Counts mocks base method.
+func (m *MockReactionRepository) Counts(ctx context.Context, target domain.ReactionTarget, targetIDs []domain.ID, emojis []string, viewerID *domain.ID) (map[domain.ID][]domain.ReactionCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Counts", ctx, target, targetIDs, emojis, viewerID)
+	ret0, _ := ret[0].(map[domain.ID][]domain.ReactionCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Counts indicates an expected call of Counts.
+func (mr *MockReactionRepositoryMockRecorder) Counts(ctx, target, targetIDs, emojis, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Counts", reflect.TypeOf((*MockReactionRepository)(nil).Counts), ctx, target, targetIDs, emojis, viewerID)
+}
+
+// GetUserReactions mocks base method.
+func (m *MockReactionRepository) GetUserReactions(ctx context.Context, target domain.ReactionTarget, targetID, userID domain.ID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserReactions", ctx, target, targetID, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserReactions indicates an expected call of GetUserReactions.
+func (mr *MockReactionRepositoryMockRecorder) GetUserReactions(ctx, target, targetID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReactions", reflect.TypeOf((*MockReactionRepository)(nil).GetUserReactions), ctx, target, targetID, userID)
+}
+
+// Remove mocks base method.
+func (m *MockReactionRepository) Remove(ctx context.Context, reaction *domain.Reaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, reaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockReactionRepositoryMockRecorder) Remove(ctx, reaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockReactionRepository)(nil).Remove), ctx, reaction)
+}
+
+// Replace mocks base method.
+func (m *MockReactionRepository) Replace(ctx context.Context, reaction *domain.Reaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, reaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockReactionRepositoryMockRecorder) Replace(ctx, reaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockReactionRepository)(nil).Replace), ctx, reaction)
+}
+
+// MockReactionImageRepository is a mock of ReactionImageRepository interface.
+type MockReactionImageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReactionImageRepositoryMockRecorder
+}
+
+// MockReactionImageRepositoryMockRecorder is the mock recorder for MockReactionImageRepository.
+type MockReactionImageRepositoryMockRecorder struct {
+	mock *MockReactionImageRepository
+}
+
+// NewMockReactionImageRepository creates a new mock instance.
+func NewMockReactionImageRepository(ctrl *gomock.Controller) *MockReactionImageRepository {
+	mock := &MockReactionImageRepository{ctrl: ctrl}
+	mock.recorder = &MockReactionImageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReactionImageRepository) EXPECT() *MockReactionImageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CanViewAuthor mocks base method.
+func (m *MockReactionImageRepository) CanViewAuthor(ctx context.Context, authorID domain.ID, viewerID *domain.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewAuthor", ctx, authorID, viewerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewAuthor indicates an expected call of CanViewAuthor.
+func (mr *MockReactionImageRepositoryMockRecorder) CanViewAuthor(ctx, authorID, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewAuthor", reflect.TypeOf((*MockReactionImageRepository)(nil).CanViewAuthor), ctx, authorID, viewerID)
+}
+
+// GetByID mocks base method.
+func (m *MockReactionImageRepository) GetByID(ctx context.Context, id domain.ID) (*domain.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReactionImageRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReactionImageRepository)(nil).GetByID), ctx, id)
+}
+
+// MockReactionCommentRepository is a mock of ReactionCommentRepository interface.
+type MockReactionCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReactionCommentRepositoryMockRecorder
+}
+
+// MockReactionCommentRepositoryMockRecorder is the mock recorder for MockReactionCommentRepository.
+type MockReactionCommentRepositoryMockRecorder struct {
+	mock *MockReactionCommentRepository
+}
+
+// NewMockReactionCommentRepository creates a new mock instance.
+func NewMockReactionCommentRepository(ctrl *gomock.Controller) *MockReactionCommentRepository {
+	mock := &MockReactionCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockReactionCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReactionCommentRepository) EXPECT() *MockReactionCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockReactionCommentRepository) GetByID(ctx context.Context, id domain.ID) (*domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReactionCommentRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReactionCommentRepository)(nil).GetByID), ctx, id)
+}
+
+// MockReactionCounter is a mock of ReactionCounter interface.
+type MockReactionCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockReactionCounterMockRecorder
+}
+
+// MockReactionCounterMockRecorder is the mock recorder for MockReactionCounter.
+type MockReactionCounterMockRecorder struct {
+	mock *MockReactionCounter
+}
+
+// NewMockReactionCounter creates a new mock instance.
+func NewMockReactionCounter(ctrl *gomock.Controller) *MockReactionCounter {
+	mock := &MockReactionCounter{ctrl: ctrl}
+	mock.recorder = &MockReactionCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReactionCounter) EXPECT() *MockReactionCounterMockRecorder {
+	return m.recorder
+}
+
+// Counts mocks base method.
+func (m *MockReactionCounter) Counts(ctx context.Context, target domain.ReactionTarget, targetIDs []domain.ID, viewerID *domain.ID) (map[domain.ID][]domain.ReactionCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Counts", ctx, target, targetIDs, viewerID)
+	ret0, _ := ret[0].(map[domain.ID][]domain.ReactionCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Counts indicates an expected call of Counts.
+func (mr *MockReactionCounterMockRecorder) Counts(ctx, target, targetIDs, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Counts", reflect.TypeOf((*MockReactionCounter)(nil).Counts), ctx, target, targetIDs, viewerID)
+}
+
+// UserReactions mocks base method.
+func (m *MockReactionCounter) UserReactions(ctx context.Context, target domain.ReactionTarget, targetID, userID domain.ID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserReactions", ctx, target, targetID, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserReactions indicates an expected call of UserReactions.
+func (mr *MockReactionCounterMockRecorder) UserReactions(ctx, target, targetID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserReactions", reflect.TypeOf((*MockReactionCounter)(nil).UserReactions), ctx, target, targetID, userID)
+}
@@ -0,0 +1,165 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/restriction.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/restriction.go -destination=./internal/usecase/mock/mock_restriction.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/pillowskiy/gopix/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRestrictionRepository is a mock of RestrictionRepository interface.
+type MockRestrictionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestrictionRepositoryMockRecorder
+}
+
+// MockRestrictionRepositoryMockRecorder is the mock recorder for MockRestrictionRepository.
+type MockRestrictionRepositoryMockRecorder struct {
+	mock *MockRestrictionRepository
+}
+
+// NewMockRestrictionRepository creates a new mock instance.
+func NewMockRestrictionRepository(ctrl *gomock.Controller) *MockRestrictionRepository {
+	mock := &MockRestrictionRepository{ctrl: ctrl}
+	mock.recorder = &MockRestrictionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRestrictionRepository) EXPECT() *MockRestrictionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Block mocks base method.
+func (m *MockRestrictionRepository) Block(ctx context.Context, blockerID, blockedID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Block", ctx, blockerID, blockedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockRestrictionRepositoryMockRecorder) Block(ctx, blockerID, blockedID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockRestrictionRepository)(nil).Block), ctx, blockerID, blockedID)
+}
+
+// Blocked mocks base method.
+func (m *MockRestrictionRepository) Blocked(ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput) (*domain.Pagination[domain.RestrictedUser], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Blocked", ctx, userID, pagInput)
+	ret0, _ := ret[0].(*domain.Pagination[domain.RestrictedUser])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Blocked indicates an expected call of Blocked.
+func (mr *MockRestrictionRepositoryMockRecorder) Blocked(ctx, userID, pagInput any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Blocked", reflect.TypeOf((*MockRestrictionRepository)(nil).Blocked), ctx, userID, pagInput)
+}
+
+// Mute mocks base method.
+func (m *MockRestrictionRepository) Mute(ctx context.Context, muterID, mutedID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mute", ctx, muterID, mutedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Mute indicates an expected call of Mute.
+func (mr *MockRestrictionRepositoryMockRecorder) Mute(ctx, muterID, mutedID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mute", reflect.TypeOf((*MockRestrictionRepository)(nil).Mute), ctx, muterID, mutedID)
+}
+
+// Muted mocks base method.
+func (m *MockRestrictionRepository) Muted(ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput) (*domain.Pagination[domain.RestrictedUser], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Muted", ctx, userID, pagInput)
+	ret0, _ := ret[0].(*domain.Pagination[domain.RestrictedUser])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Muted indicates an expected call of Muted.
+func (mr *MockRestrictionRepositoryMockRecorder) Muted(ctx, userID, pagInput any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Muted", reflect.TypeOf((*MockRestrictionRepository)(nil).Muted), ctx, userID, pagInput)
+}
+
+// Unblock mocks base method.
+func (m *MockRestrictionRepository) Unblock(ctx context.Context, blockerID, blockedID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unblock", ctx, blockerID, blockedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unblock indicates an expected call of Unblock.
+func (mr *MockRestrictionRepositoryMockRecorder) Unblock(ctx, blockerID, blockedID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unblock", reflect.TypeOf((*MockRestrictionRepository)(nil).Unblock), ctx, blockerID, blockedID)
+}
+
+// Unmute mocks base method.
+func (m *MockRestrictionRepository) Unmute(ctx context.Context, muterID, mutedID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unmute", ctx, muterID, mutedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unmute indicates an expected call of Unmute.
+func (mr *MockRestrictionRepositoryMockRecorder) Unmute(ctx, muterID, mutedID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unmute", reflect.TypeOf((*MockRestrictionRepository)(nil).Unmute), ctx, muterID, mutedID)
+}
+
+// MockRestrictionUserUseCase is a mock of RestrictionUserUseCase interface.
+type MockRestrictionUserUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestrictionUserUseCaseMockRecorder
+}
+
+// MockRestrictionUserUseCaseMockRecorder is the mock recorder for MockRestrictionUserUseCase.
+type MockRestrictionUserUseCaseMockRecorder struct {
+	mock *MockRestrictionUserUseCase
+}
+
+// NewMockRestrictionUserUseCase creates a new mock instance.
+func NewMockRestrictionUserUseCase(ctrl *gomock.Controller) *MockRestrictionUserUseCase {
+	mock := &MockRestrictionUserUseCase{ctrl: ctrl}
+	mock.recorder = &MockRestrictionUserUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRestrictionUserUseCase) EXPECT() *MockRestrictionUserUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockRestrictionUserUseCase) GetByID(ctx context.Context, userID domain.ID) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRestrictionUserUseCaseMockRecorder) GetByID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRestrictionUserUseCase)(nil).GetByID), ctx, userID)
+}
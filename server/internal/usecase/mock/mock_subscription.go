@@ -40,6 +40,34 @@ func (m *MockSubscriptionFollowingUseCase) EXPECT() *MockSubscriptionFollowingUs
 	return m.recorder
 }
 
+// ApproveRequest mocks base method.
+func (m *MockSubscriptionFollowingUseCase) ApproveRequest(ctx context.Context, requesterID domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveRequest", ctx, requesterID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveRequest indicates an expected call of ApproveRequest.
+func (mr *MockSubscriptionFollowingUseCaseMockRecorder) ApproveRequest(ctx, requesterID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveRequest", reflect.TypeOf((*MockSubscriptionFollowingUseCase)(nil).ApproveRequest), ctx, requesterID, executor)
+}
+
+// DenyRequest mocks base method.
+func (m *MockSubscriptionFollowingUseCase) DenyRequest(ctx context.Context, requesterID domain.ID, executor *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenyRequest", ctx, requesterID, executor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DenyRequest indicates an expected call of DenyRequest.
+func (mr *MockSubscriptionFollowingUseCaseMockRecorder) DenyRequest(ctx, requesterID, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyRequest", reflect.TypeOf((*MockSubscriptionFollowingUseCase)(nil).DenyRequest), ctx, requesterID, executor)
+}
+
 // Follow mocks base method.
 func (m *MockSubscriptionFollowingUseCase) Follow(ctx context.Context, userID domain.ID, executor *domain.User) error {
 	m.ctrl.T.Helper()
@@ -54,6 +82,51 @@ func (mr *MockSubscriptionFollowingUseCaseMockRecorder) Follow(ctx, userID, exec
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockSubscriptionFollowingUseCase)(nil).Follow), ctx, userID, executor)
 }
 
+// FollowRequests mocks base method.
+func (m *MockSubscriptionFollowingUseCase) FollowRequests(ctx context.Context, pagInput *domain.PaginationInput, executor *domain.User) (*domain.Pagination[domain.FollowRequest], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowRequests", ctx, pagInput, executor)
+	ret0, _ := ret[0].(*domain.Pagination[domain.FollowRequest])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowRequests indicates an expected call of FollowRequests.
+func (mr *MockSubscriptionFollowingUseCaseMockRecorder) FollowRequests(ctx, pagInput, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowRequests", reflect.TypeOf((*MockSubscriptionFollowingUseCase)(nil).FollowRequests), ctx, pagInput, executor)
+}
+
+// Followers mocks base method.
+func (m *MockSubscriptionFollowingUseCase) Followers(ctx context.Context, userID domain.ID, input *domain.FollowListInput, executor *domain.User) (*domain.CursorPagination[domain.Follow], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Followers", ctx, userID, input, executor)
+	ret0, _ := ret[0].(*domain.CursorPagination[domain.Follow])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Followers indicates an expected call of Followers.
+func (mr *MockSubscriptionFollowingUseCaseMockRecorder) Followers(ctx, userID, input, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Followers", reflect.TypeOf((*MockSubscriptionFollowingUseCase)(nil).Followers), ctx, userID, input, executor)
+}
+
+// Following mocks base method.
+func (m *MockSubscriptionFollowingUseCase) Following(ctx context.Context, userID domain.ID, input *domain.FollowListInput, executor *domain.User) (*domain.CursorPagination[domain.Follow], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Following", ctx, userID, input, executor)
+	ret0, _ := ret[0].(*domain.CursorPagination[domain.Follow])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Following indicates an expected call of Following.
+func (mr *MockSubscriptionFollowingUseCaseMockRecorder) Following(ctx, userID, input, executor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Following", reflect.TypeOf((*MockSubscriptionFollowingUseCase)(nil).Following), ctx, userID, input, executor)
+}
+
 // Unfollow mocks base method.
 func (m *MockSubscriptionFollowingUseCase) Unfollow(ctx context.Context, userID domain.ID, executor *domain.User) error {
 	m.ctrl.T.Helper()
@@ -68,6 +141,44 @@ func (mr *MockSubscriptionFollowingUseCaseMockRecorder) Unfollow(ctx, userID, ex
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockSubscriptionFollowingUseCase)(nil).Unfollow), ctx, userID, executor)
 }
 
+// MockFollowSuggester is a mock of FollowSuggester interface.
+type MockFollowSuggester struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowSuggesterMockRecorder
+}
+
+// MockFollowSuggesterMockRecorder is the mock recorder for MockFollowSuggester.
+type MockFollowSuggesterMockRecorder struct {
+	mock *MockFollowSuggester
+}
+
+// NewMockFollowSuggester creates a new mock instance.
+func NewMockFollowSuggester(ctrl *gomock.Controller) *MockFollowSuggester {
+	mock := &MockFollowSuggester{ctrl: ctrl}
+	mock.recorder = &MockFollowSuggesterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowSuggester) EXPECT() *MockFollowSuggesterMockRecorder {
+	return m.recorder
+}
+
+// Suggest mocks base method.
+func (m *MockFollowSuggester) Suggest(ctx context.Context, userID domain.ID, limit int) ([]domain.FollowSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, userID, limit)
+	ret0, _ := ret[0].([]domain.FollowSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockFollowSuggesterMockRecorder) Suggest(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockFollowSuggester)(nil).Suggest), ctx, userID, limit)
+}
+
 // MockSubscriptionUserUseCase is a mock of SubscriptionUserUseCase interface.
 type MockSubscriptionUserUseCase struct {
 	ctrl     *gomock.Controller
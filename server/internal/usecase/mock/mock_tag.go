@@ -12,6 +12,7 @@ package mock_usecase
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/pillowskiy/gopix/internal/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -40,6 +41,49 @@ func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddAlias mocks base method.
+func (m *MockTagRepository) AddAlias(ctx context.Context, id domain.ID, alias string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAlias", ctx, id, alias)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAlias indicates an expected call of AddAlias.
+func (mr *MockTagRepositoryMockRecorder) AddAlias(ctx, id, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAlias", reflect.TypeOf((*MockTagRepository)(nil).AddAlias), ctx, id, alias)
+}
+
+// Approve mocks base method.
+func (m *MockTagRepository) Approve(ctx context.Context, id domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockTagRepositoryMockRecorder) Approve(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockTagRepository)(nil).Approve), ctx, id)
+}
+
+// CountImages mocks base method.
+func (m *MockTagRepository) CountImages(ctx context.Context, id domain.ID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountImages", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountImages indicates an expected call of CountImages.
+func (mr *MockTagRepositoryMockRecorder) CountImages(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountImages", reflect.TypeOf((*MockTagRepository)(nil).CountImages), ctx, id)
+}
+
 // Create mocks base method.
 func (m *MockTagRepository) Create(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
 	m.ctrl.T.Helper()
@@ -83,6 +127,36 @@ func (mr *MockTagRepositoryMockRecorder) DeleteImageTag(ctx, imageID, tagID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageTag", reflect.TypeOf((*MockTagRepository)(nil).DeleteImageTag), ctx, imageID, tagID)
 }
 
+// GetAliases mocks base method.
+func (m *MockTagRepository) GetAliases(ctx context.Context, id domain.ID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAliases", ctx, id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAliases indicates an expected call of GetAliases.
+func (mr *MockTagRepositoryMockRecorder) GetAliases(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAliases", reflect.TypeOf((*MockTagRepository)(nil).GetAliases), ctx, id)
+}
+
+// GetAncestorIDs mocks base method.
+func (m *MockTagRepository) GetAncestorIDs(ctx context.Context, id domain.ID) ([]domain.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAncestorIDs", ctx, id)
+	ret0, _ := ret[0].([]domain.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAncestorIDs indicates an expected call of GetAncestorIDs.
+func (mr *MockTagRepositoryMockRecorder) GetAncestorIDs(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAncestorIDs", reflect.TypeOf((*MockTagRepository)(nil).GetAncestorIDs), ctx, id)
+}
+
 // GetByID mocks base method.
 func (m *MockTagRepository) GetByID(ctx context.Context, id domain.ID) (*domain.Tag, error) {
 	m.ctrl.T.Helper()
@@ -113,19 +187,164 @@ func (mr *MockTagRepositoryMockRecorder) GetByName(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockTagRepository)(nil).GetByName), ctx, name)
 }
 
+// GetChildren mocks base method.
+func (m *MockTagRepository) GetChildren(ctx context.Context, id domain.ID) ([]domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChildren", ctx, id)
+	ret0, _ := ret[0].([]domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChildren indicates an expected call of GetChildren.
+func (mr *MockTagRepositoryMockRecorder) GetChildren(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChildren", reflect.TypeOf((*MockTagRepository)(nil).GetChildren), ctx, id)
+}
+
+// GetImages mocks base method.
+func (m *MockTagRepository) GetImages(ctx context.Context, id domain.ID, viewerID *domain.ID, pagInput *domain.PaginationInput) (*domain.Pagination[domain.ImageWithMeta], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImages", ctx, id, viewerID, pagInput)
+	ret0, _ := ret[0].(*domain.Pagination[domain.ImageWithMeta])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImages indicates an expected call of GetImages.
+func (mr *MockTagRepositoryMockRecorder) GetImages(ctx, id, viewerID, pagInput any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImages", reflect.TypeOf((*MockTagRepository)(nil).GetImages), ctx, id, viewerID, pagInput)
+}
+
+// GetProposals mocks base method.
+func (m *MockTagRepository) GetProposals(ctx context.Context, pagInput *domain.PaginationInput) (*domain.Pagination[domain.TagProposal], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposals", ctx, pagInput)
+	ret0, _ := ret[0].(*domain.Pagination[domain.TagProposal])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposals indicates an expected call of GetProposals.
+func (mr *MockTagRepositoryMockRecorder) GetProposals(ctx, pagInput any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposals", reflect.TypeOf((*MockTagRepository)(nil).GetProposals), ctx, pagInput)
+}
+
+// GetUsage mocks base method.
+func (m *MockTagRepository) GetUsage(ctx context.Context, since time.Time, sort domain.TagUsageSortMethod, pagInput *domain.PaginationInput) (*domain.Pagination[domain.TagUsage], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", ctx, since, sort, pagInput)
+	ret0, _ := ret[0].(*domain.Pagination[domain.TagUsage])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockTagRepositoryMockRecorder) GetUsage(ctx, since, sort, pagInput any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockTagRepository)(nil).GetUsage), ctx, since, sort, pagInput)
+}
+
+// GetUsageHistory mocks base method.
+func (m *MockTagRepository) GetUsageHistory(ctx context.Context, id domain.ID, since time.Time) ([]domain.TagUsagePoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsageHistory", ctx, id, since)
+	ret0, _ := ret[0].([]domain.TagUsagePoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsageHistory indicates an expected call of GetUsageHistory.
+func (mr *MockTagRepositoryMockRecorder) GetUsageHistory(ctx, id, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsageHistory", reflect.TypeOf((*MockTagRepository)(nil).GetUsageHistory), ctx, id, since)
+}
+
+// Merge mocks base method.
+func (m *MockTagRepository) Merge(ctx context.Context, id, targetID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, id, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockTagRepositoryMockRecorder) Merge(ctx, id, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockTagRepository)(nil).Merge), ctx, id, targetID)
+}
+
+// Reject mocks base method.
+func (m *MockTagRepository) Reject(ctx context.Context, id domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockTagRepositoryMockRecorder) Reject(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockTagRepository)(nil).Reject), ctx, id)
+}
+
+// RemoveAlias mocks base method.
+func (m *MockTagRepository) RemoveAlias(ctx context.Context, id domain.ID, alias string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAlias", ctx, id, alias)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAlias indicates an expected call of RemoveAlias.
+func (mr *MockTagRepositoryMockRecorder) RemoveAlias(ctx, id, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAlias", reflect.TypeOf((*MockTagRepository)(nil).RemoveAlias), ctx, id, alias)
+}
+
+// Rename mocks base method.
+func (m *MockTagRepository) Rename(ctx context.Context, id domain.ID, name string, keepAlias bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", ctx, id, name, keepAlias)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockTagRepositoryMockRecorder) Rename(ctx, id, name, keepAlias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockTagRepository)(nil).Rename), ctx, id, name, keepAlias)
+}
+
 // Search mocks base method.
-func (m *MockTagRepository) Search(ctx context.Context, name string) ([]domain.Tag, error) {
+func (m *MockTagRepository) Search(ctx context.Context, name string, viewerID *domain.ID) ([]domain.Tag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", ctx, name)
+	ret := m.ctrl.Call(m, "Search", ctx, name, viewerID)
 	ret0, _ := ret[0].([]domain.Tag)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Search indicates an expected call of Search.
-func (mr *MockTagRepositoryMockRecorder) Search(ctx, name any) *gomock.Call {
+func (mr *MockTagRepositoryMockRecorder) Search(ctx, name, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTagRepository)(nil).Search), ctx, name, viewerID)
+}
+
+// SetParent mocks base method.
+func (m *MockTagRepository) SetParent(ctx context.Context, id domain.ID, parentID *domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetParent", ctx, id, parentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetParent indicates an expected call of SetParent.
+func (mr *MockTagRepositoryMockRecorder) SetParent(ctx, id, parentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTagRepository)(nil).Search), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetParent", reflect.TypeOf((*MockTagRepository)(nil).SetParent), ctx, id, parentID)
 }
 
 // UpsertImageTags mocks base method.
@@ -203,6 +422,20 @@ func (m *MockTagAccessPolicy) EXPECT() *MockTagAccessPolicyMockRecorder {
 	return m.recorder
 }
 
+// CanModerateTags mocks base method.
+func (m *MockTagAccessPolicy) CanModerateTags(user *domain.User) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanModerateTags", user)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanModerateTags indicates an expected call of CanModerateTags.
+func (mr *MockTagAccessPolicyMockRecorder) CanModerateTags(user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanModerateTags", reflect.TypeOf((*MockTagAccessPolicy)(nil).CanModerateTags), user)
+}
+
 // CanModifyImageTags mocks base method.
 func (m *MockTagAccessPolicy) CanModifyImageTags(user *domain.User, image *domain.Image) bool {
 	m.ctrl.T.Helper()
@@ -216,3 +449,41 @@ func (mr *MockTagAccessPolicyMockRecorder) CanModifyImageTags(user, image any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanModifyImageTags", reflect.TypeOf((*MockTagAccessPolicy)(nil).CanModifyImageTags), user, image)
 }
+
+// MockTagSuggester is a mock of TagSuggester interface.
+type MockTagSuggester struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagSuggesterMockRecorder
+}
+
+// MockTagSuggesterMockRecorder is the mock recorder for MockTagSuggester.
+type MockTagSuggesterMockRecorder struct {
+	mock *MockTagSuggester
+}
+
+// NewMockTagSuggester creates a new mock instance.
+func NewMockTagSuggester(ctrl *gomock.Controller) *MockTagSuggester {
+	mock := &MockTagSuggester{ctrl: ctrl}
+	mock.recorder = &MockTagSuggesterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagSuggester) EXPECT() *MockTagSuggesterMockRecorder {
+	return m.recorder
+}
+
+// Suggest mocks base method.
+func (m *MockTagSuggester) Suggest(ctx context.Context, imageID domain.ID) ([]domain.TagSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, imageID)
+	ret0, _ := ret[0].([]domain.TagSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockTagSuggesterMockRecorder) Suggest(ctx, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockTagSuggester)(nil).Suggest), ctx, imageID)
+}
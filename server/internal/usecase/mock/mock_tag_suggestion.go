@@ -0,0 +1,138 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/tag_suggestion.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/tag_suggestion.go -destination=./internal/usecase/mock/mock_tag_suggestion.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/pillowskiy/gopix/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTagSuggestionRepository is a mock of TagSuggestionRepository interface.
+type MockTagSuggestionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagSuggestionRepositoryMockRecorder
+}
+
+// MockTagSuggestionRepositoryMockRecorder is the mock recorder for MockTagSuggestionRepository.
+type MockTagSuggestionRepositoryMockRecorder struct {
+	mock *MockTagSuggestionRepository
+}
+
+// NewMockTagSuggestionRepository creates a new mock instance.
+func NewMockTagSuggestionRepository(ctrl *gomock.Controller) *MockTagSuggestionRepository {
+	mock := &MockTagSuggestionRepository{ctrl: ctrl}
+	mock.recorder = &MockTagSuggestionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagSuggestionRepository) EXPECT() *MockTagSuggestionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CooccurringTags mocks base method.
+func (m *MockTagSuggestionRepository) CooccurringTags(ctx context.Context, imageID domain.ID, limit int) ([]domain.TagSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CooccurringTags", ctx, imageID, limit)
+	ret0, _ := ret[0].([]domain.TagSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CooccurringTags indicates an expected call of CooccurringTags.
+func (mr *MockTagSuggestionRepositoryMockRecorder) CooccurringTags(ctx, imageID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CooccurringTags", reflect.TypeOf((*MockTagSuggestionRepository)(nil).CooccurringTags), ctx, imageID, limit)
+}
+
+// ImagesTags mocks base method.
+func (m *MockTagSuggestionRepository) ImagesTags(ctx context.Context, imageIDs []domain.ID) (map[domain.ID][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImagesTags", ctx, imageIDs)
+	ret0, _ := ret[0].(map[domain.ID][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImagesTags indicates an expected call of ImagesTags.
+func (mr *MockTagSuggestionRepositoryMockRecorder) ImagesTags(ctx, imageIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagesTags", reflect.TypeOf((*MockTagSuggestionRepository)(nil).ImagesTags), ctx, imageIDs)
+}
+
+// PublicImagesTags mocks base method.
+func (m *MockTagSuggestionRepository) PublicImagesTags(ctx context.Context, imageIDs []domain.ID) (map[domain.ID][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicImagesTags", ctx, imageIDs)
+	ret0, _ := ret[0].(map[domain.ID][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicImagesTags indicates an expected call of PublicImagesTags.
+func (mr *MockTagSuggestionRepositoryMockRecorder) PublicImagesTags(ctx, imageIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicImagesTags", reflect.TypeOf((*MockTagSuggestionRepository)(nil).PublicImagesTags), ctx, imageIDs)
+}
+
+// UpsertImageTags mocks base method.
+func (m *MockTagSuggestionRepository) UpsertImageTags(ctx context.Context, tag *domain.Tag, imageID domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertImageTags", ctx, tag, imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertImageTags indicates an expected call of UpsertImageTags.
+func (mr *MockTagSuggestionRepositoryMockRecorder) UpsertImageTags(ctx, tag, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertImageTags", reflect.TypeOf((*MockTagSuggestionRepository)(nil).UpsertImageTags), ctx, tag, imageID)
+}
+
+// MockTagSuggestionFeaturesUseCase is a mock of TagSuggestionFeaturesUseCase interface.
+type MockTagSuggestionFeaturesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagSuggestionFeaturesUseCaseMockRecorder
+}
+
+// MockTagSuggestionFeaturesUseCaseMockRecorder is the mock recorder for MockTagSuggestionFeaturesUseCase.
+type MockTagSuggestionFeaturesUseCaseMockRecorder struct {
+	mock *MockTagSuggestionFeaturesUseCase
+}
+
+// NewMockTagSuggestionFeaturesUseCase creates a new mock instance.
+func NewMockTagSuggestionFeaturesUseCase(ctrl *gomock.Controller) *MockTagSuggestionFeaturesUseCase {
+	mock := &MockTagSuggestionFeaturesUseCase{ctrl: ctrl}
+	mock.recorder = &MockTagSuggestionFeaturesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagSuggestionFeaturesUseCase) EXPECT() *MockTagSuggestionFeaturesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Similar mocks base method.
+func (m *MockTagSuggestionFeaturesUseCase) Similar(ctx context.Context, imageID domain.ID) ([]domain.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Similar", ctx, imageID)
+	ret0, _ := ret[0].([]domain.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Similar indicates an expected call of Similar.
+func (mr *MockTagSuggestionFeaturesUseCaseMockRecorder) Similar(ctx, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Similar", reflect.TypeOf((*MockTagSuggestionFeaturesUseCase)(nil).Similar), ctx, imageID)
+}
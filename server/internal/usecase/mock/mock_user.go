@@ -121,6 +121,20 @@ func (mr *MockUserRepositoryMockRecorder) SetPermissions(ctx, id, permissions an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPermissions", reflect.TypeOf((*MockUserRepository)(nil).SetPermissions), ctx, id, permissions)
 }
 
+// SetPrivate mocks base method.
+func (m *MockUserRepository) SetPrivate(ctx context.Context, id domain.ID, private bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrivate", ctx, id, private)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPrivate indicates an expected call of SetPrivate.
+func (mr *MockUserRepositoryMockRecorder) SetPrivate(ctx, id, private any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrivate", reflect.TypeOf((*MockUserRepository)(nil).SetPrivate), ctx, id, private)
+}
+
 // Update mocks base method.
 func (m *MockUserRepository) Update(ctx context.Context, id domain.ID, user *domain.User) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -173,3 +187,41 @@ func (mr *MockUserFollowingUseCaseMockRecorder) Stats(ctx, userID, executorID an
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockUserFollowingUseCase)(nil).Stats), ctx, userID, executorID)
 }
+
+// MockUserRestrictions is a mock of UserRestrictions interface.
+type MockUserRestrictions struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRestrictionsMockRecorder
+}
+
+// MockUserRestrictionsMockRecorder is the mock recorder for MockUserRestrictions.
+type MockUserRestrictionsMockRecorder struct {
+	mock *MockUserRestrictions
+}
+
+// NewMockUserRestrictions creates a new mock instance.
+func NewMockUserRestrictions(ctrl *gomock.Controller) *MockUserRestrictions {
+	mock := &MockUserRestrictions{ctrl: ctrl}
+	mock.recorder = &MockUserRestrictionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRestrictions) EXPECT() *MockUserRestrictionsMockRecorder {
+	return m.recorder
+}
+
+// HasBlocked mocks base method.
+func (m *MockUserRestrictions) HasBlocked(ctx context.Context, blockerID, blockedID domain.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasBlocked", ctx, blockerID, blockedID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasBlocked indicates an expected call of HasBlocked.
+func (mr *MockUserRestrictionsMockRecorder) HasBlocked(ctx, blockerID, blockedID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasBlocked", reflect.TypeOf((*MockUserRestrictions)(nil).HasBlocked), ctx, blockerID, blockedID)
+}
@@ -25,18 +25,46 @@ type NotificationSignal interface {
 	Publish(id string, item *domain.Notification) error
 }
 
+type NotificationRestrictions interface {
+	IsSilenced(ctx context.Context, userID, actorID domain.ID) (bool, error)
+}
+
 type notifactionUseCase struct {
-	repo    NotificationRepository
-	signal  NotificationSignal
-	logger  logger.Logger
-	waitDur time.Duration
+	repo         NotificationRepository
+	restrictions NotificationRestrictions
+	signal       NotificationSignal
+	logger       logger.Logger
+	waitDur      time.Duration
 }
 
-func NewNotificationUseCase(repo NotificationRepository, signal NotificationSignal, logger logger.Logger) *notifactionUseCase {
-	return &notifactionUseCase{repo: repo, signal: signal, logger: logger, waitDur: 2 * time.Minute}
+func NewNotificationUseCase(
+	repo NotificationRepository,
+	restrictions NotificationRestrictions,
+	signal NotificationSignal,
+	logger logger.Logger,
+) *notifactionUseCase {
+	return &notifactionUseCase{
+		repo:         repo,
+		restrictions: restrictions,
+		signal:       signal,
+		logger:       logger,
+		waitDur:      2 * time.Minute,
+	}
 }
 
+// Notify silently drops the notifications caused by the users the receiver blocked or muted
 func (uc *notifactionUseCase) Notify(ctx context.Context, userID domain.ID, notif *domain.Notification) error {
+	if notif.ActorID != nil {
+		silenced, err := uc.restrictions.IsSilenced(ctx, userID, *notif.ActorID)
+		if err != nil {
+			return fmt.Errorf("NotificationUseCase.Notify.IsSilenced: %w", err)
+		}
+
+		if silenced {
+			return nil
+		}
+	}
+
 	createdNotif, err := uc.repo.Push(ctx, userID, notif)
 	if err != nil {
 		return fmt.Errorf("NotificationUseCase.Notify.Push: %w", err)
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
+	"github.com/pkg/errors"
+)
+
+var defaultReactionEmojis = []string{"👍", "❤️", "😂", "😮", "😢", "🔥"}
+
+type ReactionRepository interface {
+	Add(ctx context.Context, reaction *domain.Reaction) error
+	Replace(ctx context.Context, reaction *domain.Reaction) error
+	Remove(ctx context.Context, reaction *domain.Reaction) error
+	Counts(
+		ctx context.Context, target domain.ReactionTarget, targetIDs []domain.ID, emojis []string, viewerID *domain.ID,
+	) (map[domain.ID][]domain.ReactionCount, error)
+	GetUserReactions(
+		ctx context.Context, target domain.ReactionTarget, targetID domain.ID, userID domain.ID,
+	) ([]string, error)
+}
+
+// The targets are looked up in the repositories, since the image and comment use cases depend on the reactions
+type ReactionImageRepository interface {
+	GetByID(ctx context.Context, id domain.ID) (*domain.Image, error)
+	CanViewAuthor(ctx context.Context, authorID domain.ID, viewerID *domain.ID) (bool, error)
+}
+
+type ReactionCommentRepository interface {
+	GetByID(ctx context.Context, id domain.ID) (*domain.Comment, error)
+}
+
+// ReactionCounter fills the aggregated reactions of the images and comments
+type ReactionCounter interface {
+	Counts(
+		ctx context.Context, target domain.ReactionTarget, targetIDs []domain.ID, viewerID *domain.ID,
+	) (map[domain.ID][]domain.ReactionCount, error)
+	UserReactions(
+		ctx context.Context, target domain.ReactionTarget, targetID domain.ID, userID domain.ID,
+	) ([]string, error)
+}
+
+type reactionUseCase struct {
+	repo        ReactionRepository
+	imageRepo   ReactionImageRepository
+	commentRepo ReactionCommentRepository
+	emojis      []string
+	multiple    bool
+}
+
+// NewReactionUseCase allows only the given emojis, the default set is used when it's empty.
+// Unless multiple is set, a new reaction of the user replaces the previous one on the same target
+func NewReactionUseCase(
+	repo ReactionRepository,
+	imageRepo ReactionImageRepository,
+	commentRepo ReactionCommentRepository,
+	emojis []string,
+	multiple bool,
+) *reactionUseCase {
+	if len(emojis) == 0 {
+		emojis = defaultReactionEmojis
+	}
+
+	return &reactionUseCase{
+		repo:        repo,
+		imageRepo:   imageRepo,
+		commentRepo: commentRepo,
+		emojis:      emojis,
+		multiple:    multiple,
+	}
+}
+
+func (uc *reactionUseCase) Emojis() []string {
+	return uc.emojis
+}
+
+func (uc *reactionUseCase) React(ctx context.Context, reaction *domain.Reaction, executor *domain.User) error {
+	if !slices.Contains(uc.emojis, reaction.Emoji) {
+		return ErrUnprocessable
+	}
+
+	if err := uc.checkTarget(ctx, reaction.Target, reaction.TargetID, executor); err != nil {
+		return err
+	}
+
+	reaction.UserID = executor.ID
+	if uc.multiple {
+		return uc.repo.Add(ctx, reaction)
+	}
+
+	return uc.repo.Replace(ctx, reaction)
+}
+
+func (uc *reactionUseCase) Unreact(ctx context.Context, reaction *domain.Reaction, executor *domain.User) error {
+	reaction.UserID = executor.ID
+	if err := uc.repo.Remove(ctx, reaction); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Counts returns the reactions of the targets in order of the configured emojis
+func (uc *reactionUseCase) Counts(
+	ctx context.Context,
+	target domain.ReactionTarget,
+	targetIDs []domain.ID,
+	viewerID *domain.ID,
+) (map[domain.ID][]domain.ReactionCount, error) {
+	return uc.repo.Counts(ctx, target, targetIDs, uc.emojis, viewerID)
+}
+
+// UserReactions omits the reactions with the emojis that are no longer configured
+func (uc *reactionUseCase) UserReactions(
+	ctx context.Context,
+	target domain.ReactionTarget,
+	targetID domain.ID,
+	userID domain.ID,
+) ([]string, error) {
+	emojis, err := uc.repo.GetUserReactions(ctx, target, targetID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(emojis, func(emoji string) bool {
+		return !slices.Contains(uc.emojis, emoji)
+	}), nil
+}
+
+// orEmptyReactions keeps the reactions of the targets without any as an empty list in the responses
+func orEmptyReactions(reactions []domain.ReactionCount) []domain.ReactionCount {
+	if reactions == nil {
+		return []domain.ReactionCount{}
+	}
+	return reactions
+}
+
+// checkTarget makes sure the target exists and its image author is visible to the executor
+func (uc *reactionUseCase) checkTarget(
+	ctx context.Context, target domain.ReactionTarget, targetID domain.ID, executor *domain.User,
+) error {
+	imageID := targetID
+	switch target {
+	case domain.ReactionTargetImage:
+	case domain.ReactionTargetComment:
+		cmt, err := uc.commentRepo.GetByID(ctx, targetID)
+		if err != nil {
+			return uc.targetErr(err)
+		}
+		if cmt.IsDeleted() {
+			return ErrNotFound
+		}
+		imageID = cmt.ImageID
+	default:
+		return ErrUnprocessable
+	}
+
+	img, err := uc.imageRepo.GetByID(ctx, imageID)
+	if err != nil {
+		return uc.targetErr(err)
+	}
+
+	canView, err := uc.imageRepo.CanViewAuthor(ctx, img.AuthorID, executorID(executor))
+	if err != nil {
+		return errors.Wrap(err, "ReactionUseCase.checkTarget.CanViewAuthor")
+	}
+	if !canView {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+func (uc *reactionUseCase) targetErr(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrNotFound
+	}
+	return errors.Wrap(err, "ReactionUseCase.checkTarget")
+}
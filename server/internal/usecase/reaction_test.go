@@ -0,0 +1,174 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/repository"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	usecaseMock "github.com/pillowskiy/gopix/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReactionUseCase_React(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockReactionRepository(ctrl)
+	mockImageRepo := usecaseMock.NewMockReactionImageRepository(ctrl)
+	mockCommentRepo := usecaseMock.NewMockReactionCommentRepository(ctrl)
+
+	emojis := []string{"👍", "🔥"}
+	singleUC := usecase.NewReactionUseCase(mockRepo, mockImageRepo, mockCommentRepo, emojis, false)
+	multipleUC := usecase.NewReactionUseCase(mockRepo, mockImageRepo, mockCommentRepo, emojis, true)
+
+	executor := &domain.User{ID: 2}
+	targetID := domain.ID(1)
+
+	newReaction := func(target domain.ReactionTarget, emoji string) *domain.Reaction {
+		return &domain.Reaction{Target: target, TargetID: targetID, Emoji: emoji}
+	}
+
+	t.Run("SingleReplacesReaction", func(t *testing.T) {
+		reaction := newReaction(domain.ReactionTargetImage, "🔥")
+
+		mockImageRepo.EXPECT().GetByID(gomock.Any(), targetID).Return(&domain.Image{ID: targetID}, nil)
+		mockImageRepo.EXPECT().CanViewAuthor(gomock.Any(), gomock.Any(), &executor.ID).Return(true, nil)
+		mockRepo.EXPECT().Replace(gomock.Any(), reaction).Return(nil)
+		mockRepo.EXPECT().Add(gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, singleUC.React(context.Background(), reaction, executor))
+		assert.Equal(t, executor.ID, reaction.UserID)
+	})
+
+	t.Run("MultipleAddsReaction", func(t *testing.T) {
+		reaction := newReaction(domain.ReactionTargetComment, "👍")
+
+		imageID := domain.ID(3)
+		mockCommentRepo.EXPECT().GetByID(gomock.Any(), targetID).Return(&domain.Comment{ID: targetID, ImageID: imageID}, nil)
+		mockImageRepo.EXPECT().GetByID(gomock.Any(), imageID).Return(&domain.Image{ID: imageID}, nil)
+		mockImageRepo.EXPECT().CanViewAuthor(gomock.Any(), gomock.Any(), &executor.ID).Return(true, nil)
+		mockRepo.EXPECT().Add(gomock.Any(), reaction).Return(nil)
+		mockRepo.EXPECT().Replace(gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(t, multipleUC.React(context.Background(), reaction, executor))
+	})
+
+	t.Run("UnsupportedEmoji", func(t *testing.T) {
+		mockImageRepo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Times(0)
+		mockRepo.EXPECT().Replace(gomock.Any(), gomock.Any()).Times(0)
+
+		err := singleUC.React(context.Background(), newReaction(domain.ReactionTargetImage, "🙃"), executor)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+	})
+
+	t.Run("ImageNotFound", func(t *testing.T) {
+		mockImageRepo.EXPECT().GetByID(gomock.Any(), targetID).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().Replace(gomock.Any(), gomock.Any()).Times(0)
+
+		err := singleUC.React(context.Background(), newReaction(domain.ReactionTargetImage, "🔥"), executor)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+
+	t.Run("HiddenAuthor", func(t *testing.T) {
+		authorID := domain.ID(4)
+		mockImageRepo.EXPECT().GetByID(gomock.Any(), targetID).Return(&domain.Image{ID: targetID, AuthorID: authorID}, nil)
+		mockImageRepo.EXPECT().CanViewAuthor(gomock.Any(), authorID, &executor.ID).Return(false, nil)
+		mockRepo.EXPECT().Replace(gomock.Any(), gomock.Any()).Times(0)
+
+		err := singleUC.React(context.Background(), newReaction(domain.ReactionTargetImage, "🔥"), executor)
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+	})
+
+	t.Run("DeletedComment", func(t *testing.T) {
+		deletedAt := time.Now()
+		mockCommentRepo.EXPECT().
+			GetByID(gomock.Any(), targetID).
+			Return(&domain.Comment{ID: targetID, DeletedAt: &deletedAt}, nil)
+		mockRepo.EXPECT().Replace(gomock.Any(), gomock.Any()).Times(0)
+
+		err := singleUC.React(context.Background(), newReaction(domain.ReactionTargetComment, "🔥"), executor)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockImageRepo.EXPECT().GetByID(gomock.Any(), targetID).Return(nil, errors.New("repo error"))
+
+		err := singleUC.React(context.Background(), newReaction(domain.ReactionTargetImage, "🔥"), executor)
+		assert.Error(t, err)
+	})
+}
+
+func TestReactionUseCase_Unreact(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockReactionRepository(ctrl)
+	reactionUC := usecase.NewReactionUseCase(mockRepo, nil, nil, nil, false)
+
+	executor := &domain.User{ID: 2}
+
+	t.Run("SuccessUnreact", func(t *testing.T) {
+		reaction := &domain.Reaction{Target: domain.ReactionTargetImage, TargetID: 1, Emoji: "🔥"}
+		mockRepo.EXPECT().Remove(gomock.Any(), reaction).Return(nil)
+
+		assert.NoError(t, reactionUC.Unreact(context.Background(), reaction, executor))
+		assert.Equal(t, executor.ID, reaction.UserID)
+	})
+
+	t.Run("NotReacted", func(t *testing.T) {
+		reaction := &domain.Reaction{Target: domain.ReactionTargetImage, TargetID: 1, Emoji: "🔥"}
+		mockRepo.EXPECT().Remove(gomock.Any(), reaction).Return(repository.ErrNotFound)
+
+		err := reactionUC.Unreact(context.Background(), reaction, executor)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
+
+func TestReactionUseCase_Counts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockReactionRepository(ctrl)
+
+	emojis := []string{"👍", "🔥"}
+	reactionUC := usecase.NewReactionUseCase(mockRepo, nil, nil, emojis, false)
+
+	t.Run("UsesConfiguredEmojis", func(t *testing.T) {
+		viewerID := domain.ID(2)
+		counts := map[domain.ID][]domain.ReactionCount{1: {{Emoji: "🔥", Count: 3, Reacted: true}}}
+
+		mockRepo.EXPECT().
+			Counts(gomock.Any(), domain.ReactionTargetComment, []domain.ID{1}, emojis, &viewerID).
+			Return(counts, nil)
+
+		actual, err := reactionUC.Counts(context.Background(), domain.ReactionTargetComment, []domain.ID{1}, &viewerID)
+		assert.NoError(t, err)
+		assert.Equal(t, counts, actual)
+	})
+
+	t.Run("DefaultEmojis", func(t *testing.T) {
+		defaultUC := usecase.NewReactionUseCase(mockRepo, nil, nil, nil, false)
+		assert.NotEmpty(t, defaultUC.Emojis())
+	})
+
+	t.Run("UserReactionsOmitUnconfigured", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetUserReactions(gomock.Any(), domain.ReactionTargetImage, domain.ID(1), domain.ID(2)).
+			Return([]string{"🙃", "🔥"}, nil)
+
+		actual, err := reactionUC.UserReactions(context.Background(), domain.ReactionTargetImage, 1, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"🔥"}, actual)
+	})
+}
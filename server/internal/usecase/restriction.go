@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pkg/errors"
+)
+
+type RestrictionRepository interface {
+	Block(ctx context.Context, blockerID, blockedID domain.ID) error
+	Unblock(ctx context.Context, blockerID, blockedID domain.ID) error
+	Mute(ctx context.Context, muterID, mutedID domain.ID) error
+	Unmute(ctx context.Context, muterID, mutedID domain.ID) error
+	Blocked(
+		ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput,
+	) (*domain.Pagination[domain.RestrictedUser], error)
+	Muted(
+		ctx context.Context, userID domain.ID, pagInput *domain.PaginationInput,
+	) (*domain.Pagination[domain.RestrictedUser], error)
+}
+
+type RestrictionUserUseCase interface {
+	GetByID(ctx context.Context, userID domain.ID) (*domain.User, error)
+}
+
+type restrictionUseCase struct {
+	repo   RestrictionRepository
+	userUC RestrictionUserUseCase
+}
+
+func NewRestrictionUseCase(repo RestrictionRepository, userUC RestrictionUserUseCase) *restrictionUseCase {
+	return &restrictionUseCase{repo: repo, userUC: userUC}
+}
+
+func (uc *restrictionUseCase) Block(ctx context.Context, userID domain.ID, executor *domain.User) error {
+	if err := uc.correctTargetRef(ctx, userID, executor); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Block(ctx, executor.ID, userID); err != nil {
+		return errors.Wrap(err, "RestrictionUseCase.Block")
+	}
+
+	return nil
+}
+
+func (uc *restrictionUseCase) Unblock(ctx context.Context, userID domain.ID, executor *domain.User) error {
+	if err := uc.repo.Unblock(ctx, executor.ID, userID); err != nil {
+		return errors.Wrap(err, "RestrictionUseCase.Unblock")
+	}
+
+	return nil
+}
+
+func (uc *restrictionUseCase) Mute(ctx context.Context, userID domain.ID, executor *domain.User) error {
+	if err := uc.correctTargetRef(ctx, userID, executor); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Mute(ctx, executor.ID, userID); err != nil {
+		return errors.Wrap(err, "RestrictionUseCase.Mute")
+	}
+
+	return nil
+}
+
+func (uc *restrictionUseCase) Unmute(ctx context.Context, userID domain.ID, executor *domain.User) error {
+	if err := uc.repo.Unmute(ctx, executor.ID, userID); err != nil {
+		return errors.Wrap(err, "RestrictionUseCase.Unmute")
+	}
+
+	return nil
+}
+
+func (uc *restrictionUseCase) Blocked(
+	ctx context.Context, pagInput *domain.PaginationInput, executor *domain.User,
+) (*domain.Pagination[domain.RestrictedUser], error) {
+	return uc.repo.Blocked(ctx, executor.ID, pagInput)
+}
+
+func (uc *restrictionUseCase) Muted(
+	ctx context.Context, pagInput *domain.PaginationInput, executor *domain.User,
+) (*domain.Pagination[domain.RestrictedUser], error) {
+	return uc.repo.Muted(ctx, executor.ID, pagInput)
+}
+
+func (uc *restrictionUseCase) correctTargetRef(ctx context.Context, userID domain.ID, executor *domain.User) error {
+	if userID == executor.ID {
+		return ErrUnprocessable
+	}
+
+	if _, err := uc.userUC.GetByID(ctx, userID); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return ErrIncorrectUserRef
+		}
+
+		return errors.Wrap(err, "RestrictionUseCase.correctTargetRef")
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	usecaseMock "github.com/pillowskiy/gopix/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRestrictionUseCase_Block(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockRestrictionRepository(ctrl)
+	mockUserUC := usecaseMock.NewMockRestrictionUserUseCase(ctrl)
+	restrictionUC := usecase.NewRestrictionUseCase(mockRepo, mockUserUC)
+
+	mockUserID := domain.ID(1)
+	mockExecutor := &domain.User{ID: 2}
+
+	t.Run("SuccessBlock", func(t *testing.T) {
+		mockUserUC.EXPECT().GetByID(gomock.Any(), mockUserID).Return(&domain.User{ID: mockUserID}, nil)
+		mockRepo.EXPECT().Block(gomock.Any(), mockExecutor.ID, mockUserID).Return(nil)
+
+		err := restrictionUC.Block(context.Background(), mockUserID, mockExecutor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("BlockSelf", func(t *testing.T) {
+		mockUserUC.EXPECT().GetByID(gomock.Any(), gomock.Any()).Times(0)
+		mockRepo.EXPECT().Block(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := restrictionUC.Block(context.Background(), mockExecutor.ID, mockExecutor)
+		assert.Equal(t, usecase.ErrUnprocessable, err)
+	})
+
+	t.Run("ErrUserRef", func(t *testing.T) {
+		mockUserUC.EXPECT().GetByID(gomock.Any(), mockUserID).Return(nil, usecase.ErrNotFound)
+		mockRepo.EXPECT().Block(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := restrictionUC.Block(context.Background(), mockUserID, mockExecutor)
+		assert.Equal(t, usecase.ErrIncorrectUserRef, err)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockUserUC.EXPECT().GetByID(gomock.Any(), mockUserID).Return(&domain.User{ID: mockUserID}, nil)
+		mockRepo.EXPECT().Block(gomock.Any(), mockExecutor.ID, mockUserID).Return(errors.New("repo error"))
+
+		err := restrictionUC.Block(context.Background(), mockUserID, mockExecutor)
+		assert.Error(t, err)
+	})
+}
+
+func TestRestrictionUseCase_Unblock(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockRestrictionRepository(ctrl)
+	mockUserUC := usecaseMock.NewMockRestrictionUserUseCase(ctrl)
+	restrictionUC := usecase.NewRestrictionUseCase(mockRepo, mockUserUC)
+
+	mockUserID := domain.ID(1)
+	mockExecutor := &domain.User{ID: 2}
+
+	t.Run("SuccessUnblock", func(t *testing.T) {
+		mockRepo.EXPECT().Unblock(gomock.Any(), mockExecutor.ID, mockUserID).Return(nil)
+
+		err := restrictionUC.Unblock(context.Background(), mockUserID, mockExecutor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo.EXPECT().Unblock(gomock.Any(), mockExecutor.ID, mockUserID).Return(errors.New("repo error"))
+
+		err := restrictionUC.Unblock(context.Background(), mockUserID, mockExecutor)
+		assert.Error(t, err)
+	})
+}
+
+func TestRestrictionUseCase_Mute(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockRestrictionRepository(ctrl)
+	mockUserUC := usecaseMock.NewMockRestrictionUserUseCase(ctrl)
+	restrictionUC := usecase.NewRestrictionUseCase(mockRepo, mockUserUC)
+
+	mockUserID := domain.ID(1)
+	mockExecutor := &domain.User{ID: 2}
+
+	t.Run("SuccessMute", func(t *testing.T) {
+		mockUserUC.EXPECT().GetByID(gomock.Any(), mockUserID).Return(&domain.User{ID: mockUserID}, nil)
+		mockRepo.EXPECT().Mute(gomock.Any(), mockExecutor.ID, mockUserID).Return(nil)
+
+		err := restrictionUC.Mute(context.Background(), mockUserID, mockExecutor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("MuteSelf", func(t *testing.T) {
+		mockRepo.EXPECT().Mute(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := restrictionUC.Mute(context.Background(), mockExecutor.ID, mockExecutor)
+		assert.Equal(t, usecase.ErrUnprocessable, err)
+	})
+
+	t.Run("UserUCError", func(t *testing.T) {
+		mockUserUC.EXPECT().GetByID(gomock.Any(), mockUserID).Return(nil, errors.New("repo error"))
+		mockRepo.EXPECT().Mute(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := restrictionUC.Mute(context.Background(), mockUserID, mockExecutor)
+		assert.Error(t, err)
+		assert.NotEqual(t, usecase.ErrIncorrectUserRef, err)
+	})
+}
+
+func TestRestrictionUseCase_Blocked(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockRestrictionRepository(ctrl)
+	restrictionUC := usecase.NewRestrictionUseCase(mockRepo, nil)
+
+	mockExecutor := &domain.User{ID: 2}
+	pagInput := &domain.PaginationInput{Page: 1, PerPage: 10}
+
+	t.Run("SuccessBlocked", func(t *testing.T) {
+		mockPag := &domain.Pagination[domain.RestrictedUser]{PaginationInput: *pagInput}
+		mockRepo.EXPECT().Blocked(gomock.Any(), mockExecutor.ID, pagInput).Return(mockPag, nil)
+
+		pag, err := restrictionUC.Blocked(context.Background(), pagInput, mockExecutor)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPag, pag)
+	})
+
+	t.Run("SuccessMuted", func(t *testing.T) {
+		mockPag := &domain.Pagination[domain.RestrictedUser]{PaginationInput: *pagInput}
+		mockRepo.EXPECT().Muted(gomock.Any(), mockExecutor.ID, pagInput).Return(mockPag, nil)
+
+		pag, err := restrictionUC.Muted(context.Background(), pagInput, mockExecutor)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPag, pag)
+	})
+}
@@ -10,6 +10,21 @@ import (
 type SubscriptionFollowingUseCase interface {
 	Follow(ctx context.Context, userID domain.ID, executor *domain.User) error
 	Unfollow(ctx context.Context, userID domain.ID, executor *domain.User) error
+	Followers(
+		ctx context.Context, userID domain.ID, input *domain.FollowListInput, executor *domain.User,
+	) (*domain.CursorPagination[domain.Follow], error)
+	Following(
+		ctx context.Context, userID domain.ID, input *domain.FollowListInput, executor *domain.User,
+	) (*domain.CursorPagination[domain.Follow], error)
+	FollowRequests(
+		ctx context.Context, pagInput *domain.PaginationInput, executor *domain.User,
+	) (*domain.Pagination[domain.FollowRequest], error)
+	ApproveRequest(ctx context.Context, requesterID domain.ID, executor *domain.User) error
+	DenyRequest(ctx context.Context, requesterID domain.ID, executor *domain.User) error
+}
+
+type FollowSuggester interface {
+	Suggest(ctx context.Context, userID domain.ID, limit int) ([]domain.FollowSuggestion, error)
 }
 
 type SubscriptionUserUseCase interface {
@@ -19,13 +34,15 @@ type SubscriptionUserUseCase interface {
 type subscriptionUseCase struct {
 	followingUC SubscriptionFollowingUseCase
 	userUC      SubscriptionUserUseCase
+	suggester   FollowSuggester
 }
 
 func NewSubscriptionUseCase(
 	followingUC SubscriptionFollowingUseCase,
 	userUC SubscriptionUserUseCase,
+	suggester FollowSuggester,
 ) *subscriptionUseCase {
-	return &subscriptionUseCase{followingUC: followingUC, userUC: userUC}
+	return &subscriptionUseCase{followingUC: followingUC, userUC: userUC, suggester: suggester}
 }
 
 func (uc *subscriptionUseCase) Follow(ctx context.Context, userID domain.ID, executor *domain.User) error {
@@ -44,6 +61,48 @@ func (uc *subscriptionUseCase) Unfollow(ctx context.Context, userID domain.ID, e
 	return uc.followingUC.Unfollow(ctx, userID, executor)
 }
 
+func (uc *subscriptionUseCase) Followers(
+	ctx context.Context, userID domain.ID, input *domain.FollowListInput, executor *domain.User,
+) (*domain.CursorPagination[domain.Follow], error) {
+	if err := uc.correctUserRef(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return uc.followingUC.Followers(ctx, userID, input, executor)
+}
+
+func (uc *subscriptionUseCase) Following(
+	ctx context.Context, userID domain.ID, input *domain.FollowListInput, executor *domain.User,
+) (*domain.CursorPagination[domain.Follow], error) {
+	if err := uc.correctUserRef(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return uc.followingUC.Following(ctx, userID, input, executor)
+}
+
+func (uc *subscriptionUseCase) FollowRequests(
+	ctx context.Context, pagInput *domain.PaginationInput, executor *domain.User,
+) (*domain.Pagination[domain.FollowRequest], error) {
+	return uc.followingUC.FollowRequests(ctx, pagInput, executor)
+}
+
+func (uc *subscriptionUseCase) ApproveRequest(
+	ctx context.Context, requesterID domain.ID, executor *domain.User,
+) error {
+	return uc.followingUC.ApproveRequest(ctx, requesterID, executor)
+}
+
+func (uc *subscriptionUseCase) DenyRequest(ctx context.Context, requesterID domain.ID, executor *domain.User) error {
+	return uc.followingUC.DenyRequest(ctx, requesterID, executor)
+}
+
+func (uc *subscriptionUseCase) Suggestions(
+	ctx context.Context, limit int, executor *domain.User,
+) ([]domain.FollowSuggestion, error) {
+	return uc.suggester.Suggest(ctx, executor.ID, limit)
+}
+
 func (uc *subscriptionUseCase) correctUserRef(ctx context.Context, userID domain.ID) error {
 	if _, err := uc.userUC.GetByID(ctx, userID); err != nil {
 		if errors.Is(err, ErrNotFound) {
@@ -20,7 +20,7 @@ func TestSubscriptionUseCase_Follow(t *testing.T) {
 
 	mockFollowingUC := usecaseMock.NewMockSubscriptionFollowingUseCase(ctrl)
 	mockUserUC := usecaseMock.NewMockSubscriptionUserUseCase(ctrl)
-	subscriptionUC := usecase.NewSubscriptionUseCase(mockFollowingUC, mockUserUC)
+	subscriptionUC := usecase.NewSubscriptionUseCase(mockFollowingUC, mockUserUC, nil)
 
 	mockUserID := domain.ID(1)
 	mockUser := &domain.User{ID: mockUserID}
@@ -73,7 +73,7 @@ func TestSubscriptionUseCase_Unfollow(t *testing.T) {
 
 	mockFollowingUC := usecaseMock.NewMockSubscriptionFollowingUseCase(ctrl)
 	mockUserUC := usecaseMock.NewMockSubscriptionUserUseCase(ctrl)
-	subscriptionUC := usecase.NewSubscriptionUseCase(mockFollowingUC, mockUserUC)
+	subscriptionUC := usecase.NewSubscriptionUseCase(mockFollowingUC, mockUserUC, nil)
 
 	mockUserID := domain.ID(1)
 	mockUser := &domain.User{ID: mockUserID}
@@ -117,3 +117,74 @@ func TestSubscriptionUseCase_Unfollow(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestSubscriptionUseCase_Followers(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFollowingUC := usecaseMock.NewMockSubscriptionFollowingUseCase(ctrl)
+	mockUserUC := usecaseMock.NewMockSubscriptionUserUseCase(ctrl)
+	subscriptionUC := usecase.NewSubscriptionUseCase(mockFollowingUC, mockUserUC, nil)
+
+	mockUserID := domain.ID(1)
+	mockUser := &domain.User{ID: mockUserID}
+	mockInput := &domain.FollowListInput{Limit: 10}
+
+	t.Run("SuccessFollowers", func(t *testing.T) {
+		mockPage := &domain.CursorPagination[domain.Follow]{}
+		mockUserUC.EXPECT().GetByID(gomock.Any(), mockUserID).Return(mockUser, nil)
+		mockFollowingUC.EXPECT().Followers(gomock.Any(), mockUserID, mockInput, nil).Return(mockPage, nil)
+
+		page, err := subscriptionUC.Followers(context.Background(), mockUserID, mockInput, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPage, page)
+	})
+
+	t.Run("ErrUserRef", func(t *testing.T) {
+		mockUserUC.EXPECT().GetByID(gomock.Any(), mockUserID).Return(nil, usecase.ErrNotFound)
+		mockFollowingUC.EXPECT().Followers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		page, err := subscriptionUC.Followers(context.Background(), mockUserID, mockInput, nil)
+		assert.Equal(t, usecase.ErrIncorrectUserRef, err)
+		assert.Nil(t, page)
+	})
+}
+
+func TestSubscriptionUseCase_Following(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFollowingUC := usecaseMock.NewMockSubscriptionFollowingUseCase(ctrl)
+	mockUserUC := usecaseMock.NewMockSubscriptionUserUseCase(ctrl)
+	subscriptionUC := usecase.NewSubscriptionUseCase(mockFollowingUC, mockUserUC, nil)
+
+	mockUserID := domain.ID(1)
+	mockUser := &domain.User{ID: mockUserID}
+	mockExecutor := &domain.User{ID: 2}
+	mockInput := &domain.FollowListInput{Limit: 10}
+
+	t.Run("SuccessFollowing", func(t *testing.T) {
+		mockPage := &domain.CursorPagination[domain.Follow]{}
+		mockUserUC.EXPECT().GetByID(gomock.Any(), mockUserID).Return(mockUser, nil)
+		mockFollowingUC.EXPECT().Following(gomock.Any(), mockUserID, mockInput, mockExecutor).Return(mockPage, nil)
+
+		page, err := subscriptionUC.Following(context.Background(), mockUserID, mockInput, mockExecutor)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPage, page)
+	})
+
+	t.Run("FollowingUCError", func(t *testing.T) {
+		mockUserUC.EXPECT().GetByID(gomock.Any(), mockUserID).Return(mockUser, nil)
+		mockFollowingUC.EXPECT().Following(
+			gomock.Any(), mockUserID, mockInput, mockExecutor,
+		).Return(nil, errors.New("repo error"))
+
+		page, err := subscriptionUC.Following(context.Background(), mockUserID, mockInput, mockExecutor)
+		assert.Error(t, err)
+		assert.Nil(t, page)
+	})
+}
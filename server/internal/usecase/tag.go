@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/pillowskiy/gopix/internal/domain"
 	"github.com/pillowskiy/gopix/internal/repository"
@@ -14,8 +16,32 @@ type TagRepository interface {
 	DeleteImageTag(ctx context.Context, imageID domain.ID, tagID domain.ID) error
 	GetByID(ctx context.Context, id domain.ID) (*domain.Tag, error)
 	GetByName(ctx context.Context, name string) (*domain.Tag, error)
-	Search(ctx context.Context, name string) ([]domain.Tag, error)
+	Search(ctx context.Context, name string, viewerID *domain.ID) ([]domain.Tag, error)
 	Delete(ctx context.Context, id domain.ID) error
+	SetParent(ctx context.Context, id domain.ID, parentID *domain.ID) error
+	GetAncestorIDs(ctx context.Context, id domain.ID) ([]domain.ID, error)
+	GetChildren(ctx context.Context, id domain.ID) ([]domain.Tag, error)
+	GetAliases(ctx context.Context, id domain.ID) ([]string, error)
+	AddAlias(ctx context.Context, id domain.ID, alias string) error
+	RemoveAlias(ctx context.Context, id domain.ID, alias string) error
+	GetImages(
+		ctx context.Context, id domain.ID, viewerID *domain.ID, pagInput *domain.PaginationInput,
+	) (*domain.Pagination[domain.ImageWithMeta], error)
+	GetProposals(
+		ctx context.Context, pagInput *domain.PaginationInput,
+	) (*domain.Pagination[domain.TagProposal], error)
+	Approve(ctx context.Context, id domain.ID) error
+	Reject(ctx context.Context, id domain.ID) error
+	Merge(ctx context.Context, id domain.ID, targetID domain.ID) error
+	Rename(ctx context.Context, id domain.ID, name string, keepAlias bool) error
+	CountImages(ctx context.Context, id domain.ID) (int, error)
+	GetUsage(
+		ctx context.Context,
+		since time.Time,
+		sort domain.TagUsageSortMethod,
+		pagInput *domain.PaginationInput,
+	) (*domain.Pagination[domain.TagUsage], error)
+	GetUsageHistory(ctx context.Context, id domain.ID, since time.Time) ([]domain.TagUsagePoint, error)
 }
 
 type TagImageUseCase interface {
@@ -24,16 +50,24 @@ type TagImageUseCase interface {
 
 type TagAccessPolicy interface {
 	CanModifyImageTags(user *domain.User, image *domain.Image) bool
+	CanModerateTags(user *domain.User) bool
+}
+
+type TagSuggester interface {
+	Suggest(ctx context.Context, imageID domain.ID) ([]domain.TagSuggestion, error)
 }
 
 type tagUseCase struct {
-	repo    TagRepository
-	imageUC TagImageUseCase
-	acl     TagAccessPolicy
+	repo      TagRepository
+	imageUC   TagImageUseCase
+	acl       TagAccessPolicy
+	suggester TagSuggester
 }
 
-func NewTagUseCase(repo TagRepository, acl TagAccessPolicy, imageUC TagImageUseCase) *tagUseCase {
-	return &tagUseCase{repo: repo, acl: acl, imageUC: imageUC}
+func NewTagUseCase(
+	repo TagRepository, acl TagAccessPolicy, imageUC TagImageUseCase, suggester TagSuggester,
+) *tagUseCase {
+	return &tagUseCase{repo: repo, acl: acl, imageUC: imageUC, suggester: suggester}
 }
 
 func (uc *tagUseCase) Create(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
@@ -57,6 +91,23 @@ func (uc *tagUseCase) UpsertImageTag(
 		return ErrForbidden
 	}
 
+	existingTag, err := uc.repo.GetByName(ctx, tag.Name)
+	switch {
+	case err == nil:
+		if !existingTag.CanBeAttachedTo(image) {
+			return ErrUnprocessable
+		}
+	case errors.Is(err, repository.ErrNotFound):
+		// Unknown tags become proposals unless they are added by a moderator
+		tag.Status = domain.TagStatusApproved
+		if !uc.acl.CanModerateTags(executor) {
+			tag.Status = domain.TagStatusPending
+			tag.ProposerID = &image.AuthorID
+		}
+	default:
+		return errors.Wrap(err, "tagUseCase.UpsertImageTag.GetByName")
+	}
+
 	return uc.repo.UpsertImageTags(ctx, tag, imageID)
 }
 
@@ -75,8 +126,23 @@ func (uc *tagUseCase) DeleteImageTag(
 	return uc.repo.DeleteImageTag(ctx, imageID, tagID)
 }
 
-func (uc *tagUseCase) Search(ctx context.Context, query string) ([]domain.Tag, error) {
-	return uc.repo.Search(ctx, query)
+func (uc *tagUseCase) Suggestions(
+	ctx context.Context, imageID domain.ID, executor *domain.User,
+) ([]domain.TagSuggestion, error) {
+	image, err := uc.imageUC.GetByID(ctx, imageID)
+	if err != nil {
+		return nil, ErrIncorrectImageRef
+	}
+
+	if !uc.acl.CanModifyImageTags(executor, image) {
+		return nil, ErrForbidden
+	}
+
+	return uc.suggester.Suggest(ctx, image.ID)
+}
+
+func (uc *tagUseCase) Search(ctx context.Context, query string, executor *domain.User) ([]domain.Tag, error) {
+	return uc.repo.Search(ctx, query, executorID(executor))
 }
 
 func (uc *tagUseCase) Delete(ctx context.Context, tagID domain.ID) error {
@@ -99,3 +165,231 @@ func (uc *tagUseCase) GetByID(ctx context.Context, tagID domain.ID) (*domain.Tag
 
 	return tag, nil
 }
+
+func (uc *tagUseCase) GetDetailed(ctx context.Context, tagID domain.ID) (*domain.DetailedTag, error) {
+	tag, err := uc.GetByID(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases, err := uc.repo.GetAliases(ctx, tag.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "tagUseCase.GetDetailed.GetAliases")
+	}
+
+	children, err := uc.repo.GetChildren(ctx, tag.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "tagUseCase.GetDetailed.GetChildren")
+	}
+
+	imagesCount, err := uc.repo.CountImages(ctx, tag.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "tagUseCase.GetDetailed.CountImages")
+	}
+
+	return &domain.DetailedTag{Tag: *tag, Aliases: aliases, Children: children, ImagesCount: imagesCount}, nil
+}
+
+// SetParent moves the tag under the parent, nil parent makes the tag a root one.
+// The parent cannot be the tag itself or any of its descendants
+func (uc *tagUseCase) SetParent(ctx context.Context, tagID domain.ID, parentID *domain.ID) error {
+	tag, err := uc.GetByID(ctx, tagID)
+	if err != nil {
+		return err
+	}
+
+	if parentID != nil {
+		if *parentID == tag.ID {
+			return ErrUnprocessable
+		}
+
+		if _, err := uc.GetByID(ctx, *parentID); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return ErrUnprocessable
+			}
+			return err
+		}
+
+		ancestorIDs, err := uc.repo.GetAncestorIDs(ctx, *parentID)
+		if err != nil {
+			return errors.Wrap(err, "tagUseCase.SetParent.GetAncestorIDs")
+		}
+
+		if slices.Contains(ancestorIDs, tag.ID) {
+			return ErrUnprocessable
+		}
+	}
+
+	if err := uc.repo.SetParent(ctx, tag.ID, parentID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return errors.Wrap(err, "tagUseCase.SetParent")
+	}
+
+	return nil
+}
+
+// AddAlias makes the alias resolve to the tag, the alias cannot collide with any tag name or alias
+func (uc *tagUseCase) AddAlias(ctx context.Context, tagID domain.ID, alias string) error {
+	tag, err := uc.GetByID(ctx, tagID)
+	if err != nil {
+		return err
+	}
+
+	existingTag, err := uc.repo.GetByName(ctx, alias)
+	if existingTag != nil || err == nil {
+		return ErrAlreadyExists
+	}
+
+	if !errors.Is(err, repository.ErrNotFound) {
+		return errors.Wrap(err, "tagUseCase.AddAlias.GetByName")
+	}
+
+	return uc.repo.AddAlias(ctx, tag.ID, alias)
+}
+
+func (uc *tagUseCase) RemoveAlias(ctx context.Context, tagID domain.ID, alias string) error {
+	if err := uc.repo.RemoveAlias(ctx, tagID, alias); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return errors.Wrap(err, "tagUseCase.RemoveAlias")
+	}
+
+	return nil
+}
+
+// GetImages returns the images tagged with the tag or any of its descendants visible to the executor
+func (uc *tagUseCase) GetImages(
+	ctx context.Context, tagID domain.ID, pagInput *domain.PaginationInput, executor *domain.User,
+) (*domain.Pagination[domain.ImageWithMeta], error) {
+	tag, err := uc.GetByID(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetImages(ctx, tag.ID, executorID(executor), pagInput)
+}
+
+func (uc *tagUseCase) GetProposals(
+	ctx context.Context, pagInput *domain.PaginationInput,
+) (*domain.Pagination[domain.TagProposal], error) {
+	return uc.repo.GetProposals(ctx, pagInput)
+}
+
+func (uc *tagUseCase) ApproveProposal(ctx context.Context, tagID domain.ID) error {
+	if err := uc.repo.Approve(ctx, tagID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return errors.Wrap(err, "tagUseCase.ApproveProposal")
+	}
+
+	return nil
+}
+
+func (uc *tagUseCase) RejectProposal(ctx context.Context, tagID domain.ID) error {
+	if err := uc.repo.Reject(ctx, tagID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return errors.Wrap(err, "tagUseCase.RejectProposal")
+	}
+
+	return nil
+}
+
+// Merge replaces the tag with the approved target one on every image, the tag name becomes an alias of the target.
+// Both approved and pending tags can be merged, but never into their own descendants
+func (uc *tagUseCase) Merge(ctx context.Context, tagID domain.ID, targetID domain.ID) error {
+	tag, err := uc.GetByID(ctx, tagID)
+	if err != nil {
+		return err
+	}
+
+	target, err := uc.GetByID(ctx, targetID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return ErrUnprocessable
+		}
+		return err
+	}
+
+	if target.ID == tag.ID || target.IsPending() {
+		return ErrUnprocessable
+	}
+
+	ancestorIDs, err := uc.repo.GetAncestorIDs(ctx, target.ID)
+	if err != nil {
+		return errors.Wrap(err, "tagUseCase.Merge.GetAncestorIDs")
+	}
+
+	if slices.Contains(ancestorIDs, tag.ID) {
+		return ErrUnprocessable
+	}
+
+	if err := uc.repo.Merge(ctx, tag.ID, target.ID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return errors.Wrap(err, "tagUseCase.Merge")
+	}
+
+	return nil
+}
+
+// Rename changes the tag name, the new name cannot be taken by another tag or its alias
+func (uc *tagUseCase) Rename(ctx context.Context, tagID domain.ID, name string, keepAlias bool) error {
+	tag, err := uc.GetByID(ctx, tagID)
+	if err != nil {
+		return err
+	}
+
+	existingTag, err := uc.repo.GetByName(ctx, name)
+	if err == nil && existingTag.ID != tag.ID {
+		return ErrAlreadyExists
+	}
+
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return errors.Wrap(err, "tagUseCase.Rename.GetByName")
+	}
+
+	if err := uc.repo.Rename(ctx, tag.ID, name, keepAlias); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return errors.Wrap(err, "tagUseCase.Rename")
+	}
+
+	return nil
+}
+
+// GetUsage lists the approved tags with their usage, recent counts cover the last days
+func (uc *tagUseCase) GetUsage(
+	ctx context.Context, days int, sort domain.TagUsageSortMethod, pagInput *domain.PaginationInput,
+) (*domain.Pagination[domain.TagUsage], error) {
+	since := time.Now().AddDate(0, 0, -days)
+
+	usage, err := uc.repo.GetUsage(ctx, since, sort, pagInput)
+	if err != nil {
+		if errors.Is(err, repository.ErrIncorrectInput) {
+			return nil, ErrUnprocessable
+		}
+		return nil, errors.Wrap(err, "tagUseCase.GetUsage")
+	}
+
+	return usage, nil
+}
+
+func (uc *tagUseCase) GetUsageHistory(
+	ctx context.Context, tagID domain.ID, days int,
+) ([]domain.TagUsagePoint, error) {
+	tag, err := uc.GetByID(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Now().AddDate(0, 0, -days)
+	return uc.repo.GetUsageHistory(ctx, tag.ID, since)
+}
@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/pkg/logger"
+	"github.com/pkg/errors"
+)
+
+const defaultTagSuggestionsLimit = 10
+
+type TagSuggestionRepository interface {
+	ImagesTags(ctx context.Context, imageIDs []domain.ID) (map[domain.ID][]string, error)
+	PublicImagesTags(ctx context.Context, imageIDs []domain.ID) (map[domain.ID][]string, error)
+	CooccurringTags(ctx context.Context, imageID domain.ID, limit int) ([]domain.TagSuggestion, error)
+	UpsertImageTags(ctx context.Context, tag *domain.Tag, imageID domain.ID) error
+}
+
+type TagSuggestionFeaturesUseCase interface {
+	Similar(ctx context.Context, imageID domain.ID) ([]domain.ID, error)
+}
+
+type tagSuggestionUseCase struct {
+	repo           TagSuggestionRepository
+	featuresUC     TagSuggestionFeaturesUseCase
+	limit          int
+	autoApplyScore float64
+	logger         logger.Logger
+}
+
+func NewTagSuggestionUseCase(
+	repo TagSuggestionRepository,
+	featuresUC TagSuggestionFeaturesUseCase,
+	limit int,
+	autoApplyScore float64,
+	logger logger.Logger,
+) *tagSuggestionUseCase {
+	if limit <= 0 {
+		limit = defaultTagSuggestionsLimit
+	}
+
+	return &tagSuggestionUseCase{
+		repo:           repo,
+		featuresUC:     featuresUC,
+		limit:          limit,
+		autoApplyScore: autoApplyScore,
+		logger:         logger,
+	}
+}
+
+// Suggest ranks the tags of the visually similar images and the tags co-occurring with the image tags.
+// Both scores are combined as independent evidences, so a tag backed by both sources ranks higher
+func (uc *tagSuggestionUseCase) Suggest(ctx context.Context, imageID domain.ID) ([]domain.TagSuggestion, error) {
+	imageTags, err := uc.repo.ImagesTags(ctx, []domain.ID{imageID})
+	if err != nil {
+		return nil, errors.Wrap(err, "TagSuggestionUseCase.Suggest.ImagesTags")
+	}
+
+	scores := make(map[string]*domain.TagSuggestion)
+	addScore := func(name string, score float64, source domain.TagSuggestionSource) {
+		if slices.Contains(imageTags[imageID], name) {
+			return
+		}
+
+		suggestion, ok := scores[name]
+		if !ok {
+			suggestion = &domain.TagSuggestion{Name: name}
+			scores[name] = suggestion
+		}
+		suggestion.Score = 1 - (1-suggestion.Score)*(1-score)
+		suggestion.Sources = append(suggestion.Sources, source)
+	}
+
+	similarScores, err := uc.similarScores(ctx, imageID)
+	if err != nil {
+		// The vectorization service is optional for suggestions, co-occurrence still works without it
+		uc.logger.Warnf("TagSuggestionUseCase.Suggest.similarScores: %v", err)
+	}
+	for name, score := range similarScores {
+		addScore(name, score, domain.TagSuggestionSimilar)
+	}
+
+	cooccurring, err := uc.repo.CooccurringTags(ctx, imageID, uc.limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "TagSuggestionUseCase.Suggest.CooccurringTags")
+	}
+	for _, suggestion := range cooccurring {
+		addScore(suggestion.Name, suggestion.Score, domain.TagSuggestionCooccurrence)
+	}
+
+	suggestions := make([]domain.TagSuggestion, 0, len(scores))
+	for _, suggestion := range scores {
+		suggestions = append(suggestions, *suggestion)
+	}
+
+	slices.SortFunc(suggestions, func(a, b domain.TagSuggestion) int {
+		if a.Score != b.Score {
+			if a.Score > b.Score {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	if len(suggestions) > uc.limit {
+		suggestions = suggestions[:uc.limit]
+	}
+
+	return suggestions, nil
+}
+
+// AutoApply tags the image with the suggestions scored at least the configured auto apply score
+// and returns the applied ones
+func (uc *tagSuggestionUseCase) AutoApply(ctx context.Context, imageID domain.ID) ([]domain.TagSuggestion, error) {
+	if uc.autoApplyScore <= 0 {
+		return nil, nil
+	}
+
+	suggestions, err := uc.Suggest(ctx, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make([]domain.TagSuggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		if suggestion.Score < uc.autoApplyScore {
+			break
+		}
+
+		tag := &domain.Tag{Name: suggestion.Name}
+		if err := uc.repo.UpsertImageTags(ctx, tag, imageID); err != nil {
+			return applied, errors.Wrap(err, "TagSuggestionUseCase.AutoApply.UpsertImageTags")
+		}
+		applied = append(applied, suggestion)
+	}
+
+	return applied, nil
+}
+
+// similarScores weights the tags of the similar images by the similarity rank,
+// the most similar image has the biggest weight
+func (uc *tagSuggestionUseCase) similarScores(ctx context.Context, imageID domain.ID) (map[string]float64, error) {
+	similarIDs, err := uc.featuresUC.Similar(ctx, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	similarIDs = slices.DeleteFunc(similarIDs, func(id domain.ID) bool { return id == imageID })
+	if len(similarIDs) == 0 {
+		return nil, nil
+	}
+
+	similarTags, err := uc.repo.PublicImagesTags(ctx, similarIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var totalWeight float64
+	scores := make(map[string]float64)
+	for rank, id := range similarIDs {
+		weight := 1 / float64(rank+1)
+		totalWeight += weight
+		for _, name := range similarTags[id] {
+			scores[name] += weight
+		}
+	}
+
+	for name := range scores {
+		scores[name] /= totalWeight
+	}
+
+	return scores, nil
+}
@@ -0,0 +1,123 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pillowskiy/gopix/internal/domain"
+	"github.com/pillowskiy/gopix/internal/usecase"
+	usecaseMock "github.com/pillowskiy/gopix/internal/usecase/mock"
+	loggerMock "github.com/pillowskiy/gopix/pkg/logger/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTagSuggestionUseCase_Suggest(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockTagSuggestionRepository(ctrl)
+	mockFeatUC := usecaseMock.NewMockTagSuggestionFeaturesUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+
+	suggestionUC := usecase.NewTagSuggestionUseCase(mockRepo, mockFeatUC, 3, 0, mockLog)
+
+	imageID := domain.ID(1)
+	imageTags := map[domain.ID][]string{imageID: {"animal"}}
+
+	t.Run("SuccessSuggest", func(t *testing.T) {
+		mockRepo.EXPECT().ImagesTags(gomock.Any(), []domain.ID{imageID}).Return(imageTags, nil)
+		mockFeatUC.EXPECT().Similar(gomock.Any(), imageID).Return([]domain.ID{imageID, 2, 3}, nil)
+		mockRepo.EXPECT().PublicImagesTags(gomock.Any(), []domain.ID{2, 3}).Return(map[domain.ID][]string{
+			2: {"animal", "cat", "cute"},
+			3: {"cat", "dog"},
+		}, nil)
+		mockRepo.EXPECT().CooccurringTags(gomock.Any(), imageID, 3).Return([]domain.TagSuggestion{
+			{Name: "cat", Score: 0.5},
+			{Name: "pet", Score: 0.2},
+		}, nil)
+
+		suggestions, err := suggestionUC.Suggest(context.Background(), imageID)
+		if !assert.NoError(t, err) || !assert.Len(t, suggestions, 3) {
+			return
+		}
+
+		assert.Equal(t, "cat", suggestions[0].Name)
+		assert.InDelta(t, 1, suggestions[0].Score, 0.001)
+		assert.Equal(t, []domain.TagSuggestionSource{
+			domain.TagSuggestionSimilar, domain.TagSuggestionCooccurrence,
+		}, suggestions[0].Sources)
+
+		assert.Equal(t, "cute", suggestions[1].Name)
+		assert.InDelta(t, 2.0/3.0, suggestions[1].Score, 0.001)
+		assert.Equal(t, "dog", suggestions[2].Name)
+	})
+
+	t.Run("SimilarError", func(t *testing.T) {
+		mockRepo.EXPECT().ImagesTags(gomock.Any(), []domain.ID{imageID}).Return(imageTags, nil)
+		mockFeatUC.EXPECT().Similar(gomock.Any(), imageID).Return(nil, errors.New("vec error"))
+		mockLog.EXPECT().Warnf(gomock.Any(), gomock.Any())
+		mockRepo.EXPECT().CooccurringTags(gomock.Any(), imageID, 3).Return([]domain.TagSuggestion{
+			{Name: "pet", Score: 0.2},
+		}, nil)
+
+		suggestions, err := suggestionUC.Suggest(context.Background(), imageID)
+		if assert.NoError(t, err) && assert.Len(t, suggestions, 1) {
+			assert.Equal(t, []domain.TagSuggestionSource{domain.TagSuggestionCooccurrence}, suggestions[0].Sources)
+		}
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo.EXPECT().ImagesTags(gomock.Any(), []domain.ID{imageID}).Return(nil, errors.New("repo error"))
+
+		suggestions, err := suggestionUC.Suggest(context.Background(), imageID)
+		assert.Error(t, err)
+		assert.Nil(t, suggestions)
+	})
+}
+
+func TestTagSuggestionUseCase_AutoApply(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := usecaseMock.NewMockTagSuggestionRepository(ctrl)
+	mockFeatUC := usecaseMock.NewMockTagSuggestionFeaturesUseCase(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+
+	imageID := domain.ID(1)
+
+	t.Run("SuccessAutoApply", func(t *testing.T) {
+		suggestionUC := usecase.NewTagSuggestionUseCase(mockRepo, mockFeatUC, 0, 0.8, mockLog)
+
+		mockRepo.EXPECT().ImagesTags(gomock.Any(), []domain.ID{imageID}).Return(map[domain.ID][]string{}, nil)
+		mockFeatUC.EXPECT().Similar(gomock.Any(), imageID).Return([]domain.ID{2}, nil)
+		mockRepo.EXPECT().PublicImagesTags(gomock.Any(), []domain.ID{2}).Return(map[domain.ID][]string{
+			2: {"cat"},
+		}, nil)
+		mockRepo.EXPECT().CooccurringTags(gomock.Any(), imageID, gomock.Any()).Return([]domain.TagSuggestion{
+			{Name: "pet", Score: 0.5},
+		}, nil)
+		mockRepo.EXPECT().UpsertImageTags(gomock.Any(), &domain.Tag{Name: "cat"}, imageID).Return(nil)
+
+		applied, err := suggestionUC.AutoApply(context.Background(), imageID)
+		if assert.NoError(t, err) && assert.Len(t, applied, 1) {
+			assert.Equal(t, "cat", applied[0].Name)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		suggestionUC := usecase.NewTagSuggestionUseCase(mockRepo, mockFeatUC, 0, 0, mockLog)
+
+		mockRepo.EXPECT().ImagesTags(gomock.Any(), gomock.Any()).Times(0)
+		mockRepo.EXPECT().UpsertImageTags(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		applied, err := suggestionUC.AutoApply(context.Background(), imageID)
+		assert.NoError(t, err)
+		assert.Empty(t, applied)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/pillowskiy/gopix/internal/domain"
 	"github.com/pillowskiy/gopix/internal/repository"
@@ -23,7 +24,7 @@ func TestTagUseCase_Create(t *testing.T) {
 	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
 	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
 
-	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC)
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
 
 	tagInput := &domain.Tag{Name: "test"}
 	mockTag := &domain.Tag{ID: 1, Name: "test"}
@@ -56,7 +57,7 @@ func TestTagUseCase_UpsertImageTag(t *testing.T) {
 	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
 	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
 
-	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC)
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
 
 	tagInput := &domain.Tag{Name: "test"}
 
@@ -69,11 +70,56 @@ func TestTagUseCase_UpsertImageTag(t *testing.T) {
 	t.Run("SuccessUpsertImageTag", func(t *testing.T) {
 		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
 		mockACL.EXPECT().CanModifyImageTags(mockUser, mockImage).Return(true)
+		mockRepo.EXPECT().GetByName(gomock.Any(), tagInput.Name).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanModerateTags(mockUser).Return(true)
 		mockRepo.EXPECT().UpsertImageTags(gomock.Any(), tagInput, imageID).Return(nil)
 
 		err := tagUC.UpsertImageTag(context.Background(), tagInput, imageID, mockUser)
 
 		assert.NoError(t, err)
+		assert.Equal(t, domain.TagStatusApproved, tagInput.Status)
+	})
+
+	t.Run("ProposesNewTag", func(t *testing.T) {
+		proposedTag := &domain.Tag{Name: "proposal"}
+		author := &domain.User{ID: userID}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanModifyImageTags(author, mockImage).Return(true)
+		mockRepo.EXPECT().GetByName(gomock.Any(), proposedTag.Name).Return(nil, repository.ErrNotFound)
+		mockACL.EXPECT().CanModerateTags(author).Return(false)
+		mockRepo.EXPECT().UpsertImageTags(gomock.Any(), proposedTag, imageID).Return(nil)
+
+		err := tagUC.UpsertImageTag(context.Background(), proposedTag, imageID, author)
+		if assert.NoError(t, err) {
+			assert.Equal(t, domain.TagStatusPending, proposedTag.Status)
+			assert.Equal(t, &userID, proposedTag.ProposerID)
+		}
+	})
+
+	t.Run("OwnPendingTag", func(t *testing.T) {
+		pendingTag := &domain.Tag{ID: 5, Name: "proposal", Status: domain.TagStatusPending, ProposerID: &userID}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanModifyImageTags(mockUser, mockImage).Return(true)
+		mockRepo.EXPECT().GetByName(gomock.Any(), pendingTag.Name).Return(pendingTag, nil)
+		mockRepo.EXPECT().UpsertImageTags(gomock.Any(), gomock.Any(), imageID).Return(nil)
+
+		err := tagUC.UpsertImageTag(context.Background(), &domain.Tag{Name: pendingTag.Name}, imageID, mockUser)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ForeignPendingTag", func(t *testing.T) {
+		proposerID := domain.ID(7)
+		pendingTag := &domain.Tag{ID: 5, Name: "proposal", Status: domain.TagStatusPending, ProposerID: &proposerID}
+
+		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
+		mockACL.EXPECT().CanModifyImageTags(mockUser, mockImage).Return(true)
+		mockRepo.EXPECT().GetByName(gomock.Any(), pendingTag.Name).Return(pendingTag, nil)
+		mockRepo.EXPECT().UpsertImageTags(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := tagUC.UpsertImageTag(context.Background(), &domain.Tag{Name: pendingTag.Name}, imageID, mockUser)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
 	})
 
 	t.Run("IncorrectImageRef", func(t *testing.T) {
@@ -101,6 +147,7 @@ func TestTagUseCase_UpsertImageTag(t *testing.T) {
 	t.Run("RepoError", func(t *testing.T) {
 		mockImageUC.EXPECT().GetByID(gomock.Any(), imageID).Return(mockImage, nil)
 		mockACL.EXPECT().CanModifyImageTags(mockUser, mockImage).Return(true)
+		mockRepo.EXPECT().GetByName(gomock.Any(), tagInput.Name).Return(&domain.Tag{ID: 1, Name: tagInput.Name}, nil)
 		mockRepo.EXPECT().UpsertImageTags(gomock.Any(), tagInput, imageID).Return(errors.New("repo error"))
 
 		err := tagUC.UpsertImageTag(context.Background(), tagInput, imageID, mockUser)
@@ -118,7 +165,7 @@ func TestTagUseCase_DeleteImageTag(t *testing.T) {
 	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
 	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
 
-	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC)
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
 
 	tagID := domain.ID(1)
 	imageID := domain.ID(2)
@@ -176,26 +223,27 @@ func TestTagUseCase_Search(t *testing.T) {
 	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
 	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
 
-	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC)
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
 
 	query := "test"
+	executor := &domain.User{ID: 3}
 	tags := []domain.Tag{
 		{ID: 1},
 		{ID: 2},
 	}
 
 	t.Run("SuccessSearch", func(t *testing.T) {
-		mockRepo.EXPECT().Search(gomock.Any(), query).Return(tags, nil)
+		mockRepo.EXPECT().Search(gomock.Any(), query, &executor.ID).Return(tags, nil)
 
-		sTags, err := tagUC.Search(context.Background(), query)
+		sTags, err := tagUC.Search(context.Background(), query, executor)
 		assert.NoError(t, err)
 		assert.Equal(t, tags, sTags)
 	})
 
 	t.Run("RepoError", func(t *testing.T) {
-		mockRepo.EXPECT().Search(gomock.Any(), query).Return(nil, errors.New("repo error"))
+		mockRepo.EXPECT().Search(gomock.Any(), query, &executor.ID).Return(nil, errors.New("repo error"))
 
-		sTags, err := tagUC.Search(context.Background(), query)
+		sTags, err := tagUC.Search(context.Background(), query, executor)
 		assert.Error(t, err)
 		assert.Nil(t, sTags)
 	})
@@ -211,7 +259,7 @@ func TestTagUseCase_Delete(t *testing.T) {
 	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
 	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
 
-	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC)
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
 
 	tagID := domain.ID(1)
 	tag := &domain.Tag{ID: tagID}
@@ -251,7 +299,7 @@ func TestTagUseCase_GetByID(t *testing.T) {
 	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
 	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
 
-	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC)
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
 
 	tagID := domain.ID(1)
 	tag := &domain.Tag{ID: tagID}
@@ -281,3 +329,485 @@ func TestTagUseCase_GetByID(t *testing.T) {
 		assert.Nil(t, tTag)
 	})
 }
+
+func TestTagUseCase_GetDetailed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockTagImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
+	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
+
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
+
+	mockTag := &domain.Tag{ID: 1, Name: "animal"}
+	children := []domain.Tag{{ID: 2, Name: "cat", ParentID: &mockTag.ID}}
+
+	t.Run("SuccessGetDetailed", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(mockTag, nil)
+		mockRepo.EXPECT().GetAliases(gomock.Any(), mockTag.ID).Return([]string{"animals"}, nil)
+		mockRepo.EXPECT().GetChildren(gomock.Any(), mockTag.ID).Return(children, nil)
+		mockRepo.EXPECT().CountImages(gomock.Any(), mockTag.ID).Return(12, nil)
+
+		tag, err := tagUC.GetDetailed(context.Background(), mockTag.ID)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"animals"}, tag.Aliases)
+			assert.Equal(t, children, tag.Children)
+			assert.Equal(t, 12, tag.ImagesCount)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(nil, repository.ErrNotFound)
+
+		tag, err := tagUC.GetDetailed(context.Background(), mockTag.ID)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+		assert.Nil(t, tag)
+	})
+}
+
+func TestTagUseCase_SetParent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockTagImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
+	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
+
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
+
+	mockTag := &domain.Tag{ID: 1, Name: "cat"}
+	mockParent := &domain.Tag{ID: 2, Name: "animal"}
+
+	t.Run("SuccessSetParent", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(mockTag, nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockParent.ID).Return(mockParent, nil)
+		mockRepo.EXPECT().GetAncestorIDs(gomock.Any(), mockParent.ID).Return([]domain.ID{3}, nil)
+		mockRepo.EXPECT().SetParent(gomock.Any(), mockTag.ID, &mockParent.ID).Return(nil)
+
+		assert.NoError(t, tagUC.SetParent(context.Background(), mockTag.ID, &mockParent.ID))
+	})
+
+	t.Run("SuccessDetach", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(mockTag, nil)
+		mockRepo.EXPECT().SetParent(gomock.Any(), mockTag.ID, nil).Return(nil)
+
+		assert.NoError(t, tagUC.SetParent(context.Background(), mockTag.ID, nil))
+	})
+
+	t.Run("SelfParent", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(mockTag, nil)
+		mockRepo.EXPECT().SetParent(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := tagUC.SetParent(context.Background(), mockTag.ID, &mockTag.ID)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(mockTag, nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockParent.ID).Return(mockParent, nil)
+		mockRepo.EXPECT().GetAncestorIDs(gomock.Any(), mockParent.ID).Return([]domain.ID{mockTag.ID}, nil)
+		mockRepo.EXPECT().SetParent(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := tagUC.SetParent(context.Background(), mockTag.ID, &mockParent.ID)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+	})
+
+	t.Run("ParentNotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(mockTag, nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockParent.ID).Return(nil, repository.ErrNotFound)
+
+		err := tagUC.SetParent(context.Background(), mockTag.ID, &mockParent.ID)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(nil, repository.ErrNotFound)
+
+		err := tagUC.SetParent(context.Background(), mockTag.ID, &mockParent.ID)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
+
+func TestTagUseCase_AddAlias(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockTagImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
+	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
+
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
+
+	mockTag := &domain.Tag{ID: 1, Name: "cat"}
+	alias := "kitty"
+
+	t.Run("SuccessAddAlias", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(mockTag, nil)
+		mockRepo.EXPECT().GetByName(gomock.Any(), alias).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().AddAlias(gomock.Any(), mockTag.ID, alias).Return(nil)
+
+		assert.NoError(t, tagUC.AddAlias(context.Background(), mockTag.ID, alias))
+	})
+
+	t.Run("AlreadyExists", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(mockTag, nil)
+		mockRepo.EXPECT().GetByName(gomock.Any(), alias).Return(&domain.Tag{ID: 2, Name: alias}, nil)
+		mockRepo.EXPECT().AddAlias(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := tagUC.AddAlias(context.Background(), mockTag.ID, alias)
+		assert.ErrorIs(t, err, usecase.ErrAlreadyExists)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(nil, repository.ErrNotFound)
+
+		err := tagUC.AddAlias(context.Background(), mockTag.ID, alias)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
+
+func TestTagUseCase_RemoveAlias(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockTagImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
+	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
+
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
+
+	tagID := domain.ID(1)
+	alias := "kitty"
+
+	t.Run("SuccessRemoveAlias", func(t *testing.T) {
+		mockRepo.EXPECT().RemoveAlias(gomock.Any(), tagID, alias).Return(nil)
+
+		assert.NoError(t, tagUC.RemoveAlias(context.Background(), tagID, alias))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().RemoveAlias(gomock.Any(), tagID, alias).Return(repository.ErrNotFound)
+
+		err := tagUC.RemoveAlias(context.Background(), tagID, alias)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
+
+func TestTagUseCase_GetImages(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockTagImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
+	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
+
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
+
+	mockTag := &domain.Tag{ID: 1, Name: "animal"}
+	pagInput := &domain.PaginationInput{Page: 1, PerPage: 10}
+	executor := &domain.User{ID: 2}
+	mockPag := &domain.Pagination[domain.ImageWithMeta]{PaginationInput: *pagInput}
+
+	t.Run("SuccessGetImages", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(mockTag, nil)
+		mockRepo.EXPECT().GetImages(gomock.Any(), mockTag.ID, &executor.ID, pagInput).Return(mockPag, nil)
+
+		images, err := tagUC.GetImages(context.Background(), mockTag.ID, pagInput, executor)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPag, images)
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(mockTag, nil)
+		mockRepo.EXPECT().GetImages(gomock.Any(), mockTag.ID, nil, pagInput).Return(mockPag, nil)
+
+		_, err := tagUC.GetImages(context.Background(), mockTag.ID, pagInput, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), mockTag.ID).Return(nil, repository.ErrNotFound)
+
+		images, err := tagUC.GetImages(context.Background(), mockTag.ID, pagInput, executor)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+		assert.Nil(t, images)
+	})
+}
+
+func TestTagUseCase_Suggestions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockTagImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
+	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
+	mockSuggester := usecaseMock.NewMockTagSuggester(ctrl)
+
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, mockSuggester)
+
+	executor := &domain.User{ID: 1}
+	mockImage := &domain.Image{ID: 2, AuthorID: executor.ID}
+	mockSuggestions := []domain.TagSuggestion{{Name: "cat", Score: 0.9}}
+
+	t.Run("SuccessSuggestions", func(t *testing.T) {
+		mockImageUC.EXPECT().GetByID(gomock.Any(), mockImage.ID).Return(mockImage, nil)
+		mockACL.EXPECT().CanModifyImageTags(executor, mockImage).Return(true)
+		mockSuggester.EXPECT().Suggest(gomock.Any(), mockImage.ID).Return(mockSuggestions, nil)
+
+		suggestions, err := tagUC.Suggestions(context.Background(), mockImage.ID, executor)
+		assert.NoError(t, err)
+		assert.Equal(t, mockSuggestions, suggestions)
+	})
+
+	t.Run("IncorrectImageRef", func(t *testing.T) {
+		mockImageUC.EXPECT().GetByID(gomock.Any(), mockImage.ID).Return(nil, usecase.ErrNotFound)
+
+		_, err := tagUC.Suggestions(context.Background(), mockImage.ID, executor)
+		assert.ErrorIs(t, err, usecase.ErrIncorrectImageRef)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockImageUC.EXPECT().GetByID(gomock.Any(), mockImage.ID).Return(mockImage, nil)
+		mockACL.EXPECT().CanModifyImageTags(executor, mockImage).Return(false)
+		mockSuggester.EXPECT().Suggest(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := tagUC.Suggestions(context.Background(), mockImage.ID, executor)
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+	})
+}
+
+func TestTagUseCase_Proposals(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockTagImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
+	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
+
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
+
+	tagID := domain.ID(1)
+
+	t.Run("SuccessApprove", func(t *testing.T) {
+		mockRepo.EXPECT().Approve(gomock.Any(), tagID).Return(nil)
+
+		assert.NoError(t, tagUC.ApproveProposal(context.Background(), tagID))
+	})
+
+	t.Run("ApproveNotPending", func(t *testing.T) {
+		mockRepo.EXPECT().Approve(gomock.Any(), tagID).Return(repository.ErrNotFound)
+
+		err := tagUC.ApproveProposal(context.Background(), tagID)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+
+	t.Run("SuccessReject", func(t *testing.T) {
+		mockRepo.EXPECT().Reject(gomock.Any(), tagID).Return(nil)
+
+		assert.NoError(t, tagUC.RejectProposal(context.Background(), tagID))
+	})
+}
+
+func TestTagUseCase_Merge(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockTagImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
+	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
+
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
+
+	tag := &domain.Tag{ID: 1, Name: "kitty", Status: domain.TagStatusPending}
+	target := &domain.Tag{ID: 2, Name: "cat", Status: domain.TagStatusApproved}
+
+	t.Run("SuccessMerge", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), tag.ID).Return(tag, nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), target.ID).Return(target, nil)
+		mockRepo.EXPECT().GetAncestorIDs(gomock.Any(), target.ID).Return([]domain.ID{3}, nil)
+		mockRepo.EXPECT().Merge(gomock.Any(), tag.ID, target.ID).Return(nil)
+
+		assert.NoError(t, tagUC.Merge(context.Background(), tag.ID, target.ID))
+	})
+
+	t.Run("MergeIntoPending", func(t *testing.T) {
+		pendingTarget := &domain.Tag{ID: 3, Name: "kitten", Status: domain.TagStatusPending}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), tag.ID).Return(tag, nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), pendingTarget.ID).Return(pendingTarget, nil)
+		mockRepo.EXPECT().Merge(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := tagUC.Merge(context.Background(), tag.ID, pendingTarget.ID)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+	})
+
+	t.Run("MergeIntoItself", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), target.ID).Return(target, nil).Times(2)
+		mockRepo.EXPECT().Merge(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := tagUC.Merge(context.Background(), target.ID, target.ID)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+	})
+
+	t.Run("MergeIntoDescendant", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), tag.ID).Return(tag, nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), target.ID).Return(target, nil)
+		mockRepo.EXPECT().GetAncestorIDs(gomock.Any(), target.ID).Return([]domain.ID{tag.ID}, nil)
+		mockRepo.EXPECT().Merge(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := tagUC.Merge(context.Background(), tag.ID, target.ID)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+	})
+
+	t.Run("TargetNotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), tag.ID).Return(tag, nil)
+		mockRepo.EXPECT().GetByID(gomock.Any(), target.ID).Return(nil, repository.ErrNotFound)
+
+		err := tagUC.Merge(context.Background(), tag.ID, target.ID)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), tag.ID).Return(nil, repository.ErrNotFound)
+
+		err := tagUC.Merge(context.Background(), tag.ID, target.ID)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
+
+func TestTagUseCase_Rename(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockTagImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
+	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
+
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
+
+	tag := &domain.Tag{ID: 1, Name: "kitty"}
+	name := "cat"
+
+	t.Run("SuccessRename", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), tag.ID).Return(tag, nil)
+		mockRepo.EXPECT().GetByName(gomock.Any(), name).Return(nil, repository.ErrNotFound)
+		mockRepo.EXPECT().Rename(gomock.Any(), tag.ID, name, true).Return(nil)
+
+		assert.NoError(t, tagUC.Rename(context.Background(), tag.ID, name, true))
+	})
+
+	t.Run("RenameToOwnAlias", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), tag.ID).Return(tag, nil)
+		mockRepo.EXPECT().GetByName(gomock.Any(), name).Return(tag, nil)
+		mockRepo.EXPECT().Rename(gomock.Any(), tag.ID, name, false).Return(nil)
+
+		assert.NoError(t, tagUC.Rename(context.Background(), tag.ID, name, false))
+	})
+
+	t.Run("AlreadyExists", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), tag.ID).Return(tag, nil)
+		mockRepo.EXPECT().GetByName(gomock.Any(), name).Return(&domain.Tag{ID: 2, Name: name}, nil)
+		mockRepo.EXPECT().Rename(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := tagUC.Rename(context.Background(), tag.ID, name, true)
+		assert.ErrorIs(t, err, usecase.ErrAlreadyExists)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), tag.ID).Return(nil, repository.ErrNotFound)
+
+		err := tagUC.Rename(context.Background(), tag.ID, name, true)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
+
+func TestTagUseCase_GetUsage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockTagImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
+	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
+
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
+
+	pagInput := &domain.PaginationInput{Page: 1, PerPage: 10}
+
+	t.Run("SuccessGetUsage", func(t *testing.T) {
+		mockPag := &domain.Pagination[domain.TagUsage]{PaginationInput: *pagInput}
+		mockRepo.EXPECT().
+			GetUsage(gomock.Any(), gomock.Any(), domain.TagUsageGrowthSort, pagInput).
+			DoAndReturn(func(
+				_ context.Context, since time.Time, _ domain.TagUsageSortMethod, _ *domain.PaginationInput,
+			) (*domain.Pagination[domain.TagUsage], error) {
+				assert.WithinDuration(t, time.Now().AddDate(0, 0, -7), since, time.Minute)
+				return mockPag, nil
+			})
+
+		usage, err := tagUC.GetUsage(context.Background(), 7, domain.TagUsageGrowthSort, pagInput)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPag, usage)
+	})
+
+	t.Run("IncorrectSort", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetUsage(gomock.Any(), gomock.Any(), domain.TagUsageSortMethod("unknown"), pagInput).
+			Return(nil, repository.ErrIncorrectInput)
+
+		_, err := tagUC.GetUsage(context.Background(), 7, "unknown", pagInput)
+		assert.ErrorIs(t, err, usecase.ErrUnprocessable)
+	})
+}
+
+func TestTagUseCase_GetUsageHistory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageUC := usecaseMock.NewMockTagImageUseCase(ctrl)
+	mockRepo := usecaseMock.NewMockTagRepository(ctrl)
+	mockACL := usecaseMock.NewMockTagAccessPolicy(ctrl)
+
+	tagUC := usecase.NewTagUseCase(mockRepo, mockACL, mockImageUC, nil)
+
+	tag := &domain.Tag{ID: 1, Name: "cat"}
+	points := []domain.TagUsagePoint{{Date: time.Now().Truncate(24 * time.Hour), Count: 3}}
+
+	t.Run("SuccessGetUsageHistory", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), tag.ID).Return(tag, nil)
+		mockRepo.EXPECT().GetUsageHistory(gomock.Any(), tag.ID, gomock.Any()).Return(points, nil)
+
+		history, err := tagUC.GetUsageHistory(context.Background(), tag.ID, 30)
+		assert.NoError(t, err)
+		assert.Equal(t, points, history)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), tag.ID).Return(nil, repository.ErrNotFound)
+
+		_, err := tagUC.GetUsageHistory(context.Background(), tag.ID, 30)
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
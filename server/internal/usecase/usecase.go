@@ -8,6 +8,7 @@ var (
 	ErrNotFound           = errors.New("entity not found")
 	ErrUnprocessable      = errors.New("unprocessable")
 	ErrForbidden          = errors.New("forbidden")
+	ErrUnavailable        = errors.New("temporarily unavailable")
 
 	ErrIncorrectImageRef = errors.New("incorrect image reference provided")
 	ErrIncorrectUserRef  = errors.New("incorrect user reference provided")
@@ -18,23 +18,39 @@ type UserRepository interface {
 	GetByID(ctx context.Context, id domain.ID) (*domain.User, error)
 	Update(ctx context.Context, id domain.ID, user *domain.User) (*domain.User, error)
 	SetPermissions(ctx context.Context, id domain.ID, permissions int) error
+	SetPrivate(ctx context.Context, id domain.ID, private bool) error
 }
 
 type UserFollowingUseCase interface {
 	Stats(ctx context.Context, userID domain.ID, executorID *domain.ID) (*domain.FollowingStats, error)
 }
 
+type UserRestrictions interface {
+	HasBlocked(ctx context.Context, blockerID, blockedID domain.ID) (bool, error)
+}
+
 type UserUseCase struct {
-	repo        UserRepository
-	cache       UserCache
-	followingUC UserFollowingUseCase
-	logger      logger.Logger
+	repo         UserRepository
+	cache        UserCache
+	followingUC  UserFollowingUseCase
+	restrictions UserRestrictions
+	logger       logger.Logger
 }
 
 func NewUserUseCase(
-	repo UserRepository, cache UserCache, followingUC UserFollowingUseCase, logger logger.Logger,
+	repo UserRepository,
+	cache UserCache,
+	followingUC UserFollowingUseCase,
+	restrictions UserRestrictions,
+	logger logger.Logger,
 ) *UserUseCase {
-	return &UserUseCase{repo: repo, cache: cache, followingUC: followingUC, logger: logger}
+	return &UserUseCase{
+		repo:         repo,
+		cache:        cache,
+		followingUC:  followingUC,
+		restrictions: restrictions,
+		logger:       logger,
+	}
 }
 
 func (uc *UserUseCase) GetDetailed(
@@ -49,6 +65,18 @@ func (uc *UserUseCase) GetDetailed(
 	}
 	user.HidePassword()
 
+	// The profile of the user who blocked the viewer looks like a missing one
+	if executorID != nil {
+		blocked, err := uc.restrictions.HasBlocked(ctx, user.ID, *executorID)
+		if err != nil {
+			return nil, errors.Wrap(err, "UserUseCase.GetDetailed.HasBlocked")
+		}
+
+		if blocked {
+			return nil, ErrNotFound
+		}
+	}
+
 	stats, err := uc.followingUC.Stats(ctx, user.ID, executorID)
 	if err != nil {
 		return nil, err
@@ -107,6 +135,20 @@ func (uc *UserUseCase) OverwritePermissions(
 	return nil
 }
 
+// SetPrivate switches the account privacy, making the account public approves all pending follow requests
+func (uc *UserUseCase) SetPrivate(ctx context.Context, id domain.ID, private bool) error {
+	if _, err := uc.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.repo.SetPrivate(ctx, id, private); err != nil {
+		return errors.Wrap(err, "UserUseCase.SetPrivate")
+	}
+
+	uc.deleteCachedUser(ctx, id)
+	return nil
+}
+
 func (uc *UserUseCase) GetByID(ctx context.Context, id domain.ID) (*domain.User, error) {
 	user, err := uc.repo.GetByID(ctx, id)
 	if err != nil {
@@ -24,9 +24,10 @@ func TestUseUseCase_GetDetailed(t *testing.T) {
 	mockUserRepo := usecaseMock.NewMockUserRepository(ctrl)
 	mockUserCache := usecaseMock.NewMockUserCache(ctrl)
 	mockFollowingUC := usecaseMock.NewMockUserFollowingUseCase(ctrl)
+	mockRestrictions := usecaseMock.NewMockUserRestrictions(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	userUC := usecase.NewUserUseCase(mockUserRepo, mockUserCache, mockFollowingUC, mockLog)
+	userUC := usecase.NewUserUseCase(mockUserRepo, mockUserCache, mockFollowingUC, mockRestrictions, mockLog)
 
 	username := "test"
 	uniqueInput := &domain.User{Username: username}
@@ -48,6 +49,7 @@ func TestUseUseCase_GetDetailed(t *testing.T) {
 
 	t.Run("SuccessGetDetailed", func(t *testing.T) {
 		mockUserRepo.EXPECT().GetUnique(gomock.Any(), uniqueInput).Return(mockUser, nil)
+		mockRestrictions.EXPECT().HasBlocked(gomock.Any(), mockUser.ID, *executorID).Return(false, nil)
 		mockFollowingUC.EXPECT().Stats(gomock.Any(), mockUser.ID, executorID).Return(stats, nil)
 
 		detailedUser, err := userUC.GetDetailed(context.Background(), username, executorID)
@@ -65,8 +67,29 @@ func TestUseUseCase_GetDetailed(t *testing.T) {
 		assert.Nil(t, detailedUser)
 	})
 
+	t.Run("SuccessGetDetailed_Anonymous", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetUnique(gomock.Any(), uniqueInput).Return(mockUser, nil)
+		mockRestrictions.EXPECT().HasBlocked(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockFollowingUC.EXPECT().Stats(gomock.Any(), mockUser.ID, nil).Return(stats, nil)
+
+		detailedUser, err := userUC.GetDetailed(context.Background(), username, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, mockDetailedUser, detailedUser)
+	})
+
+	t.Run("BlockedViewer", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetUnique(gomock.Any(), uniqueInput).Return(mockUser, nil)
+		mockRestrictions.EXPECT().HasBlocked(gomock.Any(), mockUser.ID, *executorID).Return(true, nil)
+		mockFollowingUC.EXPECT().Stats(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		detailedUser, err := userUC.GetDetailed(context.Background(), username, executorID)
+		assert.Equal(t, usecase.ErrNotFound, err)
+		assert.Nil(t, detailedUser)
+	})
+
 	t.Run("RepoError", func(t *testing.T) {
 		mockUserRepo.EXPECT().GetUnique(gomock.Any(), uniqueInput).Return(mockUser, nil)
+		mockRestrictions.EXPECT().HasBlocked(gomock.Any(), mockUser.ID, *executorID).Return(false, nil)
 		mockFollowingUC.EXPECT().Stats(gomock.Any(), mockUser.ID, executorID).Return(nil, errors.New("repo error"))
 
 		detailedUser, err := userUC.GetDetailed(context.Background(), username, executorID)
@@ -85,7 +108,7 @@ func TestUserUseCase_Update(t *testing.T) {
 	mockUserCache := usecaseMock.NewMockUserCache(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	userUC := usecase.NewUserUseCase(mockUserRepo, mockUserCache, nil, mockLog)
+	userUC := usecase.NewUserUseCase(mockUserRepo, mockUserCache, nil, nil, mockLog)
 
 	userID := domain.ID(1)
 	validUserInput := &domain.User{
@@ -162,7 +185,7 @@ func TestUserUseCase_OverwritePermissions(t *testing.T) {
 	mockUserCache := usecaseMock.NewMockUserCache(ctrl)
 	mockLog := loggerMock.NewMockLogger(ctrl)
 
-	userUC := usecase.NewUserUseCase(mockUserRepo, mockUserCache, nil, mockLog)
+	userUC := usecase.NewUserUseCase(mockUserRepo, mockUserCache, nil, nil, mockLog)
 
 	userID := domain.ID(1)
 	mockUser := &domain.User{
@@ -210,3 +233,45 @@ func TestUserUseCase_OverwritePermissions(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestUserUseCase_SetPrivate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := usecaseMock.NewMockUserRepository(ctrl)
+	mockUserCache := usecaseMock.NewMockUserCache(ctrl)
+	mockLog := loggerMock.NewMockLogger(ctrl)
+
+	userUC := usecase.NewUserUseCase(mockUserRepo, mockUserCache, nil, nil, mockLog)
+
+	userID := domain.ID(1)
+	mockUser := &domain.User{ID: userID, Username: "test"}
+
+	t.Run("SuccessSetPrivate", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetByID(gomock.Any(), userID).Return(mockUser, nil)
+		mockUserRepo.EXPECT().SetPrivate(gomock.Any(), userID, true).Return(nil)
+		mockUserCache.EXPECT().Del(gomock.Any(), userID.String()).Return(nil)
+
+		err := userUC.SetPrivate(context.Background(), userID, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetByID(gomock.Any(), userID).Return(nil, repository.ErrNotFound)
+		mockUserRepo.EXPECT().SetPrivate(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := userUC.SetPrivate(context.Background(), userID, true)
+		assert.Equal(t, usecase.ErrNotFound, err)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetByID(gomock.Any(), userID).Return(mockUser, nil)
+		mockUserRepo.EXPECT().SetPrivate(gomock.Any(), userID, false).Return(errors.New("repo error"))
+		mockUserCache.EXPECT().Del(gomock.Any(), gomock.Any()).Times(0)
+
+		err := userUC.SetPrivate(context.Background(), userID, false)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_images_search ON images USING GIN (
+    to_tsvector('simple', COALESCE(title, '') || ' ' || COALESCE(description, ''))
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_images_search;
+-- +goose StatementEnd
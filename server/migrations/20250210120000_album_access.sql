@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE albums ADD COLUMN access_level access_level NOT NULL DEFAULT 'public';
+ALTER TABLE albums ADD COLUMN share_token VARCHAR(64) UNIQUE;
+
+CREATE INDEX idx_albums_access_level ON albums(access_level);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_albums_access_level;
+
+ALTER TABLE albums DROP COLUMN share_token;
+ALTER TABLE albums DROP COLUMN access_level;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE album_role AS ENUM ('viewer', 'contributor', 'editor');
+
+CREATE TABLE IF NOT EXISTS "album_members" (
+    "album_id" BIGINT NOT NULL,
+    "user_id" BIGINT NOT NULL,
+    "role" album_role NOT NULL DEFAULT 'viewer',
+    "accepted" BOOLEAN NOT NULL DEFAULT FALSE,
+    "invited_by" BIGINT,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("album_id", "user_id"),
+    CONSTRAINT fk_album_members_album_id FOREIGN KEY ("album_id") REFERENCES "albums" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_album_members_user_id FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_album_members_invited_by FOREIGN KEY ("invited_by") REFERENCES "users" ("id") ON DELETE SET NULL ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_album_members_user_id ON album_members(user_id);
+
+ALTER TABLE images_to_albums ADD COLUMN added_by BIGINT;
+ALTER TABLE images_to_albums
+ADD CONSTRAINT fk_images_to_albums_added_by FOREIGN KEY ("added_by") REFERENCES "users" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE images_to_albums DROP CONSTRAINT IF EXISTS fk_images_to_albums_added_by;
+ALTER TABLE images_to_albums DROP COLUMN added_by;
+
+DROP INDEX IF EXISTS idx_album_members_user_id;
+DROP TABLE IF EXISTS "album_members";
+DROP TYPE IF EXISTS album_role;
+-- +goose StatementEnd
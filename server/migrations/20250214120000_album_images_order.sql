@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE images_to_albums ADD COLUMN position INT NOT NULL DEFAULT 0;
+ALTER TABLE images_to_albums ADD COLUMN added_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+UPDATE images_to_albums ia SET position = o.position
+FROM (
+    SELECT
+        album_id,
+        image_id,
+        ROW_NUMBER() OVER (PARTITION BY album_id ORDER BY image_id) - 1 AS position
+    FROM images_to_albums
+) AS o
+WHERE ia.album_id = o.album_id AND ia.image_id = o.image_id;
+
+CREATE INDEX IF NOT EXISTS idx_images_to_albums_position ON images_to_albums(album_id, position);
+
+ALTER TABLE albums ADD COLUMN cover_id BIGINT;
+ALTER TABLE albums
+ADD CONSTRAINT fk_albums_cover_id FOREIGN KEY ("cover_id") REFERENCES "images" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE albums DROP CONSTRAINT IF EXISTS fk_albums_cover_id;
+ALTER TABLE albums DROP COLUMN cover_id;
+
+DROP INDEX IF EXISTS idx_images_to_albums_position;
+ALTER TABLE images_to_albums DROP COLUMN added_at;
+ALTER TABLE images_to_albums DROP COLUMN position;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+DELETE FROM images_to_albums a
+USING images_to_albums b
+WHERE a.album_id = b.album_id AND a.image_id = b.image_id AND a.ctid > b.ctid;
+
+ALTER TABLE images_to_albums
+ADD CONSTRAINT pk_images_to_albums PRIMARY KEY ("album_id", "image_id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE images_to_albums DROP CONSTRAINT IF EXISTS pk_images_to_albums;
+-- +goose StatementEnd
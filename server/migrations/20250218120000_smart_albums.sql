@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE album_kind AS ENUM ('regular', 'smart');
+
+ALTER TABLE albums ADD COLUMN kind album_kind NOT NULL DEFAULT 'regular';
+
+CREATE TABLE IF NOT EXISTS "album_rules" (
+    "album_id" BIGINT PRIMARY KEY,
+    "rule" JSONB NOT NULL,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_album_rules_album_id FOREIGN KEY ("album_id") REFERENCES "albums" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_images_uploaded_at ON images(uploaded_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_images_uploaded_at;
+DROP TABLE IF EXISTS "album_rules";
+ALTER TABLE albums DROP COLUMN kind;
+DROP TYPE IF EXISTS album_kind;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "comments" ADD COLUMN IF NOT EXISTS "depth" INT NOT NULL DEFAULT 0;
+
+WITH RECURSIVE thread AS (
+    SELECT id, 0 AS depth FROM comments WHERE parent_id IS NULL
+    UNION ALL
+    SELECT c.id, t.depth + 1 FROM comments c JOIN thread t ON c.parent_id = t.id
+)
+UPDATE comments c SET depth = t.depth FROM thread t WHERE c.id = t.id AND t.depth > 0;
+
+CREATE INDEX IF NOT EXISTS idx_comments_image_id_parent_id ON comments(image_id, parent_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_comments_image_id_parent_id;
+ALTER TABLE "comments" DROP COLUMN IF EXISTS "depth";
+-- +goose StatementEnd
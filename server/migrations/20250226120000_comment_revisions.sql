@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "comments"
+    ADD COLUMN IF NOT EXISTS "edited_at" TIMESTAMP DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS "deleted_at" TIMESTAMP DEFAULT NULL;
+
+CREATE TABLE IF NOT EXISTS "comment_revisions" (
+    "id" BIGINT DEFAULT generate_snowflake_id() PRIMARY KEY,
+    "comment_id" BIGINT NOT NULL,
+    "comment" TEXT NOT NULL,
+    "edited_by" BIGINT DEFAULT NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_comment_revisions_comment_id FOREIGN KEY ("comment_id") REFERENCES "comments" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_comment_revisions_edited_by FOREIGN KEY ("edited_by") REFERENCES "users" ("id") ON DELETE SET NULL ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_comment_revisions_comment_id ON comment_revisions(comment_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "comment_revisions";
+ALTER TABLE "comments"
+    DROP COLUMN IF EXISTS "edited_at",
+    DROP COLUMN IF EXISTS "deleted_at";
+-- +goose StatementEnd
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "reactions" (
+    "target_type" VARCHAR(16) NOT NULL,
+    "target_id" BIGINT NOT NULL,
+    "user_id" BIGINT NOT NULL,
+    "emoji" VARCHAR(32) NOT NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("target_type", "target_id", "user_id", "emoji"),
+    CONSTRAINT fk_reactions_user_id FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_reactions_user_id ON reactions(user_id);
+
+-- The counters are updated in batches, so they may fall behind the reactions for a while
+CREATE TABLE IF NOT EXISTS "reaction_counts" (
+    "target_type" VARCHAR(16) NOT NULL,
+    "target_id" BIGINT NOT NULL,
+    "emoji" VARCHAR(32) NOT NULL,
+    "count" INT NOT NULL DEFAULT 0,
+    PRIMARY KEY ("target_type", "target_id", "emoji")
+);
+
+-- The reactions can't reference their targets, so they are cleaned up by the triggers
+CREATE OR REPLACE FUNCTION delete_target_reactions()
+RETURNS TRIGGER AS $$
+BEGIN
+  DELETE FROM reactions WHERE target_type = TG_ARGV[0] AND target_id = OLD.id;
+  DELETE FROM reaction_counts WHERE target_type = TG_ARGV[0] AND target_id = OLD.id;
+  RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_delete_image_reactions
+AFTER DELETE ON images
+FOR EACH ROW
+EXECUTE FUNCTION delete_target_reactions('image');
+
+CREATE TRIGGER trg_delete_comment_reactions
+AFTER DELETE ON comments
+FOR EACH ROW
+EXECUTE FUNCTION delete_target_reactions('comment');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_delete_comment_reactions ON comments;
+DROP TRIGGER IF EXISTS trg_delete_image_reactions ON images;
+DROP FUNCTION IF EXISTS delete_target_reactions();
+DROP TABLE IF EXISTS "reaction_counts";
+DROP TABLE IF EXISTS "reactions";
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "images"
+    ADD COLUMN IF NOT EXISTS "comments_locked" BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE "comments"
+    ADD COLUMN IF NOT EXISTS "pinned_at" TIMESTAMP DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS "hidden_at" TIMESTAMP DEFAULT NULL;
+
+-- Only a single comment can be pinned on the image
+CREATE UNIQUE INDEX IF NOT EXISTS idx_comments_pinned_image_id ON comments(image_id) WHERE pinned_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_comments_pinned_image_id;
+ALTER TABLE "comments"
+    DROP COLUMN IF EXISTS "pinned_at",
+    DROP COLUMN IF EXISTS "hidden_at";
+ALTER TABLE "images"
+    DROP COLUMN IF EXISTS "comments_locked";
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "tags"
+    ADD COLUMN IF NOT EXISTS "parent_id" BIGINT DEFAULT NULL;
+
+ALTER TABLE "tags"
+ADD CONSTRAINT fk_tags_parent_id FOREIGN KEY ("parent_id") REFERENCES "tags" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_tags_parent_id ON tags(parent_id);
+
+CREATE TABLE
+    IF NOT EXISTS "tag_aliases" (
+        "alias" varchar(50) PRIMARY KEY,
+        "tag_id" BIGINT NOT NULL,
+        "created_at" timestamp DEFAULT (current_timestamp)
+    );
+
+CREATE INDEX IF NOT EXISTS idx_tag_aliases_tag_id ON tag_aliases(tag_id);
+
+ALTER TABLE "tag_aliases"
+ADD CONSTRAINT fk_tag_aliases_tag_id FOREIGN KEY ("tag_id") REFERENCES "tags" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- Resolves the tag (or its alias) by name and returns the ids of the tag and all of its descendants
+CREATE OR REPLACE FUNCTION tag_subtree(tag_name TEXT)
+RETURNS TABLE (id BIGINT) AS $$
+  WITH RECURSIVE subtree AS (
+    SELECT t.id FROM tags t
+    WHERE t.name = tag_name
+      OR t.id = (SELECT ta.tag_id FROM tag_aliases ta WHERE ta.alias = tag_name)
+    UNION
+    SELECT c.id FROM tags c
+    JOIN subtree s ON c.parent_id = s.id
+  )
+  SELECT subtree.id FROM subtree;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS tag_subtree(TEXT);
+DROP TABLE IF EXISTS "tag_aliases";
+DROP INDEX IF EXISTS idx_tags_parent_id;
+ALTER TABLE "tags" DROP CONSTRAINT IF EXISTS fk_tags_parent_id;
+ALTER TABLE "tags" DROP COLUMN IF EXISTS "parent_id";
+-- +goose StatementEnd
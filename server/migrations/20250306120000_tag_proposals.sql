@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE tag_status AS ENUM ('approved', 'pending');
+
+ALTER TABLE "tags"
+    ADD COLUMN IF NOT EXISTS "status" tag_status NOT NULL DEFAULT 'approved',
+    ADD COLUMN IF NOT EXISTS "proposer_id" BIGINT DEFAULT NULL;
+
+ALTER TABLE "tags"
+ADD CONSTRAINT fk_tags_proposer_id FOREIGN KEY ("proposer_id") REFERENCES "users" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_tags_pending ON tags(created_at) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tags_pending;
+ALTER TABLE "tags" DROP CONSTRAINT IF EXISTS fk_tags_proposer_id;
+ALTER TABLE "tags"
+    DROP COLUMN IF EXISTS "status",
+    DROP COLUMN IF EXISTS "proposer_id";
+DROP TYPE IF EXISTS tag_status;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "images_to_tags"
+    ADD COLUMN IF NOT EXISTS "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+-- The relations had no timestamp before, the image upload time is the closest approximation
+UPDATE images_to_tags it SET created_at = i.uploaded_at
+FROM images i
+WHERE i.id = it.image_id;
+
+CREATE INDEX IF NOT EXISTS idx_images_to_tags_tag_id_created_at ON images_to_tags(tag_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_images_to_tags_tag_id_created_at;
+ALTER TABLE "images_to_tags" DROP COLUMN IF EXISTS "created_at";
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS "user_blocks" (
+        "blocker_id" BIGINT NOT NULL,
+        "blocked_id" BIGINT NOT NULL,
+        "created_at" timestamp DEFAULT (current_timestamp),
+
+        PRIMARY KEY ("blocker_id", "blocked_id"),
+        FOREIGN KEY ("blocker_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+        FOREIGN KEY ("blocked_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+    );
+
+CREATE INDEX IF NOT EXISTS idx_user_blocks_blocked_id ON user_blocks(blocked_id);
+
+CREATE TABLE
+    IF NOT EXISTS "user_mutes" (
+        "muter_id" BIGINT NOT NULL,
+        "muted_id" BIGINT NOT NULL,
+        "created_at" timestamp DEFAULT (current_timestamp),
+
+        PRIMARY KEY ("muter_id", "muted_id"),
+        FOREIGN KEY ("muter_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+        FOREIGN KEY ("muted_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+    );
+
+-- Returns the ids of the users blocked by the user and the users who blocked them
+CREATE OR REPLACE FUNCTION blocked_user_ids(user_id BIGINT)
+RETURNS TABLE (id BIGINT) AS $$
+  SELECT b.blocked_id FROM user_blocks b WHERE b.blocker_id = user_id
+  UNION
+  SELECT b.blocker_id FROM user_blocks b WHERE b.blocked_id = user_id;
+$$ LANGUAGE sql STABLE;
+
+-- Returns the ids of the authors whose content is hidden from the viewer, NULL viewer sees everyone
+CREATE OR REPLACE FUNCTION hidden_author_ids(viewer_id BIGINT)
+RETURNS TABLE (id BIGINT) AS $$
+  SELECT b.id FROM blocked_user_ids(viewer_id) b
+  UNION
+  SELECT m.muted_id FROM user_mutes m WHERE m.muter_id = viewer_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS hidden_author_ids(BIGINT);
+DROP FUNCTION IF EXISTS blocked_user_ids(BIGINT);
+DROP TABLE IF EXISTS "user_mutes";
+DROP INDEX IF EXISTS idx_user_blocks_blocked_id;
+DROP TABLE IF EXISTS "user_blocks";
+-- +goose StatementEnd
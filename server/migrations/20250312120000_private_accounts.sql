@@ -0,0 +1,65 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "users"
+    ADD COLUMN IF NOT EXISTS "private" BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE
+    IF NOT EXISTS "follow_requests" (
+        "requester_id" BIGINT NOT NULL,
+        "target_id" BIGINT NOT NULL,
+        "created_at" timestamp DEFAULT (current_timestamp),
+
+        PRIMARY KEY ("requester_id", "target_id"),
+        FOREIGN KEY ("requester_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+        FOREIGN KEY ("target_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+    );
+
+CREATE INDEX IF NOT EXISTS idx_follow_requests_target_id ON follow_requests(target_id);
+
+-- Returns the ids of the private users whose content the viewer can't see, NULL viewer can't see any of them
+CREATE OR REPLACE FUNCTION private_author_ids(viewer_id BIGINT)
+RETURNS TABLE (id BIGINT) AS $$
+  SELECT u.id FROM users u
+  WHERE u.private AND u.id IS DISTINCT FROM viewer_id
+    AND NOT EXISTS (
+      SELECT 1 FROM following f WHERE f.follower_id = viewer_id AND f.followed_id = u.id
+    );
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION hidden_author_ids(viewer_id BIGINT)
+RETURNS TABLE (id BIGINT) AS $$
+  SELECT b.id FROM blocked_user_ids(viewer_id) b
+  UNION
+  SELECT m.muted_id FROM user_mutes m WHERE m.muter_id = viewer_id
+  UNION
+  SELECT p.id FROM private_author_ids(viewer_id) p;
+$$ LANGUAGE sql STABLE;
+
+-- Reports whether the viewer can see the content of the author regardless of the mutes
+CREATE OR REPLACE FUNCTION can_view_author(author_id BIGINT, viewer_id BIGINT)
+RETURNS BOOLEAN AS $$
+  SELECT author_id IS NOT DISTINCT FROM viewer_id OR (
+    NOT EXISTS (SELECT 1 FROM blocked_user_ids(viewer_id) b WHERE b.id = author_id)
+    AND NOT EXISTS (SELECT 1 FROM users u WHERE u.id = author_id AND u.private)
+  ) OR EXISTS (
+    SELECT 1 FROM following f WHERE f.follower_id = viewer_id AND f.followed_id = author_id
+  );
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS can_view_author(BIGINT, BIGINT);
+
+CREATE OR REPLACE FUNCTION hidden_author_ids(viewer_id BIGINT)
+RETURNS TABLE (id BIGINT) AS $$
+  SELECT b.id FROM blocked_user_ids(viewer_id) b
+  UNION
+  SELECT m.muted_id FROM user_mutes m WHERE m.muter_id = viewer_id;
+$$ LANGUAGE sql STABLE;
+
+DROP FUNCTION IF EXISTS private_author_ids(BIGINT);
+DROP INDEX IF EXISTS idx_follow_requests_target_id;
+DROP TABLE IF EXISTS "follow_requests";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "private";
+-- +goose StatementEnd
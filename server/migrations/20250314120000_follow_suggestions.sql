@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Returns the ids of the users that mustn't be suggested to follow:
+-- the user itself, the followed, requested and blocked ones
+CREATE OR REPLACE FUNCTION unsuggestable_user_ids(user_id BIGINT)
+RETURNS TABLE (id BIGINT) AS $$
+  SELECT user_id
+  UNION
+  SELECT f.followed_id FROM following f WHERE f.follower_id = user_id
+  UNION
+  SELECT r.target_id FROM follow_requests r WHERE r.requester_id = user_id
+  UNION
+  SELECT b.id FROM blocked_user_ids(user_id) b;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS unsuggestable_user_ids(BIGINT);
+-- +goose StatementEnd
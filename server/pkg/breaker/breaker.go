@@ -0,0 +1,124 @@
+package breaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrOpen = errors.New("circuit breaker is open")
+
+type State int
+
+const (
+	StateClosed State = iota
+	StateOpen
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// CircuitBreaker opens after threshold consecutive failures
+// and lets a single trial call through once the cooldown has passed
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	onChange  func(from State, to State)
+
+	mut      sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// Creates new circuit breaker, onChange is optional and called under the lock
+func New(threshold int, cooldown time.Duration, onChange func(from State, to State)) *CircuitBreaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	return &CircuitBreaker{threshold: threshold, cooldown: cooldown, onChange: onChange}
+}
+
+// Allow reports whether the call may proceed,
+// every allowed call must be finished with Success or Failure
+func (b *CircuitBreaker) Allow() error {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if time.Since(b.openedAt) < b.cooldown {
+			return ErrOpen
+		}
+		b.setState(StateHalfOpen)
+		b.trial = true
+		return nil
+	case StateHalfOpen:
+		if b.trial {
+			return ErrOpen
+		}
+		b.trial = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+func (b *CircuitBreaker) Success() {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	b.failures = 0
+	b.trial = false
+	b.setState(StateClosed)
+}
+
+func (b *CircuitBreaker) Failure() {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	b.trial = false
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.threshold {
+		b.openedAt = time.Now()
+		b.setState(StateOpen)
+	}
+}
+
+// Release finishes the allowed call without affecting the breaker state
+func (b *CircuitBreaker) Release() {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	b.trial = false
+}
+
+func (b *CircuitBreaker) State() State {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	return b.state
+}
+
+func (b *CircuitBreaker) setState(state State) {
+	if b.state == state {
+		return
+	}
+
+	prev := b.state
+	b.state = state
+	if b.onChange != nil {
+		b.onChange(prev, state)
+	}
+}
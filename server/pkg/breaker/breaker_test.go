@@ -0,0 +1,79 @@
+package breaker_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pillowskiy/gopix/pkg/breaker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	cooldown := 20 * time.Millisecond
+
+	t.Run("Transitions", func(t *testing.T) {
+		var changes []breaker.State
+		b := breaker.New(2, cooldown, func(_, to breaker.State) {
+			changes = append(changes, to)
+		})
+
+		assert.NoError(t, b.Allow())
+		b.Failure()
+		assert.Equal(t, breaker.StateClosed, b.State())
+
+		assert.NoError(t, b.Allow())
+		b.Failure()
+		assert.Equal(t, breaker.StateOpen, b.State())
+		assert.ErrorIs(t, b.Allow(), breaker.ErrOpen)
+
+		time.Sleep(cooldown)
+		assert.NoError(t, b.Allow())
+		assert.Equal(t, breaker.StateHalfOpen, b.State())
+		// Only a single trial call is let through
+		assert.ErrorIs(t, b.Allow(), breaker.ErrOpen)
+
+		b.Success()
+		assert.Equal(t, breaker.StateClosed, b.State())
+		assert.NoError(t, b.Allow())
+
+		assert.Equal(t, []breaker.State{
+			breaker.StateOpen, breaker.StateHalfOpen, breaker.StateClosed,
+		}, changes)
+	})
+
+	t.Run("FailedTrial", func(t *testing.T) {
+		b := breaker.New(1, cooldown, nil)
+
+		b.Failure()
+		time.Sleep(cooldown)
+		assert.NoError(t, b.Allow())
+
+		b.Failure()
+		assert.Equal(t, breaker.StateOpen, b.State())
+		assert.ErrorIs(t, b.Allow(), breaker.ErrOpen)
+	})
+
+	t.Run("SuccessResetsFailures", func(t *testing.T) {
+		b := breaker.New(2, cooldown, nil)
+
+		b.Failure()
+		b.Success()
+		b.Failure()
+		assert.Equal(t, breaker.StateClosed, b.State())
+	})
+
+	t.Run("ReleasedTrial", func(t *testing.T) {
+		b := breaker.New(1, cooldown, nil)
+
+		b.Failure()
+		time.Sleep(cooldown)
+		assert.NoError(t, b.Allow())
+
+		// The canceled trial doesn't close the breaker, but lets the next trial through
+		b.Release()
+		assert.Equal(t, breaker.StateHalfOpen, b.State())
+		assert.NoError(t, b.Allow())
+	})
+}
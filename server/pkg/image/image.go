@@ -34,6 +34,9 @@ var imagesMimeTypeExt = map[string]string{
 	"video/x-ms-wmv":   "wmv",
 }
 
+// ErrUnsupportedMime is returned when the file is not of a known image or video type
+var ErrUnsupportedMime = errors.New("unsupported mime provided")
+
 type ImageInfo struct {
 	Width  int
 	Height int
@@ -57,7 +60,7 @@ func GenerateUniqueFilename(ext string) string {
 func GetExtByMime(mime string) (string, error) {
 	ext, ok := imagesMimeTypeExt[mime]
 	if !ok {
-		return "", errors.New("unsupported mime provided")
+		return "", ErrUnsupportedMime
 	}
 	return ext, nil
 }
@@ -0,0 +1,53 @@
+package metric
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ClientMetrics are reported by clients of the external services
+type ClientMetrics struct {
+	Duration     *prometheus.HistogramVec
+	Failures     *prometheus.CounterVec
+	Retries      *prometheus.CounterVec
+	BreakerState prometheus.Gauge
+}
+
+// Creates client metrics prefixed with the name,
+// the same metrics are returned when the client is constructed more than once
+func NewClientMetrics(name string) *ClientMetrics {
+	return &ClientMetrics{
+		Duration: registerCollector(prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    name + "_client_request_duration_seconds",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"call", "outcome"},
+		)),
+		Failures: registerCollector(prometheus.NewCounterVec(
+			prometheus.CounterOpts{Name: name + "_client_failures_total"},
+			[]string{"call", "reason"},
+		)),
+		Retries: registerCollector(prometheus.NewCounterVec(
+			prometheus.CounterOpts{Name: name + "_client_retries_total"},
+			[]string{"call"},
+		)),
+		BreakerState: registerCollector(prometheus.NewGauge(
+			prometheus.GaugeOpts{Name: name + "_client_breaker_state"},
+		)),
+	}
+}
+
+func registerCollector[T prometheus.Collector](c T) T {
+	if err := prometheus.Register(c); err != nil {
+		var regErr prometheus.AlreadyRegisteredError
+		if errors.As(err, &regErr) {
+			if existing, ok := regErr.ExistingCollector.(T); ok {
+				return existing
+			}
+		}
+	}
+
+	return c
+}
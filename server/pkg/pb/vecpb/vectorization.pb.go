@@ -0,0 +1,863 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: vectorization/v1/vectorization.proto
+
+package vecpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ImageMetadata struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// Replace overwrites the stored vector instead of failing with ALREADY_EXISTS,
+	// the old vector is kept when the new one can't be computed.
+	Replace       bool `protobuf:"varint,5,opt,name=replace,proto3" json:"replace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageMetadata) Reset() {
+	*x = ImageMetadata{}
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageMetadata) ProtoMessage() {}
+
+func (x *ImageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageMetadata.ProtoReflect.Descriptor instead.
+func (*ImageMetadata) Descriptor() ([]byte, []int) {
+	return file_vectorization_v1_vectorization_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ImageMetadata) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImageMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImageMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ImageMetadata) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ImageMetadata) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type FeaturesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*FeaturesRequest_Metadata
+	//	*FeaturesRequest_Chunk
+	Payload       isFeaturesRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeaturesRequest) Reset() {
+	*x = FeaturesRequest{}
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeaturesRequest) ProtoMessage() {}
+
+func (x *FeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeaturesRequest.ProtoReflect.Descriptor instead.
+func (*FeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_vectorization_v1_vectorization_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeaturesRequest) GetPayload() isFeaturesRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *FeaturesRequest) GetMetadata() *ImageMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*FeaturesRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *FeaturesRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*FeaturesRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isFeaturesRequest_Payload interface {
+	isFeaturesRequest_Payload()
+}
+
+type FeaturesRequest_Metadata struct {
+	Metadata *ImageMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type FeaturesRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*FeaturesRequest_Metadata) isFeaturesRequest_Payload() {}
+
+func (*FeaturesRequest_Chunk) isFeaturesRequest_Payload() {}
+
+type FeaturesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeaturesResponse) Reset() {
+	*x = FeaturesResponse{}
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeaturesResponse) ProtoMessage() {}
+
+func (x *FeaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeaturesResponse.ProtoReflect.Descriptor instead.
+func (*FeaturesResponse) Descriptor() ([]byte, []int) {
+	return file_vectorization_v1_vectorization_proto_rawDescGZIP(), []int{2}
+}
+
+type FeaturesResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Empty when the features were stored successfully
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeaturesResult) Reset() {
+	*x = FeaturesResult{}
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeaturesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeaturesResult) ProtoMessage() {}
+
+func (x *FeaturesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeaturesResult.ProtoReflect.Descriptor instead.
+func (*FeaturesResult) Descriptor() ([]byte, []int) {
+	return file_vectorization_v1_vectorization_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeaturesResult) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FeaturesResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchFeaturesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*FeaturesResult      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchFeaturesResponse) Reset() {
+	*x = BatchFeaturesResponse{}
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchFeaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchFeaturesResponse) ProtoMessage() {}
+
+func (x *BatchFeaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchFeaturesResponse.ProtoReflect.Descriptor instead.
+func (*BatchFeaturesResponse) Descriptor() ([]byte, []int) {
+	return file_vectorization_v1_vectorization_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchFeaturesResponse) GetResults() []*FeaturesResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type DeleteFeaturesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeaturesRequest) Reset() {
+	*x = DeleteFeaturesRequest{}
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeaturesRequest) ProtoMessage() {}
+
+func (x *DeleteFeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeaturesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_vectorization_v1_vectorization_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteFeaturesRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteFeaturesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeaturesResponse) Reset() {
+	*x = DeleteFeaturesResponse{}
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeaturesResponse) ProtoMessage() {}
+
+func (x *DeleteFeaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeaturesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFeaturesResponse) Descriptor() ([]byte, []int) {
+	return file_vectorization_v1_vectorization_proto_rawDescGZIP(), []int{6}
+}
+
+type SimilarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarRequest) Reset() {
+	*x = SimilarRequest{}
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarRequest) ProtoMessage() {}
+
+func (x *SimilarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarRequest.ProtoReflect.Descriptor instead.
+func (*SimilarRequest) Descriptor() ([]byte, []int) {
+	return file_vectorization_v1_vectorization_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SimilarRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SimilarRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         uint32                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOptions) Reset() {
+	*x = SearchOptions{}
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOptions) ProtoMessage() {}
+
+func (x *SearchOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOptions.ProtoReflect.Descriptor instead.
+func (*SearchOptions) Descriptor() ([]byte, []int) {
+	return file_vectorization_v1_vectorization_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchOptions) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchOptions) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type SearchByImageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*SearchByImageRequest_Options
+	//	*SearchByImageRequest_Chunk
+	Payload       isSearchByImageRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchByImageRequest) Reset() {
+	*x = SearchByImageRequest{}
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchByImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchByImageRequest) ProtoMessage() {}
+
+func (x *SearchByImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchByImageRequest.ProtoReflect.Descriptor instead.
+func (*SearchByImageRequest) Descriptor() ([]byte, []int) {
+	return file_vectorization_v1_vectorization_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchByImageRequest) GetPayload() isSearchByImageRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SearchByImageRequest) GetOptions() *SearchOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*SearchByImageRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *SearchByImageRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*SearchByImageRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isSearchByImageRequest_Payload interface {
+	isSearchByImageRequest_Payload()
+}
+
+type SearchByImageRequest_Options struct {
+	Options *SearchOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type SearchByImageRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*SearchByImageRequest_Options) isSearchByImageRequest_Payload() {}
+
+func (*SearchByImageRequest_Chunk) isSearchByImageRequest_Payload() {}
+
+type SearchByTextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchByTextRequest) Reset() {
+	*x = SearchByTextRequest{}
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchByTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchByTextRequest) ProtoMessage() {}
+
+func (x *SearchByTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchByTextRequest.ProtoReflect.Descriptor instead.
+func (*SearchByTextRequest) Descriptor() ([]byte, []int) {
+	return file_vectorization_v1_vectorization_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchByTextRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchByTextRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Neighbor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Distance      float32                `protobuf:"fixed32,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Neighbor) Reset() {
+	*x = Neighbor{}
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Neighbor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Neighbor) ProtoMessage() {}
+
+func (x *Neighbor) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Neighbor.ProtoReflect.Descriptor instead.
+func (*Neighbor) Descriptor() ([]byte, []int) {
+	return file_vectorization_v1_vectorization_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Neighbor) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Neighbor) GetDistance() float32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Neighbors     []*Neighbor            `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorization_v1_vectorization_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_vectorization_v1_vectorization_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchResponse) GetNeighbors() []*Neighbor {
+	if x != nil {
+		return x.Neighbors
+	}
+	return nil
+}
+
+var File_vectorization_v1_vectorization_proto protoreflect.FileDescriptor
+
+const file_vectorization_v1_vectorization_proto_rawDesc = "" +
+	"\n" +
+	"$vectorization/v1/vectorization.proto\x12\x16gopix.vectorization.v1\"\x84\x01\n" +
+	"\rImageMetadata\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x18\n" +
+	"\areplace\x18\x05 \x01(\bR\areplace\"y\n" +
+	"\x0fFeaturesRequest\x12C\n" +
+	"\bmetadata\x18\x01 \x01(\v2%.gopix.vectorization.v1.ImageMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\x12\n" +
+	"\x10FeaturesResponse\"6\n" +
+	"\x0eFeaturesResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"Y\n" +
+	"\x15BatchFeaturesResponse\x12@\n" +
+	"\aresults\x18\x01 \x03(\v2&.gopix.vectorization.v1.FeaturesResultR\aresults\"'\n" +
+	"\x15DeleteFeaturesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x18\n" +
+	"\x16DeleteFeaturesResponse\"6\n" +
+	"\x0eSimilarRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"H\n" +
+	"\rSearchOptions\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\rR\x05limit\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"|\n" +
+	"\x14SearchByImageRequest\x12A\n" +
+	"\aoptions\x18\x01 \x01(\v2%.gopix.vectorization.v1.SearchOptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"A\n" +
+	"\x13SearchByTextRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"6\n" +
+	"\bNeighbor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x02R\bdistance\"P\n" +
+	"\x0eSearchResponse\x12>\n" +
+	"\tneighbors\x18\x01 \x03(\v2 .gopix.vectorization.v1.NeighborR\tneighbors2\xfc\x04\n" +
+	"\x14VectorizationService\x12_\n" +
+	"\bFeatures\x12'.gopix.vectorization.v1.FeaturesRequest\x1a(.gopix.vectorization.v1.FeaturesResponse(\x01\x12i\n" +
+	"\rBatchFeatures\x12'.gopix.vectorization.v1.FeaturesRequest\x1a-.gopix.vectorization.v1.BatchFeaturesResponse(\x01\x12o\n" +
+	"\x0eDeleteFeatures\x12-.gopix.vectorization.v1.DeleteFeaturesRequest\x1a..gopix.vectorization.v1.DeleteFeaturesResponse\x12Y\n" +
+	"\aSimilar\x12&.gopix.vectorization.v1.SimilarRequest\x1a&.gopix.vectorization.v1.SearchResponse\x12g\n" +
+	"\rSearchByImage\x12,.gopix.vectorization.v1.SearchByImageRequest\x1a&.gopix.vectorization.v1.SearchResponse(\x01\x12c\n" +
+	"\fSearchByText\x12+.gopix.vectorization.v1.SearchByTextRequest\x1a&.gopix.vectorization.v1.SearchResponseB0Z.github.com/pillowskiy/gopix/pkg/pb/vecpb;vecpbb\x06proto3"
+
+var (
+	file_vectorization_v1_vectorization_proto_rawDescOnce sync.Once
+	file_vectorization_v1_vectorization_proto_rawDescData []byte
+)
+
+func file_vectorization_v1_vectorization_proto_rawDescGZIP() []byte {
+	file_vectorization_v1_vectorization_proto_rawDescOnce.Do(func() {
+		file_vectorization_v1_vectorization_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_vectorization_v1_vectorization_proto_rawDesc), len(file_vectorization_v1_vectorization_proto_rawDesc)))
+	})
+	return file_vectorization_v1_vectorization_proto_rawDescData
+}
+
+var file_vectorization_v1_vectorization_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_vectorization_v1_vectorization_proto_goTypes = []any{
+	(*ImageMetadata)(nil),          // 0: gopix.vectorization.v1.ImageMetadata
+	(*FeaturesRequest)(nil),        // 1: gopix.vectorization.v1.FeaturesRequest
+	(*FeaturesResponse)(nil),       // 2: gopix.vectorization.v1.FeaturesResponse
+	(*FeaturesResult)(nil),         // 3: gopix.vectorization.v1.FeaturesResult
+	(*BatchFeaturesResponse)(nil),  // 4: gopix.vectorization.v1.BatchFeaturesResponse
+	(*DeleteFeaturesRequest)(nil),  // 5: gopix.vectorization.v1.DeleteFeaturesRequest
+	(*DeleteFeaturesResponse)(nil), // 6: gopix.vectorization.v1.DeleteFeaturesResponse
+	(*SimilarRequest)(nil),         // 7: gopix.vectorization.v1.SimilarRequest
+	(*SearchOptions)(nil),          // 8: gopix.vectorization.v1.SearchOptions
+	(*SearchByImageRequest)(nil),   // 9: gopix.vectorization.v1.SearchByImageRequest
+	(*SearchByTextRequest)(nil),    // 10: gopix.vectorization.v1.SearchByTextRequest
+	(*Neighbor)(nil),               // 11: gopix.vectorization.v1.Neighbor
+	(*SearchResponse)(nil),         // 12: gopix.vectorization.v1.SearchResponse
+}
+var file_vectorization_v1_vectorization_proto_depIdxs = []int32{
+	0,  // 0: gopix.vectorization.v1.FeaturesRequest.metadata:type_name -> gopix.vectorization.v1.ImageMetadata
+	3,  // 1: gopix.vectorization.v1.BatchFeaturesResponse.results:type_name -> gopix.vectorization.v1.FeaturesResult
+	8,  // 2: gopix.vectorization.v1.SearchByImageRequest.options:type_name -> gopix.vectorization.v1.SearchOptions
+	11, // 3: gopix.vectorization.v1.SearchResponse.neighbors:type_name -> gopix.vectorization.v1.Neighbor
+	1,  // 4: gopix.vectorization.v1.VectorizationService.Features:input_type -> gopix.vectorization.v1.FeaturesRequest
+	1,  // 5: gopix.vectorization.v1.VectorizationService.BatchFeatures:input_type -> gopix.vectorization.v1.FeaturesRequest
+	5,  // 6: gopix.vectorization.v1.VectorizationService.DeleteFeatures:input_type -> gopix.vectorization.v1.DeleteFeaturesRequest
+	7,  // 7: gopix.vectorization.v1.VectorizationService.Similar:input_type -> gopix.vectorization.v1.SimilarRequest
+	9,  // 8: gopix.vectorization.v1.VectorizationService.SearchByImage:input_type -> gopix.vectorization.v1.SearchByImageRequest
+	10, // 9: gopix.vectorization.v1.VectorizationService.SearchByText:input_type -> gopix.vectorization.v1.SearchByTextRequest
+	2,  // 10: gopix.vectorization.v1.VectorizationService.Features:output_type -> gopix.vectorization.v1.FeaturesResponse
+	4,  // 11: gopix.vectorization.v1.VectorizationService.BatchFeatures:output_type -> gopix.vectorization.v1.BatchFeaturesResponse
+	6,  // 12: gopix.vectorization.v1.VectorizationService.DeleteFeatures:output_type -> gopix.vectorization.v1.DeleteFeaturesResponse
+	12, // 13: gopix.vectorization.v1.VectorizationService.Similar:output_type -> gopix.vectorization.v1.SearchResponse
+	12, // 14: gopix.vectorization.v1.VectorizationService.SearchByImage:output_type -> gopix.vectorization.v1.SearchResponse
+	12, // 15: gopix.vectorization.v1.VectorizationService.SearchByText:output_type -> gopix.vectorization.v1.SearchResponse
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_vectorization_v1_vectorization_proto_init() }
+func file_vectorization_v1_vectorization_proto_init() {
+	if File_vectorization_v1_vectorization_proto != nil {
+		return
+	}
+	file_vectorization_v1_vectorization_proto_msgTypes[1].OneofWrappers = []any{
+		(*FeaturesRequest_Metadata)(nil),
+		(*FeaturesRequest_Chunk)(nil),
+	}
+	file_vectorization_v1_vectorization_proto_msgTypes[9].OneofWrappers = []any{
+		(*SearchByImageRequest_Options)(nil),
+		(*SearchByImageRequest_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_vectorization_v1_vectorization_proto_rawDesc), len(file_vectorization_v1_vectorization_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_vectorization_v1_vectorization_proto_goTypes,
+		DependencyIndexes: file_vectorization_v1_vectorization_proto_depIdxs,
+		MessageInfos:      file_vectorization_v1_vectorization_proto_msgTypes,
+	}.Build()
+	File_vectorization_v1_vectorization_proto = out.File
+	file_vectorization_v1_vectorization_proto_goTypes = nil
+	file_vectorization_v1_vectorization_proto_depIdxs = nil
+}
@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: vectorization/v1/vectorization.proto
+
+package vecpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	VectorizationService_Features_FullMethodName       = "/gopix.vectorization.v1.VectorizationService/Features"
+	VectorizationService_BatchFeatures_FullMethodName  = "/gopix.vectorization.v1.VectorizationService/BatchFeatures"
+	VectorizationService_DeleteFeatures_FullMethodName = "/gopix.vectorization.v1.VectorizationService/DeleteFeatures"
+	VectorizationService_Similar_FullMethodName        = "/gopix.vectorization.v1.VectorizationService/Similar"
+	VectorizationService_SearchByImage_FullMethodName  = "/gopix.vectorization.v1.VectorizationService/SearchByImage"
+	VectorizationService_SearchByText_FullMethodName   = "/gopix.vectorization.v1.VectorizationService/SearchByText"
+)
+
+// VectorizationServiceClient is the client API for VectorizationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VectorizationServiceClient interface {
+	Features(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[FeaturesRequest, FeaturesResponse], error)
+	BatchFeatures(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[FeaturesRequest, BatchFeaturesResponse], error)
+	DeleteFeatures(ctx context.Context, in *DeleteFeaturesRequest, opts ...grpc.CallOption) (*DeleteFeaturesResponse, error)
+	Similar(ctx context.Context, in *SimilarRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	SearchByImage(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SearchByImageRequest, SearchResponse], error)
+	SearchByText(ctx context.Context, in *SearchByTextRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+}
+
+type vectorizationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVectorizationServiceClient(cc grpc.ClientConnInterface) VectorizationServiceClient {
+	return &vectorizationServiceClient{cc}
+}
+
+func (c *vectorizationServiceClient) Features(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[FeaturesRequest, FeaturesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VectorizationService_ServiceDesc.Streams[0], VectorizationService_Features_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[FeaturesRequest, FeaturesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VectorizationService_FeaturesClient = grpc.ClientStreamingClient[FeaturesRequest, FeaturesResponse]
+
+func (c *vectorizationServiceClient) BatchFeatures(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[FeaturesRequest, BatchFeaturesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VectorizationService_ServiceDesc.Streams[1], VectorizationService_BatchFeatures_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[FeaturesRequest, BatchFeaturesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VectorizationService_BatchFeaturesClient = grpc.ClientStreamingClient[FeaturesRequest, BatchFeaturesResponse]
+
+func (c *vectorizationServiceClient) DeleteFeatures(ctx context.Context, in *DeleteFeaturesRequest, opts ...grpc.CallOption) (*DeleteFeaturesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFeaturesResponse)
+	err := c.cc.Invoke(ctx, VectorizationService_DeleteFeatures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vectorizationServiceClient) Similar(ctx context.Context, in *SimilarRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, VectorizationService_Similar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vectorizationServiceClient) SearchByImage(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SearchByImageRequest, SearchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VectorizationService_ServiceDesc.Streams[2], VectorizationService_SearchByImage_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchByImageRequest, SearchResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VectorizationService_SearchByImageClient = grpc.ClientStreamingClient[SearchByImageRequest, SearchResponse]
+
+func (c *vectorizationServiceClient) SearchByText(ctx context.Context, in *SearchByTextRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, VectorizationService_SearchByText_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VectorizationServiceServer is the server API for VectorizationService service.
+// All implementations must embed UnimplementedVectorizationServiceServer
+// for forward compatibility.
+type VectorizationServiceServer interface {
+	Features(grpc.ClientStreamingServer[FeaturesRequest, FeaturesResponse]) error
+	BatchFeatures(grpc.ClientStreamingServer[FeaturesRequest, BatchFeaturesResponse]) error
+	DeleteFeatures(context.Context, *DeleteFeaturesRequest) (*DeleteFeaturesResponse, error)
+	Similar(context.Context, *SimilarRequest) (*SearchResponse, error)
+	SearchByImage(grpc.ClientStreamingServer[SearchByImageRequest, SearchResponse]) error
+	SearchByText(context.Context, *SearchByTextRequest) (*SearchResponse, error)
+	mustEmbedUnimplementedVectorizationServiceServer()
+}
+
+// UnimplementedVectorizationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVectorizationServiceServer struct{}
+
+func (UnimplementedVectorizationServiceServer) Features(grpc.ClientStreamingServer[FeaturesRequest, FeaturesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Features not implemented")
+}
+func (UnimplementedVectorizationServiceServer) BatchFeatures(grpc.ClientStreamingServer[FeaturesRequest, BatchFeaturesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method BatchFeatures not implemented")
+}
+func (UnimplementedVectorizationServiceServer) DeleteFeatures(context.Context, *DeleteFeaturesRequest) (*DeleteFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeatures not implemented")
+}
+func (UnimplementedVectorizationServiceServer) Similar(context.Context, *SimilarRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Similar not implemented")
+}
+func (UnimplementedVectorizationServiceServer) SearchByImage(grpc.ClientStreamingServer[SearchByImageRequest, SearchResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchByImage not implemented")
+}
+func (UnimplementedVectorizationServiceServer) SearchByText(context.Context, *SearchByTextRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchByText not implemented")
+}
+func (UnimplementedVectorizationServiceServer) mustEmbedUnimplementedVectorizationServiceServer() {}
+func (UnimplementedVectorizationServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeVectorizationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VectorizationServiceServer will
+// result in compilation errors.
+type UnsafeVectorizationServiceServer interface {
+	mustEmbedUnimplementedVectorizationServiceServer()
+}
+
+func RegisterVectorizationServiceServer(s grpc.ServiceRegistrar, srv VectorizationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedVectorizationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VectorizationService_ServiceDesc, srv)
+}
+
+func _VectorizationService_Features_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VectorizationServiceServer).Features(&grpc.GenericServerStream[FeaturesRequest, FeaturesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VectorizationService_FeaturesServer = grpc.ClientStreamingServer[FeaturesRequest, FeaturesResponse]
+
+func _VectorizationService_BatchFeatures_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VectorizationServiceServer).BatchFeatures(&grpc.GenericServerStream[FeaturesRequest, BatchFeaturesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VectorizationService_BatchFeaturesServer = grpc.ClientStreamingServer[FeaturesRequest, BatchFeaturesResponse]
+
+func _VectorizationService_DeleteFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorizationServiceServer).DeleteFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VectorizationService_DeleteFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorizationServiceServer).DeleteFeatures(ctx, req.(*DeleteFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VectorizationService_Similar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimilarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorizationServiceServer).Similar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VectorizationService_Similar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorizationServiceServer).Similar(ctx, req.(*SimilarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VectorizationService_SearchByImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VectorizationServiceServer).SearchByImage(&grpc.GenericServerStream[SearchByImageRequest, SearchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VectorizationService_SearchByImageServer = grpc.ClientStreamingServer[SearchByImageRequest, SearchResponse]
+
+func _VectorizationService_SearchByText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchByTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorizationServiceServer).SearchByText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VectorizationService_SearchByText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorizationServiceServer).SearchByText(ctx, req.(*SearchByTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VectorizationService_ServiceDesc is the grpc.ServiceDesc for VectorizationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VectorizationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gopix.vectorization.v1.VectorizationService",
+	HandlerType: (*VectorizationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteFeatures",
+			Handler:    _VectorizationService_DeleteFeatures_Handler,
+		},
+		{
+			MethodName: "Similar",
+			Handler:    _VectorizationService_Similar_Handler,
+		},
+		{
+			MethodName: "SearchByText",
+			Handler:    _VectorizationService_SearchByText_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Features",
+			Handler:       _VectorizationService_Features_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BatchFeatures",
+			Handler:       _VectorizationService_BatchFeatures_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SearchByImage",
+			Handler:       _VectorizationService_SearchByImage_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "vectorization/v1/vectorization.proto",
+}
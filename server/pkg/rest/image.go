@@ -35,7 +35,9 @@ func checkImageContentType(file *multipart.FileHeader) error {
 
 	isImage := strings.HasPrefix(contentType, "image")
 	isVideo := strings.HasPrefix(contentType, "video")
-	if !isImage && !isVideo {
+	// SVG may embed scripts, so it is never accepted as an image
+	isSVG := strings.HasPrefix(contentType, "image/svg")
+	if (!isImage && !isVideo) || isSVG {
 		return errNotAllowedImageExt
 	}
 
@@ -0,0 +1,170 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/pillowskiy/gopix/pkg/breaker"
+	"github.com/pillowskiy/gopix/pkg/metric"
+)
+
+type Config struct {
+	MaxRetries       int
+	Backoff          time.Duration
+	MaxConcurrency   int
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+	// Name is used as a prefix for the client metrics
+	Name string
+}
+
+// Policy classifies the errors of a transport
+type Policy struct {
+	// Retryable reports whether the failed idempotent call may be repeated
+	Retryable func(err error) bool
+	// Rejected reports whether the healthy service just didn't like the request,
+	// such errors don't count towards the circuit breaker
+	Rejected func(err error) bool
+	// Reason is the metrics label of the failure
+	Reason func(err error) string
+}
+
+type Call struct {
+	// Name is a short name of the operation used in metrics
+	Name       string
+	Timeout    time.Duration
+	Idempotent bool
+}
+
+// Executor wraps calls to an external service with per-call timeouts, retries of idempotent calls,
+// circuit breaker and bounded concurrency, reporting everything to prometheus
+type Executor struct {
+	cfg     Config
+	policy  Policy
+	breaker *breaker.CircuitBreaker
+	sem     chan struct{}
+	metrics *metric.ClientMetrics
+}
+
+func New(cfg Config, policy Policy) *Executor {
+	e := &Executor{
+		cfg:     cfg,
+		policy:  policy,
+		sem:     make(chan struct{}, max(cfg.MaxConcurrency, 1)),
+		metrics: metric.NewClientMetrics(cfg.Name),
+	}
+
+	e.breaker = breaker.New(cfg.BreakerThreshold, cfg.BreakerCooldown, func(_, to breaker.State) {
+		e.metrics.BreakerState.Set(float64(to))
+	})
+
+	return e
+}
+
+// Do runs fn with the call timeout applied to its context
+func (e *Executor) Do(ctx context.Context, call *Call, fn func(ctx context.Context) error) error {
+	attempts := 1
+	if call.Idempotent {
+		attempts += e.cfg.MaxRetries
+	}
+
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			if err := e.wait(ctx, attempt); err != nil {
+				return err
+			}
+			e.metrics.Retries.WithLabelValues(call.Name).Inc()
+		}
+
+		err = e.attempt(ctx, call, fn)
+		if err == nil || !e.shouldRetry(ctx, err) {
+			return err
+		}
+	}
+
+	return err
+}
+
+func (e *Executor) BreakerState() breaker.State {
+	return e.breaker.State()
+}
+
+func (e *Executor) attempt(ctx context.Context, call *Call, fn func(ctx context.Context) error) error {
+	select {
+	case e.sem <- struct{}{}:
+		defer func() { <-e.sem }()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if err := e.breaker.Allow(); err != nil {
+		e.metrics.Failures.WithLabelValues(call.Name, "breaker_open").Inc()
+		return err
+	}
+
+	callCtx := ctx
+	if call.Timeout > 0 {
+		var cancel context.CancelFunc
+		callCtx, cancel = context.WithTimeout(ctx, call.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	err := fn(callCtx)
+	e.metrics.Duration.WithLabelValues(call.Name, outcome(err)).Observe(time.Since(start).Seconds())
+
+	switch {
+	case err == nil:
+		e.breaker.Success()
+	case ctx.Err() != nil:
+		// The caller gave up, it says nothing about the service health
+		e.breaker.Release()
+		e.metrics.Failures.WithLabelValues(call.Name, "canceled").Inc()
+	case e.policy.Rejected(err):
+		e.breaker.Success()
+		e.metrics.Failures.WithLabelValues(call.Name, "status").Inc()
+	default:
+		e.breaker.Failure()
+		e.metrics.Failures.WithLabelValues(call.Name, e.policy.Reason(err)).Inc()
+	}
+
+	return err
+}
+
+func (e *Executor) shouldRetry(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, breaker.ErrOpen) {
+		return false
+	}
+
+	return e.policy.Retryable(err)
+}
+
+// wait sleeps with exponential backoff and full jitter before the next attempt
+func (e *Executor) wait(ctx context.Context, attempt int) error {
+	delay := time.Duration(rand.Int63n(int64(Backoff(e.cfg.Backoff, attempt)) + 1))
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Backoff is the longest delay before the attempt, it doubles with every retry
+func Backoff(base time.Duration, attempt int) time.Duration {
+	return base << (attempt - 1)
+}
+
+func outcome(err error) string {
+	if err == nil {
+		return "success"
+	}
+	return "error"
+}
@@ -0,0 +1,88 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pillowskiy/gopix/pkg/breaker"
+	"github.com/pillowskiy/gopix/pkg/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+var errUnavailable = errors.New("unavailable")
+
+func newTestExecutor(cfg retry.Config) *retry.Executor {
+	cfg.Name = "test_retry"
+	if cfg.BreakerThreshold == 0 {
+		cfg.BreakerThreshold = 100
+	}
+
+	return retry.New(cfg, retry.Policy{
+		Retryable: func(err error) bool { return errors.Is(err, errUnavailable) },
+		Rejected:  func(err error) bool { return !errors.Is(err, errUnavailable) },
+		Reason:    func(error) string { return "network" },
+	})
+}
+
+func TestExecutor_Do(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("RetryIdempotent", func(t *testing.T) {
+		exec := newTestExecutor(retry.Config{MaxRetries: 2, Backoff: time.Millisecond})
+
+		var calls int
+		err := exec.Do(ctx, &retry.Call{Name: "test", Idempotent: true}, func(context.Context) error {
+			calls++
+			return errUnavailable
+		})
+		assert.ErrorIs(t, err, errUnavailable)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("NoRetryNotIdempotent", func(t *testing.T) {
+		exec := newTestExecutor(retry.Config{MaxRetries: 2, Backoff: time.Millisecond})
+
+		var calls int
+		err := exec.Do(ctx, &retry.Call{Name: "test"}, func(context.Context) error {
+			calls++
+			return errUnavailable
+		})
+		assert.ErrorIs(t, err, errUnavailable)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("RejectedKeepsBreakerClosed", func(t *testing.T) {
+		exec := newTestExecutor(retry.Config{MaxRetries: 2, BreakerThreshold: 1})
+		rejectedErr := errors.New("bad input")
+
+		var calls int
+		err := exec.Do(ctx, &retry.Call{Name: "test", Idempotent: true}, func(context.Context) error {
+			calls++
+			return rejectedErr
+		})
+		assert.ErrorIs(t, err, rejectedErr)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, breaker.StateClosed, exec.BreakerState())
+	})
+
+	t.Run("CallTimeout", func(t *testing.T) {
+		exec := newTestExecutor(retry.Config{})
+
+		err := exec.Do(ctx, &retry.Call{Name: "test", Timeout: 10 * time.Millisecond}, func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 10*time.Millisecond, retry.Backoff(10*time.Millisecond, 1))
+	assert.Equal(t, 40*time.Millisecond, retry.Backoff(10*time.Millisecond, 3))
+}
@@ -20,3 +20,13 @@ func (w *Worker[T]) Handle(tf func(t T)) {
 func (w *Worker[T]) AddTask(task T) {
 	w.taskChan <- task
 }
+
+// TryAddTask doesn't block when the buffer is full and reports whether the task was queued
+func (w *Worker[T]) TryAddTask(task T) bool {
+	select {
+	case w.taskChan <- task:
+		return true
+	default:
+		return false
+	}
+}
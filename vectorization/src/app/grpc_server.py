@@ -0,0 +1,129 @@
+import io
+import os
+from concurrent import futures
+
+import grpc
+
+from vectorization.v1 import vectorization_pb2 as pb
+from vectorization.v1 import vectorization_pb2_grpc as pb_grpc
+
+from .service.utils import ServiceError
+from .service.vectorization import VectorizationService
+
+DEFAULT_LIMIT = 20
+
+STATUS_CODES = {
+    400: grpc.StatusCode.INVALID_ARGUMENT,
+    404: grpc.StatusCode.NOT_FOUND,
+    409: grpc.StatusCode.ALREADY_EXISTS,
+}
+
+
+class VectorizationServicer(pb_grpc.VectorizationServiceServicer):
+    _service: VectorizationService
+
+    def __init__(self, service: VectorizationService):
+        self._service = service
+
+    def Features(self, request_iterator, context):
+        images = list(_read_images(request_iterator, context))
+        if len(images) != 1:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Exactly one image is expected")
+
+        metadata, data = images[0]
+        self._call(context, self._service.insert, io.BytesIO(data), metadata.id, metadata.replace)
+        return pb.FeaturesResponse()
+
+    def BatchFeatures(self, request_iterator, context):
+        results = []
+        for metadata, data in _read_images(request_iterator, context):
+            try:
+                self._service.insert(io.BytesIO(data), metadata.id, metadata.replace)
+                results.append(pb.FeaturesResult(id=metadata.id))
+            except Exception as e:
+                results.append(pb.FeaturesResult(id=metadata.id, error=str(e) or type(e).__name__))
+
+        return pb.BatchFeaturesResponse(results=results)
+
+    def DeleteFeatures(self, request, context):
+        self._call(context, self._service.delete, request.id)
+        return pb.DeleteFeaturesResponse()
+
+    def Similar(self, request, context):
+        results = self._call(context, self._service.search_similar, request.id, _limit(request.limit))
+        return _search_response(results)
+
+    def SearchByImage(self, request_iterator, context):
+        options = None
+        data = io.BytesIO()
+        for req in request_iterator:
+            if req.HasField("options"):
+                options = req.options
+            else:
+                data.write(req.chunk)
+
+        if options is None:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Search options must precede the image")
+
+        data.seek(0)
+        results = self._call(context, self._service.search_by_image, data, _limit(options.limit))
+        return _search_response(results)
+
+    def SearchByText(self, request, context):
+        if not request.query:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Query is required")
+
+        results = self._call(context, self._service.search_by_text, request.query, _limit(request.limit))
+        return _search_response(results)
+
+    def _call(self, context, fn, *args):
+        try:
+            return fn(*args)
+        except ServiceError as e:
+            context.abort(STATUS_CODES.get(e.status, grpc.StatusCode.INTERNAL), str(e))
+        except ValueError as e:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, str(e))
+        except Exception as e:
+            context.abort(grpc.StatusCode.INTERNAL, str(e))
+
+
+def _read_images(request_iterator, context):
+    """Yields (metadata, bytes) of every image, each image starts with its metadata followed by the chunks"""
+    metadata = None
+    data = io.BytesIO()
+
+    for req in request_iterator:
+        if req.HasField("metadata"):
+            if metadata is not None:
+                yield metadata, data.getvalue()
+            metadata = req.metadata
+            data = io.BytesIO()
+        elif metadata is None:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Image metadata must precede its content")
+        else:
+            data.write(req.chunk)
+
+    if metadata is not None:
+        yield metadata, data.getvalue()
+
+
+def _limit(limit: int) -> int:
+    return limit if limit > 0 else DEFAULT_LIMIT
+
+
+def _search_response(results) -> pb.SearchResponse:
+    return pb.SearchResponse(
+        neighbors=[pb.Neighbor(id=r["id"], distance=r["distance"]) for r in results]
+    )
+
+
+def serve(service: VectorizationService) -> grpc.Server:
+    """Starts the grpc server in the background, the caller owns the returned server"""
+    workers = int(os.getenv("GRPC_WORKERS", 4))
+    server = grpc.server(futures.ThreadPoolExecutor(max_workers=workers))
+    pb_grpc.add_VectorizationServiceServicer_to_server(VectorizationServicer(service), server)
+
+    port = os.getenv("GRPC_PORT", "50051")
+    server.add_insecure_port(f"[::]:{port}")
+    server.start()
+    return server
@@ -51,6 +51,10 @@ class MilvusRepository:
         data = [{"id": id, "vector": vector}]
         self.collection.insert(data=data)
 
+    def upsert(self, id: int, vector: np.ndarray):
+        data = [{"id": id, "vector": vector}]
+        self.collection.upsert(data=data)
+
     def search_neighbors(self, vector: np.ndarray, limit: int = 20):
         results = self.collection.search(
             anns_field="vector",
@@ -31,9 +31,10 @@ def extract_features_endpoint():
             return jsonify({"error": "No file provided"}), 400
 
         file = request.files["image"]
+        replace = request.form.get("replace") == "true"
 
         start = time.perf_counter()
-        service.insert(file, target_id)
+        service.insert(file, target_id, replace)
         took = f"Featurize and insert took: {(time.perf_counter() - start)*1000:2f}ms"
 
         return jsonify({ "message": took }), 201
@@ -71,6 +72,22 @@ def search_by_text_endpoint():
         return jsonify({"error": str(e)}), 500
 
 
+@main.route("/search/image", methods=["POST"])
+def search_by_image_endpoint():
+    try:
+        limit = int(request.args.get("limit", 20))
+        if "image" not in request.files:
+            return jsonify({"error": "No file provided"}), 400
+
+        results = service.search_by_image(request.files["image"], limit)
+
+        return jsonify(results), 200
+    except ValueError as e:
+        return jsonify({"error": str(e)}), 400
+    except Exception as e:
+        return jsonify({"error": str(e)}), 500
+
+
 @main.route("/features/<int:id>", methods=["DELETE"])
 def delete_by_id_endpoint(id):
     try:
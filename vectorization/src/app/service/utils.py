@@ -1,14 +1,19 @@
 from flask import Response, jsonify
 from typing import Dict, Any
 
-class ServiceError:
+class ServiceError(Exception):
     _message: str
     _status: int
 
     def __init__(self, message: str, status: int):
+        super().__init__(message)
         self._message = message
         self._status = status
 
+    @property
+    def status(self) -> int:
+        return self._status
+
     def to_flask_res(self) -> tuple[Response, int]:
         return jsonify(self.to_dict()), self._status
 
@@ -17,24 +17,37 @@ class VectorizationService:
         self._model = model
         self._repo = repo
 
-    def insert(self, image_file: ImageFile, image_id: str):
-        if self._repo.exists(image_id):
+    def insert(self, image_file: ImageFile, image_id: str, replace: bool = False):
+        if not replace and self._repo.exists(image_id):
             raise ServiceError(f"Vector with key {image_id} already exists", 409)
 
         img = Image.open(io.BytesIO(image_file.read()))
         vec = self._model.vectorize_image(img)
-        self._repo.insert(image_id, vec)
+        # The stored vector is overwritten only once the new one is computed
+        if replace:
+            self._repo.upsert(image_id, vec)
+        else:
+            self._repo.insert(image_id, vec)
 
 
     def search_by_text(self, text: str, limit: int = 5):
         vec = self._model.vectorize_text(text)
         neighbors = self._repo.search_neighbors(vec, limit)
-        return [result.id for result in neighbors]
+        return self._to_results(neighbors)
+
+    def search_by_image(self, image_file: ImageFile, limit: int = 5):
+        img = Image.open(io.BytesIO(image_file.read()))
+        vec = self._model.vectorize_image(img)
+        neighbors = self._repo.search_neighbors(vec, limit)
+        return self._to_results(neighbors)
 
     def search_similar(self, image_id: int, limit: int = 5):
         vec = self._repo.get_vector_by_id(image_id)
         neighbors = self._repo.search_neighbors(vec, limit)
-        return [result.id for result in neighbors]
+        return self._to_results(neighbors)
+
+    def _to_results(self, neighbors):
+        return [{"id": hit.id, "distance": hit.distance} for hit in neighbors]
 
     def delete(self, image_id: int):
         if not self._repo.exists(image_id):
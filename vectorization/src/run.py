@@ -1,6 +1,9 @@
 from app import create_app
+from app.grpc_server import serve
+from app.routes import service
 
 app = create_app()
 
 if __name__ == "__main__":
+    grpc_server = serve(service)
     app.run(host="0.0.0.0", port=8000)